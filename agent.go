@@ -0,0 +1,57 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/miquella/vaulted/agent"
+	"github.com/miquella/vaulted/lib"
+)
+
+type Agent struct {
+	Timeout time.Duration
+}
+
+func (a *Agent) Run(store vaulted.Store) error {
+	socket := agentSocket()
+	listener, err := agent.Listen(socket)
+	if err != nil {
+		return err
+	}
+
+	// closing the listener stops the agent (and removes its socket)
+	stopped := make(chan struct{})
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, os.Interrupt, syscall.SIGTERM, syscall.SIGHUP)
+	go func() {
+		<-signals
+		close(stopped)
+		listener.Close()
+	}()
+
+	fmt.Fprintf(os.Stderr, "Agent listening on %s (keys are wiped after %s idle)\n", socket, a.Timeout)
+
+	err = agent.New(a.Timeout).Serve(listener)
+	select {
+	case <-stopped:
+		return nil
+	default:
+		return err
+	}
+}
+
+type Lock struct{}
+
+func (l *Lock) Run(store vaulted.Store) error {
+	err := agent.NewClient(agentSocket()).Lock()
+	if err != nil {
+		return err
+	}
+
+	fmt.Println("Agent locked")
+
+	return nil
+}
@@ -0,0 +1,159 @@
+package agent
+
+import (
+	"bytes"
+	"encoding/json"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/miquella/vaulted/lib"
+)
+
+const (
+	DefaultTimeout = 15 * time.Minute
+
+	getKeyRequest = "get"
+	putKeyRequest = "put"
+	lockRequest   = "lock"
+)
+
+type request struct {
+	Type        string `json:"type"`
+	Name        string `json:"name,omitempty"`
+	Fingerprint []byte `json:"fingerprint,omitempty"`
+	Key         []byte `json:"key,omitempty"`
+}
+
+type response struct {
+	Key   []byte `json:"key,omitempty"`
+	Error string `json:"error,omitempty"`
+}
+
+type cachedKey struct {
+	fingerprint []byte
+	key         *vaulted.SecureBuffer
+	timer       *time.Timer
+}
+
+// Agent holds the keys derived from vault passwords in locked memory (see
+// vaulted.SecureBuffer). Each key is wiped once it hasn't been used for
+// Timeout.
+type Agent struct {
+	Timeout time.Duration
+
+	mu   sync.Mutex
+	keys map[string]*cachedKey
+}
+
+func New(timeout time.Duration) *Agent {
+	return &Agent{
+		Timeout: timeout,
+		keys:    make(map[string]*cachedKey),
+	}
+}
+
+// Serve answers requests from clients connecting to the listener until the
+// listener is closed.
+func (a *Agent) Serve(listener net.Listener) error {
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			return err
+		}
+
+		go a.handle(conn)
+	}
+}
+
+func (a *Agent) handle(conn net.Conn) {
+	defer conn.Close()
+
+	req := request{}
+	err := json.NewDecoder(conn).Decode(&req)
+	if err != nil {
+		return
+	}
+
+	resp := response{}
+	switch req.Type {
+	case getKeyRequest:
+		resp.Key = a.GetKey(req.Name, req.Fingerprint)
+		if resp.Key == nil {
+			resp.Error = vaulted.ErrKeyNotCached.Error()
+		}
+		defer vaulted.Wipe(resp.Key)
+
+	case putKeyRequest:
+		a.PutKey(req.Name, req.Fingerprint, req.Key)
+		vaulted.Wipe(req.Key)
+
+	case lockRequest:
+		a.Lock()
+
+	default:
+		resp.Error = "Unknown request: " + req.Type
+	}
+
+	json.NewEncoder(conn).Encode(resp)
+}
+
+// GetKey returns the key held for the vault (or nil if the key isn't held or
+// was derived for a different fingerprint). Using a key resets its timeout.
+func (a *Agent) GetKey(name string, fingerprint []byte) []byte {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	ck, ok := a.keys[name]
+	if !ok || !bytes.Equal(ck.fingerprint, fingerprint) {
+		return nil
+	}
+
+	ck.timer.Reset(a.Timeout)
+
+	return ck.key.Copy()
+}
+
+// PutKey holds the key for the vault, replacing any key held for it before.
+func (a *Agent) PutKey(name string, fingerprint, key []byte) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.forget(name)
+
+	ck := &cachedKey{
+		fingerprint: append([]byte{}, fingerprint...),
+		key:         vaulted.NewSecureBuffer(key),
+	}
+	ck.timer = time.AfterFunc(a.Timeout, func() {
+		a.mu.Lock()
+		defer a.mu.Unlock()
+
+		if a.keys[name] == ck {
+			a.forget(name)
+		}
+	})
+	a.keys[name] = ck
+}
+
+// Lock wipes all of the keys held.
+func (a *Agent) Lock() {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	for name := range a.keys {
+		a.forget(name)
+	}
+}
+
+// forget wipes the key held for the vault. a.mu must be held.
+func (a *Agent) forget(name string) {
+	ck, ok := a.keys[name]
+	if !ok {
+		return
+	}
+
+	ck.timer.Stop()
+	ck.key.Destroy()
+	delete(a.keys, name)
+}
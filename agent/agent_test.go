@@ -0,0 +1,181 @@
+package agent
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/miquella/vaulted/lib"
+)
+
+func TestAgent(t *testing.T) {
+	socket, stop := startTestAgent(t, time.Minute)
+	defer stop()
+
+	client := NewClient(socket)
+	fingerprint := []byte("fingerprint")
+	key := bytes.Repeat([]byte{7}, 32)
+
+	_, err := client.GetKey("one", fingerprint)
+	if err != vaulted.ErrKeyNotCached {
+		t.Fatalf("expected %v, got %v", vaulted.ErrKeyNotCached, err)
+	}
+
+	err = client.PutKey("one", fingerprint, key)
+	if err != nil {
+		t.Fatalf("failed to put key: %v", err)
+	}
+
+	cached, err := client.GetKey("one", fingerprint)
+	if err != nil {
+		t.Fatalf("failed to get key: %v", err)
+	}
+	if !bytes.Equal(key, cached) {
+		t.Fatalf("expected %x, got %x", key, cached)
+	}
+
+	_, err = client.GetKey("one", []byte("other fingerprint"))
+	if err != vaulted.ErrKeyNotCached {
+		t.Fatalf("expected %v, got %v", vaulted.ErrKeyNotCached, err)
+	}
+
+	_, err = client.GetKey("two", fingerprint)
+	if err != vaulted.ErrKeyNotCached {
+		t.Fatalf("expected %v, got %v", vaulted.ErrKeyNotCached, err)
+	}
+
+	err = client.Lock()
+	if err != nil {
+		t.Fatalf("failed to lock: %v", err)
+	}
+
+	_, err = client.GetKey("one", fingerprint)
+	if err != vaulted.ErrKeyNotCached {
+		t.Fatalf("expected %v, got %v", vaulted.ErrKeyNotCached, err)
+	}
+}
+
+func TestAgentTimeout(t *testing.T) {
+	a := New(200 * time.Millisecond)
+	a.PutKey("one", []byte("fingerprint"), []byte("key"))
+
+	// using the key resets its timeout
+	time.Sleep(120 * time.Millisecond)
+	if a.GetKey("one", []byte("fingerprint")) == nil {
+		t.Fatal("expected the key to be held")
+	}
+	time.Sleep(120 * time.Millisecond)
+	if a.GetKey("one", []byte("fingerprint")) == nil {
+		t.Fatal("expected the key to be held")
+	}
+
+	time.Sleep(400 * time.Millisecond)
+	if a.GetKey("one", []byte("fingerprint")) != nil {
+		t.Fatal("expected the key to be wiped")
+	}
+}
+
+func TestListen(t *testing.T) {
+	socket, stop := startTestAgent(t, time.Minute)
+	defer stop()
+
+	_, err := Listen(socket)
+	if err != ErrAgentRunning {
+		t.Fatalf("expected %v, got %v", ErrAgentRunning, err)
+	}
+
+	info, err := os.Stat(filepath.Dir(socket))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Mode().Perm() != 0700 {
+		t.Fatalf("expected the socket directory to be private, got %v", info.Mode())
+	}
+}
+
+func TestListenInsecureDir(t *testing.T) {
+	dir, err := ioutil.TempDir("", "vaulted-agent-test-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	err = os.Chmod(dir, 0755)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = Listen(filepath.Join(dir, "agent.sock"))
+	if err != ErrInsecureSocketDir {
+		t.Fatalf("expected %v, got %v", ErrInsecureSocketDir, err)
+	}
+
+	// the directory is left untouched
+	info, err := os.Stat(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Mode().Perm() != 0755 {
+		t.Fatalf("expected the directory's mode to be unchanged, got %v", info.Mode())
+	}
+
+	// symlinks to a private directory are refused as well
+	private := filepath.Join(dir, "private")
+	err = os.Mkdir(private, 0700)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = os.Symlink(private, filepath.Join(dir, "link"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = Listen(filepath.Join(dir, "link", "agent.sock"))
+	if err != ErrInsecureSocketDir {
+		t.Fatalf("expected %v, got %v", ErrInsecureSocketDir, err)
+	}
+
+	listener, err := Listen(filepath.Join(private, "agent.sock"))
+	if err != nil {
+		t.Fatalf("failed to listen in a private directory: %v", err)
+	}
+	listener.Close()
+}
+
+func TestClientWithoutAgent(t *testing.T) {
+	dir, err := ioutil.TempDir("", "vaulted-agent-test-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	client := NewClient(filepath.Join(dir, "agent.sock"))
+	err = client.Lock()
+	if err != ErrAgentNotRunning {
+		t.Fatalf("expected %v, got %v", ErrAgentNotRunning, err)
+	}
+}
+
+func startTestAgent(t *testing.T, timeout time.Duration) (string, func()) {
+	dir, err := ioutil.TempDir("", "vaulted-agent-test-")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	socket := filepath.Join(dir, "agent", "agent.sock")
+	listener, err := Listen(socket)
+	if err != nil {
+		os.RemoveAll(dir)
+		t.Fatal(err)
+	}
+
+	go New(timeout).Serve(listener)
+
+	return socket, func() {
+		listener.Close()
+		os.RemoveAll(dir)
+	}
+}
@@ -0,0 +1,94 @@
+package agent
+
+import (
+	"encoding/json"
+	"errors"
+	"net"
+	"time"
+
+	"github.com/miquella/vaulted/lib"
+)
+
+const (
+	dialTimeout    = time.Second
+	requestTimeout = 5 * time.Second
+)
+
+var (
+	ErrAgentNotRunning = errors.New("The vaulted agent is not running")
+	ErrAgentRunning    = errors.New("The vaulted agent is already running")
+)
+
+// Client talks to the agent listening on Socket.
+type Client struct {
+	Socket string
+}
+
+func NewClient(socket string) *Client {
+	return &Client{
+		Socket: socket,
+	}
+}
+
+// GetKey returns the key held by the agent for the vault. vaulted.ErrKeyNotCached
+// is returned when no key (with a matching fingerprint) is held.
+func (c *Client) GetKey(name string, fingerprint []byte) ([]byte, error) {
+	resp, err := c.request(&request{
+		Type:        getKeyRequest,
+		Name:        name,
+		Fingerprint: fingerprint,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Key, nil
+}
+
+func (c *Client) PutKey(name string, fingerprint, key []byte) error {
+	_, err := c.request(&request{
+		Type:        putKeyRequest,
+		Name:        name,
+		Fingerprint: fingerprint,
+		Key:         key,
+	})
+	return err
+}
+
+// Lock wipes all of the keys held by the agent.
+func (c *Client) Lock() error {
+	_, err := c.request(&request{
+		Type: lockRequest,
+	})
+	return err
+}
+
+func (c *Client) request(req *request) (*response, error) {
+	conn, err := net.DialTimeout("unix", c.Socket, dialTimeout)
+	if err != nil {
+		return nil, ErrAgentNotRunning
+	}
+	defer conn.Close()
+
+	conn.SetDeadline(time.Now().Add(requestTimeout))
+
+	err = json.NewEncoder(conn).Encode(req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := response{}
+	err = json.NewDecoder(conn).Decode(&resp)
+	if err != nil {
+		return nil, err
+	}
+
+	switch resp.Error {
+	case "":
+		return &resp, nil
+	case vaulted.ErrKeyNotCached.Error():
+		return nil, vaulted.ErrKeyNotCached
+	default:
+		return nil, errors.New(resp.Error)
+	}
+}
@@ -0,0 +1,81 @@
+package agent
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"syscall"
+
+	"github.com/miquella/xdg"
+)
+
+var (
+	ErrInsecureSocketDir = errors.New("The agent's socket directory must be a directory owned by you and only accessible by you")
+)
+
+// DefaultSocket returns the location of the agent's socket in the user's
+// runtime directory (or a per-user directory in the temp directory).
+func DefaultSocket() string {
+	if xdg.RUNTIME_DIR.IsValid() {
+		return xdg.RUNTIME_DIR.Join("vaulted", "agent.sock")
+	}
+
+	return filepath.Join(os.TempDir(), fmt.Sprintf("vaulted-%d", os.Getuid()), "agent.sock")
+}
+
+// Listen creates the agent's socket. The socket's directory is created (only
+// accessible by the user) when it doesn't exist, so other users are unable to
+// connect to the agent. An existing directory is never modified, instead
+// ErrInsecureSocketDir is returned unless it is owned by the user and not
+// accessible by other users.
+func Listen(socket string) (net.Listener, error) {
+	dir := filepath.Dir(socket)
+	err := os.MkdirAll(dir, 0700)
+	if err != nil {
+		return nil, err
+	}
+
+	err = checkSocketDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	// refuse to replace the socket of a running agent (a socket that can't be
+	// connected to was left behind by an agent that has exited)
+	if conn, err := net.DialTimeout("unix", socket, dialTimeout); err == nil {
+		conn.Close()
+		return nil, ErrAgentRunning
+	}
+	os.Remove(socket)
+
+	listener, err := net.Listen("unix", socket)
+	if err != nil {
+		return nil, err
+	}
+
+	err = os.Chmod(socket, 0600)
+	if err != nil {
+		listener.Close()
+		return nil, err
+	}
+
+	return listener, nil
+}
+
+// checkSocketDir returns ErrInsecureSocketDir unless dir is a directory (not a
+// symlink) owned by the user that only the user can access.
+func checkSocketDir(dir string) error {
+	info, err := os.Lstat(dir)
+	if err != nil {
+		return err
+	}
+
+	stat, ok := info.Sys().(*syscall.Stat_t)
+	if !info.IsDir() || !ok || int(stat.Uid) != os.Getuid() || info.Mode().Perm()&0077 != 0 {
+		return ErrInsecureSocketDir
+	}
+
+	return nil
+}
@@ -0,0 +1,59 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/miquella/vaulted/agent"
+)
+
+func TestLock(t *testing.T) {
+	dir, err := ioutil.TempDir("", "vaulted-agent-test-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	socket := filepath.Join(dir, "agent.sock")
+	os.Setenv("VAULTED_AGENT_SOCK", socket)
+	defer os.Unsetenv("VAULTED_AGENT_SOCK")
+
+	l := Lock{}
+	err = l.Run(NewTestStore())
+	if err != agent.ErrAgentNotRunning {
+		t.Fatalf("Expected %v, got %v", agent.ErrAgentNotRunning, err)
+	}
+
+	listener, err := agent.Listen(socket)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer listener.Close()
+
+	a := agent.New(time.Minute)
+	go a.Serve(listener)
+
+	steward, ok := NewSteward().(*AgentSteward)
+	if !ok {
+		t.Fatal("Expected the agent to be used while it is running")
+	}
+
+	err = steward.PutKey("one", []byte("fingerprint"), []byte("key"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	CaptureStdout(func() {
+		err = l.Run(NewTestStore())
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if a.GetKey("one", []byte("fingerprint")) != nil {
+		t.Fatal("Expected the agent's keys to be wiped")
+	}
+}
@@ -0,0 +1,62 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/miquella/vaulted/lib"
+)
+
+var (
+	ErrAuditLogBroken = ErrorWithExitCode{errors.New("Audit log failed to verify (entries were removed, reordered or modified)"), EX_DATA_ERROR}
+)
+
+type Audit struct {
+	VaultName string
+}
+
+func (a *Audit) Run(store vaulted.Store) error {
+	log, err := store.VaultAuditLog(a.VaultName)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("%-19s  %-14s  %-6s  %s\n", "DATE", "OPERATION", "RESULT", "DETAILS")
+	for i, entry := range log.Entries {
+		if i == log.BrokenAt {
+			fmt.Println("-- the entries below do not follow the entries above --")
+		}
+
+		if entry == nil {
+			fmt.Printf("%-19s  %-14s  %-6s  %s\n", "unknown", "unknown", "", "sealed with a previous audit key of the vault")
+			continue
+		}
+
+		result := "ok"
+		var details []string
+		if !entry.Success {
+			result = "failed"
+			details = append(details, entry.Error)
+		}
+		if entry.Role != "" {
+			details = append(details, entry.Role)
+		}
+		if len(entry.Command) > 0 {
+			details = append(details, strings.Join(entry.Command, " "))
+		}
+
+		date := entry.Timestamp.Local().Format("2006-01-02 15:04:05")
+		fmt.Printf("%-19s  %-14s  %-6s  %s\n", date, entry.Operation, result, strings.Join(details, ": "))
+	}
+
+	if log.BrokenAt == len(log.Entries) {
+		fmt.Println("-- entries were removed from the end of the log --")
+	}
+	if log.BrokenAt >= 0 {
+		return ErrAuditLogBroken
+	}
+
+	fmt.Printf("\nAudit log verified (%d entries)\n", len(log.Entries))
+	return nil
+}
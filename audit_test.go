@@ -0,0 +1,87 @@
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/miquella/vaulted/lib"
+)
+
+func TestAudit(t *testing.T) {
+	store := NewTestStore()
+	store.Vaults["one"] = &vaulted.Vault{}
+	store.AuditLogs["one"] = &vaulted.AuditLog{
+		Entries: []*vaulted.AuditEntry{
+			nil,
+			{
+				Timestamp: time.Unix(1136239445, 0),
+				Operation: vaulted.AuditOpen,
+				Command:   []string{"vaulted", "shell", "one"},
+				Success:   false,
+				Error:     "Incorrect password",
+			},
+			{
+				Timestamp: time.Unix(1136239446, 0),
+				Operation: vaulted.AuditAssumeRole,
+				Role:      "arn:aws:iam::123456789012:role/admin",
+				Success:   true,
+			},
+		},
+		BrokenAt: -1,
+	}
+
+	a := Audit{VaultName: "one"}
+	var err error
+	output := string(CaptureStdout(func() {
+		err = a.Run(store)
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, expected := range []string{
+		"sealed with a previous audit key",
+		"open            failed  Incorrect password: vaulted shell one",
+		"assume-role     ok      arn:aws:iam::123456789012:role/admin",
+		"Audit log verified (3 entries)",
+	} {
+		if !strings.Contains(output, expected) {
+			t.Errorf("Expected output to contain %q, got:\n%s", expected, output)
+		}
+	}
+}
+
+func TestAuditBroken(t *testing.T) {
+	store := NewTestStore()
+	store.Vaults["one"] = &vaulted.Vault{}
+	store.AuditLogs["one"] = &vaulted.AuditLog{
+		Entries: []*vaulted.AuditEntry{
+			{Operation: vaulted.AuditSeal, Success: true},
+			{Operation: vaulted.AuditOpen, Success: true},
+		},
+		BrokenAt: 1,
+	}
+
+	a := Audit{VaultName: "one"}
+	var err error
+	output := string(CaptureStdout(func() {
+		err = a.Run(store)
+	}))
+	if err != ErrAuditLogBroken {
+		t.Fatalf("Expected %v, got %v", ErrAuditLogBroken, err)
+	}
+	if !strings.Contains(output, "do not follow") {
+		t.Errorf("Expected the broken link to be shown, got:\n%s", output)
+	}
+}
+
+func TestAuditNoAuditLog(t *testing.T) {
+	store := NewTestStore()
+	store.Vaults["one"] = &vaulted.Vault{}
+
+	err := (&Audit{VaultName: "one"}).Run(store)
+	if err != vaulted.ErrNoAuditKey {
+		t.Fatalf("Expected %v, got %v", vaulted.ErrNoAuditKey, err)
+	}
+}
@@ -5,8 +5,11 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
+	"time"
 
+	"github.com/miquella/vaulted/agent"
 	"github.com/miquella/vaulted/edit"
 	"github.com/miquella/vaulted/lib"
 	"github.com/spf13/pflag"
@@ -18,12 +21,27 @@ var (
 	ErrNotEnoughArguments          = ErrorWithExitCode{errors.New("not enough arguments provided"), EX_USAGE_ERROR}
 	ErrVaultNameRequired           = ErrorWithExitCode{errors.New("A vault name must be specified"), EX_USAGE_ERROR}
 	ErrMixingCommandAndInteractive = ErrorWithExitCode{errors.New("Cannot mix an interactive shell with command arguments"), EX_USAGE_ERROR}
+	ErrInvalidKDFTarget            = ErrorWithExitCode{errors.New("Invalid key derivation target (VAULTED_KDF_TARGET must be a non-negative duration, e.g. 500ms)"), EX_USAGE_ERROR}
 
 	ErrUnknownShell = errors.New("Unknown shell")
 )
 
 var (
 	HelpRequested bool
+
+	// StoreDirs are the stores given by --store (or VAULTED_HOME), in order of
+	// precedence. Vaults are only ever written to the first store.
+	StoreDirs []string
+
+	// AllowWeakPassword is set by --allow-weak-password, which disables the
+	// password policy. It has no short form (or environment variable), so
+	// weak passwords are only ever accepted on purpose.
+	AllowWeakPassword bool
+
+	// PasswordPolicy is applied to new vault passwords (configured by the
+	// VAULTED_PASSWORD_* environment variables). It is nil when
+	// --allow-weak-password is given.
+	PasswordPolicy vaulted.PasswordPolicy
 )
 
 type Command interface {
@@ -67,6 +85,19 @@ func parseArgs(args []string) (Command, error) {
 		return &Version{}, nil
 	}
 
+	PasswordPolicy = nil
+	if !AllowWeakPassword {
+		PasswordPolicy, err = passwordPolicyFromEnv()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	vaulted.KeyDerivationTarget, err = keyDerivationTargetFromEnv()
+	if err != nil {
+		return nil, err
+	}
+
 	if flag.Changed("name") || flag.Changed("interactive") {
 		return parseSpawnArgs(args)
 	}
@@ -88,9 +119,18 @@ func parseArgs(args []string) (Command, error) {
 	case "add", "create", "new":
 		return parseAddArgs(commandArgs[1:])
 
+	case "agent":
+		return parseAgentArgs(commandArgs[1:])
+
+	case "audit":
+		return parseAuditArgs(commandArgs[1:])
+
 	case "cp", "copy":
 		return parseCopyArgs(commandArgs[1:])
 
+	case "diff":
+		return parseDiffArgs(commandArgs[1:])
+
 	case "dump":
 		return parseDumpArgs(commandArgs[1:])
 
@@ -103,27 +143,57 @@ func parseArgs(args []string) (Command, error) {
 	case "exec":
 		return parseExecArgs(commandArgs[1:])
 
+	case "export":
+		return parseExportArgs(commandArgs[1:])
+
 	case "help":
 		return parseHelpArgs(commandArgs[1:])
 
+	case "history":
+		return parseHistoryArgs(commandArgs[1:])
+
 	case "ls", "list":
 		return parseListArgs(commandArgs[1:])
 
+	case "import":
+		return parseImportArgs(commandArgs[1:])
+
 	case "load":
 		return parseLoadArgs(commandArgs[1:])
 
+	case "lock":
+		return parseLockArgs(commandArgs[1:])
+
+	case "metadata":
+		return parseMetadataArgs(commandArgs[1:])
+
 	case "passwd", "password":
 		return parsePasswdArgs(commandArgs[1:])
 
+	case "recipients":
+		return parseRecipientsArgs(commandArgs[1:])
+
+	case "recovery":
+		return parseRecoveryArgs(commandArgs[1:])
+
 	case "rm", "delete", "remove":
 		return parseRemoveArgs(commandArgs[1:])
 
+	case "rollback":
+		return parseRollbackArgs(commandArgs[1:])
+
 	case "shell":
 		return parseShellArgs(commandArgs[1:])
 
+	case "sync":
+		return parseSyncArgs(commandArgs[1:])
+
 	case "upgrade":
 		return parseUpgradeArgs(commandArgs[1:])
 
+	case "verify":
+		return parseVerifyArgs(commandArgs[1:])
+
 	case "version":
 		return &Version{}, nil
 
@@ -138,9 +208,39 @@ func spawnFlagSet() *pflag.FlagSet {
 	flag.StringP("name", "n", "", "Name of the vault to use")
 	flag.BoolP("interactive", "i", false, "Spawn interactive shell (if -n is used, but no additional arguments a provided, interactive is the default)")
 	flag.BoolP("version", "V", false, "Specify current version of Vaulted")
+	flag.StringArrayVar(&StoreDirs, "store", storeDirsFromEnv(), "Store to use instead of the default (may be repeated, later stores are searched for vaults but never written to)")
+	flag.BoolVar(&AllowWeakPassword, "allow-weak-password", false, "Accept new passwords that don't satisfy the password policy")
 	return flag
 }
 
+// storeDirsFromEnv returns the stores listed in VAULTED_HOME (separated like
+// PATH).
+func storeDirsFromEnv() []string {
+	var dirs []string
+	for _, dir := range filepath.SplitList(os.Getenv("VAULTED_HOME")) {
+		if dir != "" {
+			dirs = append(dirs, dir)
+		}
+	}
+	return dirs
+}
+
+// keyDerivationTargetFromEnv returns how long deriving a vault's key should
+// take (given by VAULTED_KDF_TARGET).
+func keyDerivationTargetFromEnv() (time.Duration, error) {
+	target, present := os.LookupEnv("VAULTED_KDF_TARGET")
+	if !present {
+		return vaulted.DefaultKeyDerivationTarget, nil
+	}
+
+	duration, err := time.ParseDuration(target)
+	if err != nil || duration < 0 {
+		return 0, ErrInvalidKDFTarget
+	}
+
+	return duration, nil
+}
+
 func parseSpawnArgs(args []string) (Command, error) {
 	flag := spawnFlagSet()
 	err := flag.Parse(args)
@@ -181,6 +281,7 @@ func parseSpawnArgs(args []string) (Command, error) {
 
 func parseAddArgs(args []string) (Command, error) {
 	flag := NewFlagSet("vaulted add")
+	flag.String("cipher", "", "Encryption method to use for the vault (secretbox, xchacha20poly1305, aes-256-gcm)")
 	err := flag.Parse(args)
 	if err != nil {
 		return nil, err
@@ -197,9 +298,59 @@ func parseAddArgs(args []string) (Command, error) {
 	e := &edit.Edit{}
 	e.New = true
 	e.VaultName = flag.Arg(0)
+	e.Cipher, _ = flag.GetString("cipher")
+
+	if e.Cipher != "" {
+		err = vaulted.ValidateEncryptionMethod(e.Cipher)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return e, nil
 }
 
+func parseAgentArgs(args []string) (Command, error) {
+	flag := NewFlagSet("vaulted agent")
+	flag.Duration("timeout", agent.DefaultTimeout, "Duration an unused key is held for")
+	err := flag.Parse(args)
+	if err != nil {
+		return nil, err
+	}
+
+	if flag.NArg() > 0 {
+		return nil, ErrTooManyArguments
+	}
+
+	a := &Agent{}
+	a.Timeout, _ = flag.GetDuration("timeout")
+	if a.Timeout <= 0 {
+		return nil, fmt.Errorf("Invalid timeout: %s", a.Timeout)
+	}
+
+	return a, nil
+}
+
+func parseAuditArgs(args []string) (Command, error) {
+	flag := NewFlagSet("vaulted audit")
+	err := flag.Parse(args)
+	if err != nil {
+		return nil, err
+	}
+
+	if flag.NArg() < 1 {
+		return nil, ErrNotEnoughArguments
+	}
+
+	if flag.NArg() > 1 {
+		return nil, ErrTooManyArguments
+	}
+
+	a := &Audit{}
+	a.VaultName = flag.Arg(0)
+	return a, nil
+}
+
 func parseCopyArgs(args []string) (Command, error) {
 	flag := NewFlagSet("vaulted copy")
 	err := flag.Parse(args)
@@ -221,6 +372,35 @@ func parseCopyArgs(args []string) (Command, error) {
 	return c, nil
 }
 
+func parseDiffArgs(args []string) (Command, error) {
+	flag := NewFlagSet("vaulted diff")
+	flag.Bool("show-secrets", false, "Show the values of secrets instead of redacting them")
+	flag.Bool("json", false, "Output the differences as JSON")
+	err := flag.Parse(args)
+	if err != nil {
+		return nil, err
+	}
+
+	if flag.NArg() < 2 {
+		return nil, ErrNotEnoughArguments
+	}
+
+	if flag.NArg() > 2 {
+		return nil, ErrTooManyArguments
+	}
+
+	if flag.Arg(0) == "-" && flag.Arg(1) == "-" {
+		return nil, fmt.Errorf("Only one vault can be read from stdin")
+	}
+
+	d := &Diff{}
+	d.OldVaultName = flag.Arg(0)
+	d.NewVaultName = flag.Arg(1)
+	d.ShowSecrets, _ = flag.GetBool("show-secrets")
+	d.JSON, _ = flag.GetBool("json")
+	return d, nil
+}
+
 func parseDumpArgs(args []string) (Command, error) {
 	flag := NewFlagSet("vaulted dump")
 	err := flag.Parse(args)
@@ -243,6 +423,7 @@ func parseDumpArgs(args []string) (Command, error) {
 
 func parseEditArgs(args []string) (Command, error) {
 	flag := NewFlagSet("vaulted edit")
+	flag.Bool("fork", false, "Save a copy of a system vault to your own vaults")
 	err := flag.Parse(args)
 	if err != nil {
 		return nil, err
@@ -258,6 +439,7 @@ func parseEditArgs(args []string) (Command, error) {
 
 	e := &edit.Edit{}
 	e.VaultName = flag.Arg(0)
+	e.Fork, _ = flag.GetBool("fork")
 	return e, nil
 }
 
@@ -378,6 +560,20 @@ func parseExecArgs(args []string) (Command, error) {
 	return s, nil
 }
 
+func parseExportArgs(args []string) (Command, error) {
+	flag := NewFlagSet("vaulted export")
+	flag.StringP("output", "o", "", "File to write the bundle to (instead of stdout)")
+	err := flag.Parse(args)
+	if err != nil {
+		return nil, err
+	}
+
+	e := &Export{}
+	e.VaultNames = flag.Args()
+	e.Output, _ = flag.GetString("output")
+	return e, nil
+}
+
 func parseHelpArgs(args []string) (Command, error) {
 	h := Help{}
 	if len(args) > 0 {
@@ -386,22 +582,96 @@ func parseHelpArgs(args []string) (Command, error) {
 	return &h, nil
 }
 
+func parseHistoryArgs(args []string) (Command, error) {
+	flag := NewFlagSet("vaulted history")
+	err := flag.Parse(args)
+	if err != nil {
+		return nil, err
+	}
+
+	if flag.NArg() < 1 {
+		return nil, ErrNotEnoughArguments
+	}
+
+	if flag.NArg() > 1 {
+		return nil, ErrTooManyArguments
+	}
+
+	h := &History{}
+	h.VaultName = flag.Arg(0)
+	return h, nil
+}
+
+func parseImportArgs(args []string) (Command, error) {
+	flag := NewFlagSet("vaulted import")
+	flag.Bool(ImportRename, false, "Import vaults that already exist under a new name")
+	flag.Bool(ImportSkip, false, "Skip vaults that already exist")
+	flag.Bool(ImportOverwrite, false, "Replace vaults that already exist")
+	err := flag.Parse(args)
+	if err != nil {
+		return nil, err
+	}
+
+	if flag.NArg() < 1 {
+		return nil, ErrNotEnoughArguments
+	}
+
+	if flag.NArg() > 1 {
+		return nil, ErrTooManyArguments
+	}
+
+	i := &Import{}
+	i.Filename = flag.Arg(0)
+	for _, onConflict := range []string{ImportRename, ImportSkip, ImportOverwrite} {
+		if !flag.Changed(onConflict) {
+			continue
+		}
+		if i.OnConflict != "" {
+			return nil, errors.New("Only one of --rename, --skip or --overwrite may be specified")
+		}
+		i.OnConflict = onConflict
+	}
+	return i, nil
+}
+
 func parseListArgs(args []string) (Command, error) {
 	flag := NewFlagSet("vaulted list")
+	flag.BoolP("tree", "t", false, "List the vaults as a tree (grouping hierarchical names)")
+	flag.BoolP("long", "l", false, "List the metadata of each vault")
+	flag.StringArray("tag", nil, "Only list vaults with the given tag")
+	flag.Bool("json", false, "Output the vaults and their metadata as JSON")
 	err := flag.Parse(args)
 	if err != nil {
 		return nil, err
 	}
 
-	if flag.NArg() > 0 {
+	if flag.NArg() > 1 {
 		return nil, ErrTooManyArguments
 	}
 
-	return &List{Active: os.Getenv("VAULTED_ENV")}, nil
+	if flag.Changed("long") && flag.Changed("json") {
+		return nil, errors.New("--long cannot be combined with --json")
+	}
+
+	if flag.Changed("tree") && (flag.Changed("long") || flag.Changed("json")) {
+		return nil, errors.New("--tree cannot be combined with --long or --json")
+	}
+
+	l := &List{}
+	l.Active = os.Getenv("VAULTED_ENV")
+	l.Prefix = flag.Arg(0)
+	l.Tree, _ = flag.GetBool("tree")
+	l.Long, _ = flag.GetBool("long")
+	if flag.Changed("tag") {
+		l.Tags, _ = flag.GetStringArray("tag")
+	}
+	l.JSON, _ = flag.GetBool("json")
+	return l, nil
 }
 
 func parseLoadArgs(args []string) (Command, error) {
 	flag := NewFlagSet("vaulted load")
+	flag.Bool("fork", false, "Save a copy of a system vault to your own vaults")
 	err := flag.Parse(args)
 	if err != nil {
 		return nil, err
@@ -417,11 +687,71 @@ func parseLoadArgs(args []string) (Command, error) {
 
 	l := &Load{}
 	l.VaultName = flag.Arg(0)
+	l.Fork, _ = flag.GetBool("fork")
 	return l, nil
 }
 
+func parseLockArgs(args []string) (Command, error) {
+	flag := NewFlagSet("vaulted lock")
+	err := flag.Parse(args)
+	if err != nil {
+		return nil, err
+	}
+
+	if flag.NArg() > 0 {
+		return nil, ErrTooManyArguments
+	}
+
+	return &Lock{}, nil
+}
+
+func parseMetadataArgs(args []string) (Command, error) {
+	flag := NewFlagSet("vaulted metadata")
+	flag.String("description", "", "Describe the vault")
+	flag.String("team", "", "Team that owns the vault")
+	flag.StringArray("add-tag", nil, "Tag the vault")
+	flag.StringArray("remove-tag", nil, "Remove a tag from the vault")
+	err := flag.Parse(args)
+	if err != nil {
+		return nil, err
+	}
+
+	if flag.NArg() < 1 {
+		return nil, ErrNotEnoughArguments
+	}
+
+	if flag.NArg() > 1 {
+		return nil, ErrTooManyArguments
+	}
+
+	m := &Metadata{}
+	m.VaultName = flag.Arg(0)
+	if flag.Changed("description") {
+		description, _ := flag.GetString("description")
+		m.Description = &description
+	}
+	if flag.Changed("team") {
+		team, _ := flag.GetString("team")
+		m.Team = &team
+	}
+	if flag.Changed("add-tag") {
+		m.AddTags, _ = flag.GetStringArray("add-tag")
+	}
+	if flag.Changed("remove-tag") {
+		m.RemoveTags, _ = flag.GetStringArray("remove-tag")
+	}
+	return m, nil
+}
+
 func parsePasswdArgs(args []string) (Command, error) {
 	flag := NewFlagSet("vaulted passwd")
+	flag.String("kdf", "", "Key derivation method to migrate the vault to (argon2id, pbkdf2-sha512)")
+	flag.String("cipher", "", "Encryption method to migrate the vault to (secretbox, xchacha20poly1305, aes-256-gcm)")
+	flag.Bool("add-slot", false, "Add a key slot for an additional password")
+	flag.Int("remove-slot", 0, "Remove the key slot with the given ID")
+	flag.Bool("list-slots", false, "List the key slots of the vault")
+	flag.Bool("fork", false, "Save a copy of a system vault to your own vaults")
+	flag.Bool("recalibrate", false, "Raise the cost of the key derivation to take the target time on this machine")
 	err := flag.Parse(args)
 	if err != nil {
 		return nil, err
@@ -435,12 +765,267 @@ func parsePasswdArgs(args []string) (Command, error) {
 		return nil, ErrTooManyArguments
 	}
 
+	slotFlags := 0
+	for _, name := range []string{"add-slot", "remove-slot", "list-slots"} {
+		if flag.Changed(name) {
+			slotFlags++
+		}
+	}
+	if slotFlags > 1 {
+		return nil, errors.New("Only one of --add-slot, --remove-slot or --list-slots may be specified")
+	}
+	if slotFlags > 0 && flag.Changed("cipher") {
+		return nil, errors.New("--cipher cannot be combined with key slot changes")
+	}
+	if slotFlags > 0 && flag.Changed("fork") {
+		return nil, errors.New("--fork cannot be combined with key slot changes")
+	}
+	if slotFlags > 0 && flag.Changed("recalibrate") {
+		return nil, errors.New("--recalibrate cannot be combined with key slot changes")
+	}
+	if flag.Changed("kdf") && (flag.Changed("remove-slot") || flag.Changed("list-slots")) {
+		return nil, errors.New("--kdf can only be combined with --add-slot")
+	}
+
+	if flag.Changed("add-slot") {
+		a := &AddKeySlot{}
+		a.VaultName = flag.Arg(0)
+		a.KeyMethod, _ = flag.GetString("kdf")
+
+		if a.KeyMethod != "" {
+			err = vaulted.ValidateKeyMethod(a.KeyMethod)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		return a, nil
+	}
+
+	if flag.Changed("remove-slot") {
+		r := &RemoveKeySlot{}
+		r.VaultName = flag.Arg(0)
+		r.Slot, _ = flag.GetInt("remove-slot")
+		return r, nil
+	}
+
+	if flag.Changed("list-slots") {
+		return &ListKeySlots{VaultName: flag.Arg(0)}, nil
+	}
+
 	c := &Copy{}
 	c.OldVaultName = flag.Arg(0)
 	c.NewVaultName = flag.Arg(0)
+	c.KeyMethod, _ = flag.GetString("kdf")
+	c.Cipher, _ = flag.GetString("cipher")
+	c.Fork, _ = flag.GetBool("fork")
+	c.Recalibrate, _ = flag.GetBool("recalibrate")
+
+	if c.KeyMethod != "" {
+		err = vaulted.ValidateKeyMethod(c.KeyMethod)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if c.Cipher != "" {
+		err = vaulted.ValidateEncryptionMethod(c.Cipher)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return c, nil
 }
 
+func parseRecipientsArgs(args []string) (Command, error) {
+	if len(args) == 0 {
+		return nil, ErrSubcommandRequired
+	}
+
+	switch args[0] {
+	case "add":
+		return parseRecipientsAddArgs(args[1:])
+
+	case "rm":
+		return parseRecipientsRemoveArgs(args[1:])
+
+	case "ls":
+		return parseRecipientsListArgs(args[1:])
+
+	case "identity":
+		return parseRecipientsIdentityArgs(args[1:])
+
+	default:
+		// allow `vaulted recipients --help`
+		flag := NewFlagSet("vaulted recipients")
+		err := flag.Parse(args)
+		if err != nil {
+			return nil, err
+		}
+
+		return nil, fmt.Errorf("Unknown recipients command: %s", args[0])
+	}
+}
+
+func parseRecipientsAddArgs(args []string) (Command, error) {
+	flag := NewFlagSet("vaulted recipients add")
+	flag.String("name", "", "Name to identify the recipient by")
+	err := flag.Parse(args)
+	if err != nil {
+		return nil, err
+	}
+
+	if flag.NArg() < 2 {
+		return nil, ErrNotEnoughArguments
+	}
+
+	if flag.NArg() > 2 {
+		return nil, ErrTooManyArguments
+	}
+
+	publicKey, err := vaulted.ParsePublicKey(flag.Arg(1))
+	if err != nil {
+		return nil, err
+	}
+
+	a := &AddRecipient{}
+	a.VaultName = flag.Arg(0)
+	a.Recipient.Name, _ = flag.GetString("name")
+	a.Recipient.PublicKey = publicKey
+	return a, nil
+}
+
+func parseRecipientsRemoveArgs(args []string) (Command, error) {
+	flag := NewFlagSet("vaulted recipients rm")
+	err := flag.Parse(args)
+	if err != nil {
+		return nil, err
+	}
+
+	if flag.NArg() < 2 {
+		return nil, ErrNotEnoughArguments
+	}
+
+	if flag.NArg() > 2 {
+		return nil, ErrTooManyArguments
+	}
+
+	r := &RemoveRecipient{}
+	r.VaultName = flag.Arg(0)
+	r.Recipient = flag.Arg(1)
+	return r, nil
+}
+
+func parseRecipientsListArgs(args []string) (Command, error) {
+	flag := NewFlagSet("vaulted recipients ls")
+	err := flag.Parse(args)
+	if err != nil {
+		return nil, err
+	}
+
+	if flag.NArg() < 1 {
+		return nil, ErrNotEnoughArguments
+	}
+
+	if flag.NArg() > 1 {
+		return nil, ErrTooManyArguments
+	}
+
+	l := &ListRecipients{}
+	l.VaultName = flag.Arg(0)
+	return l, nil
+}
+
+func parseRecipientsIdentityArgs(args []string) (Command, error) {
+	flag := NewFlagSet("vaulted recipients identity")
+	err := flag.Parse(args)
+	if err != nil {
+		return nil, err
+	}
+
+	if flag.NArg() > 0 {
+		return nil, ErrTooManyArguments
+	}
+
+	return &ShowIdentity{IdentityFile: identityFile()}, nil
+}
+
+func parseRecoveryArgs(args []string) (Command, error) {
+	if len(args) == 0 {
+		return nil, ErrSubcommandRequired
+	}
+
+	switch args[0] {
+	case "split":
+		return parseRecoverySplitArgs(args[1:])
+
+	case "open":
+		return parseRecoveryOpenArgs(args[1:])
+
+	default:
+		// allow `vaulted recovery --help`
+		flag := NewFlagSet("vaulted recovery")
+		err := flag.Parse(args)
+		if err != nil {
+			return nil, err
+		}
+
+		return nil, fmt.Errorf("Unknown recovery command: %s", args[0])
+	}
+}
+
+func parseRecoverySplitArgs(args []string) (Command, error) {
+	flag := NewFlagSet("vaulted recovery split")
+	flag.Int("shares", 5, "Number of shares to split the recovery key into")
+	flag.Int("threshold", 3, "Number of shares required to open the vault")
+	flag.String("format", RecoveryFormatText, "Format to print the shares in (text, base32)")
+	err := flag.Parse(args)
+	if err != nil {
+		return nil, err
+	}
+
+	if flag.NArg() < 1 {
+		return nil, ErrNotEnoughArguments
+	}
+
+	if flag.NArg() > 1 {
+		return nil, ErrTooManyArguments
+	}
+
+	s := &SplitRecovery{}
+	s.VaultName = flag.Arg(0)
+	s.Shares, _ = flag.GetInt("shares")
+	s.Threshold, _ = flag.GetInt("threshold")
+	s.Format, _ = flag.GetString("format")
+
+	if s.Format != RecoveryFormatText && s.Format != RecoveryFormatBase32 {
+		return nil, fmt.Errorf("Unknown recovery share format: %s", s.Format)
+	}
+
+	return s, nil
+}
+
+func parseRecoveryOpenArgs(args []string) (Command, error) {
+	flag := NewFlagSet("vaulted recovery open")
+	err := flag.Parse(args)
+	if err != nil {
+		return nil, err
+	}
+
+	if flag.NArg() < 1 {
+		return nil, ErrNotEnoughArguments
+	}
+
+	if flag.NArg() > 1 {
+		return nil, ErrTooManyArguments
+	}
+
+	o := &OpenRecovery{}
+	o.VaultName = flag.Arg(0)
+	return o, nil
+}
+
 func parseRemoveArgs(args []string) (Command, error) {
 	flag := NewFlagSet("vaulted remove")
 	err := flag.Parse(args)
@@ -457,6 +1042,32 @@ func parseRemoveArgs(args []string) (Command, error) {
 	return r, nil
 }
 
+func parseRollbackArgs(args []string) (Command, error) {
+	flag := NewFlagSet("vaulted rollback")
+	err := flag.Parse(args)
+	if err != nil {
+		return nil, err
+	}
+
+	if flag.NArg() < 2 {
+		return nil, ErrNotEnoughArguments
+	}
+
+	if flag.NArg() > 2 {
+		return nil, ErrTooManyArguments
+	}
+
+	revision, err := strconv.Atoi(flag.Arg(1))
+	if err != nil || revision < 0 {
+		return nil, fmt.Errorf("Invalid revision: %s", flag.Arg(1))
+	}
+
+	r := &Rollback{}
+	r.VaultName = flag.Arg(0)
+	r.Revision = revision
+	return r, nil
+}
+
 func parseShellArgs(args []string) (Command, error) {
 	flag := NewFlagSet("vaulted shell")
 	flag.String("assume", "", "Role to assume")
@@ -503,8 +1114,84 @@ func parseShellArgs(args []string) (Command, error) {
 	return s, nil
 }
 
+func parseSyncArgs(args []string) (Command, error) {
+	if len(args) == 0 {
+		return nil, ErrSubcommandRequired
+	}
+
+	switch args[0] {
+	case "init":
+		return parseSyncInitArgs(args[1:])
+
+	case "push":
+		return parseSyncPushArgs(args[1:])
+
+	case "pull":
+		return parseSyncPullArgs(args[1:])
+
+	default:
+		// allow `vaulted sync --help`
+		flag := NewFlagSet("vaulted sync")
+		err := flag.Parse(args)
+		if err != nil {
+			return nil, err
+		}
+
+		return nil, fmt.Errorf("Unknown sync command: %s", args[0])
+	}
+}
+
+func parseSyncInitArgs(args []string) (Command, error) {
+	flag := NewFlagSet("vaulted sync init")
+	err := flag.Parse(args)
+	if err != nil {
+		return nil, err
+	}
+
+	if flag.NArg() < 1 {
+		return nil, ErrNotEnoughArguments
+	}
+
+	if flag.NArg() > 1 {
+		return nil, ErrTooManyArguments
+	}
+
+	s := &SyncInit{}
+	s.Remote = flag.Arg(0)
+	return s, nil
+}
+
+func parseSyncPushArgs(args []string) (Command, error) {
+	flag := NewFlagSet("vaulted sync push")
+	err := flag.Parse(args)
+	if err != nil {
+		return nil, err
+	}
+
+	if flag.NArg() > 0 {
+		return nil, ErrTooManyArguments
+	}
+
+	return &SyncPush{}, nil
+}
+
+func parseSyncPullArgs(args []string) (Command, error) {
+	flag := NewFlagSet("vaulted sync pull")
+	err := flag.Parse(args)
+	if err != nil {
+		return nil, err
+	}
+
+	if flag.NArg() > 0 {
+		return nil, ErrTooManyArguments
+	}
+
+	return &SyncPull{}, nil
+}
+
 func parseUpgradeArgs(args []string) (Command, error) {
 	flag := NewFlagSet("vaulted upgrade")
+	flag.Bool("all", false, "Rewrite every vault in the current vault file format")
 	err := flag.Parse(args)
 	if err != nil {
 		return nil, err
@@ -514,7 +1201,26 @@ func parseUpgradeArgs(args []string) (Command, error) {
 		return nil, ErrTooManyArguments
 	}
 
-	return &Upgrade{}, nil
+	u := &Upgrade{}
+	u.All, _ = flag.GetBool("all")
+
+	return u, nil
+}
+
+func parseVerifyArgs(args []string) (Command, error) {
+	flag := NewFlagSet("vaulted verify")
+	flag.Bool("open", false, "Verify each vault can be opened (requesting its password)")
+	flag.Bool("json", false, "Output the report as JSON")
+	err := flag.Parse(args)
+	if err != nil {
+		return nil, err
+	}
+
+	v := &Verify{}
+	v.VaultNames = flag.Args()
+	v.Open, _ = flag.GetBool("open")
+	v.JSON, _ = flag.GetBool("json")
+	return v, nil
 }
 
 func interactiveShellCommand() []string {
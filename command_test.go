@@ -5,8 +5,10 @@ import (
 	"reflect"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/miquella/vaulted/edit"
+	"github.com/miquella/vaulted/lib"
 )
 
 type parseCase struct {
@@ -18,6 +20,11 @@ type parseCase struct {
 }
 
 var (
+	testPublicKey = []byte{
+		62, 69, 57, 248, 102, 205, 18, 228, 77, 238, 20, 123, 255, 54, 133, 196,
+		18, 222, 114, 91, 120, 68, 239, 49, 113, 248, 90, 89, 225, 159, 24, 119,
+	}
+
 	goodParseCases = []parseCase{
 		// Spawn
 		{
@@ -137,6 +144,14 @@ var (
 				VaultName: "one",
 			},
 		},
+		{
+			Args: []string{"add", "--cipher", "xchacha20poly1305", "one"},
+			Command: &edit.Edit{
+				New:       true,
+				VaultName: "one",
+				Cipher:    "xchacha20poly1305",
+			},
+		},
 		{
 			Args:    []string{"add", "--help"},
 			Command: &Help{Subcommand: "add"},
@@ -150,6 +165,36 @@ var (
 			Command: &Help{Subcommand: "create"},
 		},
 
+		// Agent
+		{
+			Args: []string{"agent"},
+			Command: &Agent{
+				Timeout: 15 * time.Minute,
+			},
+		},
+		{
+			Args: []string{"agent", "--timeout", "8h"},
+			Command: &Agent{
+				Timeout: 8 * time.Hour,
+			},
+		},
+		{
+			Args:    []string{"agent", "--help"},
+			Command: &Help{Subcommand: "agent"},
+		},
+
+		// Audit
+		{
+			Args: []string{"audit", "one"},
+			Command: &Audit{
+				VaultName: "one",
+			},
+		},
+		{
+			Args:    []string{"audit", "--help"},
+			Command: &Help{Subcommand: "audit"},
+		},
+
 		// Copy
 		{
 			Args: []string{"cp", "one", "two"},
@@ -174,6 +219,28 @@ var (
 			Command: &Help{Subcommand: "cp"},
 		},
 
+		// Diff
+		{
+			Args: []string{"diff", "one", "two"},
+			Command: &Diff{
+				OldVaultName: "one",
+				NewVaultName: "two",
+			},
+		},
+		{
+			Args: []string{"diff", "--show-secrets", "--json", "one@3", "-"},
+			Command: &Diff{
+				OldVaultName: "one@3",
+				NewVaultName: "-",
+				ShowSecrets:  true,
+				JSON:         true,
+			},
+		},
+		{
+			Args:    []string{"diff", "--help"},
+			Command: &Help{Subcommand: "diff"},
+		},
+
 		// Dump
 		{
 			Args: []string{"dump", "one"},
@@ -193,6 +260,13 @@ var (
 				VaultName: "one",
 			},
 		},
+		{
+			Args: []string{"edit", "--fork", "org"},
+			Command: &edit.Edit{
+				VaultName: "org",
+				Fork:      true,
+			},
+		},
 		{
 			Args:    []string{"edit", "--help"},
 			Command: &Help{Subcommand: "edit"},
@@ -324,6 +398,23 @@ var (
 			Command: &Help{Subcommand: "exec"},
 		},
 
+		// Export
+		{
+			Args:    []string{"export"},
+			Command: &Export{VaultNames: []string{}},
+		},
+		{
+			Args: []string{"export", "-o", "bundle.vaulted", "one", "two"},
+			Command: &Export{
+				VaultNames: []string{"one", "two"},
+				Output:     "bundle.vaulted",
+			},
+		},
+		{
+			Args:    []string{"export", "--help"},
+			Command: &Help{Subcommand: "export"},
+		},
+
 		// Help
 		{
 			Args:    []string{"help", "add"},
@@ -337,6 +428,14 @@ var (
 			Args:    []string{"help", "create"},
 			Command: &Help{Subcommand: "create"},
 		},
+		{
+			Args:    []string{"help", "agent"},
+			Command: &Help{Subcommand: "agent"},
+		},
+		{
+			Args:    []string{"help", "lock"},
+			Command: &Help{Subcommand: "lock"},
+		},
 		{
 			Args:    []string{"help", "cp"},
 			Command: &Help{Subcommand: "cp"},
@@ -361,6 +460,10 @@ var (
 			Args:    []string{"help", "exec"},
 			Command: &Help{Subcommand: "exec"},
 		},
+		{
+			Args:    []string{"help", "history"},
+			Command: &Help{Subcommand: "history"},
+		},
 		{
 			Args:    []string{"help", "list"},
 			Command: &Help{Subcommand: "list"},
@@ -393,6 +496,18 @@ var (
 			Args:    []string{"help", "delete"},
 			Command: &Help{Subcommand: "delete"},
 		},
+		{
+			Args:    []string{"help", "recipients"},
+			Command: &Help{Subcommand: "recipients"},
+		},
+		{
+			Args:    []string{"help", "recovery"},
+			Command: &Help{Subcommand: "recovery"},
+		},
+		{
+			Args:    []string{"help", "rollback"},
+			Command: &Help{Subcommand: "rollback"},
+		},
 		{
 			Args:    []string{"help", "shell"},
 			Command: &Help{Subcommand: "shell"},
@@ -410,6 +525,18 @@ var (
 			Command: &Help{},
 		},
 
+		// History
+		{
+			Args: []string{"history", "one"},
+			Command: &History{
+				VaultName: "one",
+			},
+		},
+		{
+			Args:    []string{"history", "--help"},
+			Command: &Help{Subcommand: "history"},
+		},
+
 		// List
 		{
 			Args:    []string{"ls"},
@@ -445,6 +572,78 @@ var (
 			Args:    []string{"ls", "--help"},
 			Command: &Help{Subcommand: "ls"},
 		},
+		{
+			Args: []string{"ls", "--long"},
+			Command: &List{
+				Long: true,
+			},
+		},
+		{
+			Args: []string{"ls", "-l", "--tag", "prod", "--tag", "aws"},
+			Command: &List{
+				Long: true,
+				Tags: []string{"prod", "aws"},
+			},
+		},
+		{
+			Args: []string{"ls", "--json"},
+			Command: &List{
+				JSON: true,
+			},
+		},
+		{
+			Args:    []string{"--store", "/mnt/usb/vaulted", "ls"},
+			Command: &List{},
+		},
+		{
+			Args: []string{"ls", "prod/"},
+			Command: &List{
+				Prefix: "prod/",
+			},
+		},
+		{
+			Args: []string{"ls", "--tree", "prod/"},
+			Command: &List{
+				Prefix: "prod/",
+				Tree:   true,
+			},
+		},
+
+		// Lock
+		{
+			Args:    []string{"lock"},
+			Command: &Lock{},
+		},
+		{
+			Args:    []string{"lock", "--help"},
+			Command: &Help{Subcommand: "lock"},
+		},
+
+		// Import
+		{
+			Args: []string{"import", "bundle.vaulted"},
+			Command: &Import{
+				Filename: "bundle.vaulted",
+			},
+		},
+		{
+			Args: []string{"import", "--rename", "bundle.vaulted"},
+			Command: &Import{
+				Filename:   "bundle.vaulted",
+				OnConflict: ImportRename,
+			},
+		},
+		{
+			Args: []string{"import", "bundle.vaulted", "--overwrite"},
+			Command: &Import{
+				Filename:   "bundle.vaulted",
+				OnConflict: ImportOverwrite,
+			},
+		},
+		{
+			Args:    []string{"import", "--help"},
+			Command: &Help{Subcommand: "import"},
+		},
 
 		// Load
 		{
@@ -453,11 +652,46 @@ var (
 				VaultName: "one",
 			},
 		},
+		{
+			Args: []string{"load", "--fork", "org"},
+			Command: &Load{
+				VaultName: "org",
+				Fork:      true,
+			},
+		},
 		{
 			Args:    []string{"load", "--help"},
 			Command: &Help{Subcommand: "load"},
 		},
 
+		// Metadata
+		{
+			Args: []string{"metadata", "one"},
+			Command: &Metadata{
+				VaultName: "one",
+			},
+		},
+		{
+			Args: []string{"metadata", "one", "--description", "Production account", "--team", ""},
+			Command: &Metadata{
+				VaultName:   "one",
+				Description: stringPointer("Production account"),
+				Team:        stringPointer(""),
+			},
+		},
+		{
+			Args: []string{"metadata", "--add-tag", "prod", "--add-tag", "aws", "--remove-tag", "dev", "one"},
+			Command: &Metadata{
+				VaultName:  "one",
+				AddTags:    []string{"prod", "aws"},
+				RemoveTags: []string{"dev"},
+			},
+		},
+		{
+			Args:    []string{"metadata", "--help"},
+			Command: &Help{Subcommand: "metadata"},
+		},
+
 		// Passwd
 		{
 			Args: []string{"passwd", "one"},
@@ -466,6 +700,71 @@ var (
 				NewVaultName: "one",
 			},
 		},
+		{
+			Args: []string{"passwd", "--kdf", "argon2id", "one"},
+			Command: &Copy{
+				OldVaultName: "one",
+				NewVaultName: "one",
+				KeyMethod:    "argon2id",
+			},
+		},
+		{
+			Args: []string{"passwd", "--cipher", "aes-256-gcm", "one"},
+			Command: &Copy{
+				OldVaultName: "one",
+				NewVaultName: "one",
+				Cipher:       "aes-256-gcm",
+			},
+		},
+		{
+			Args: []string{"passwd", "--fork", "org"},
+			Command: &Copy{
+				OldVaultName: "org",
+				NewVaultName: "org",
+				Fork:         true,
+			},
+		},
+		{
+			Args: []string{"passwd", "--recalibrate", "one"},
+			Command: &Copy{
+				OldVaultName: "one",
+				NewVaultName: "one",
+				Recalibrate:  true,
+			},
+		},
+		{
+			Args: []string{"passwd", "--add-slot", "one"},
+			Command: &AddKeySlot{
+				VaultName: "one",
+			},
+		},
+		{
+			Args: []string{"passwd", "--add-slot", "--kdf", "pbkdf2-sha512", "one"},
+			Command: &AddKeySlot{
+				VaultName: "one",
+				KeyMethod: "pbkdf2-sha512",
+			},
+		},
+		{
+			Args: []string{"passwd", "--remove-slot", "2", "one"},
+			Command: &RemoveKeySlot{
+				VaultName: "one",
+				Slot:      2,
+			},
+		},
+		{
+			Args: []string{"passwd", "--remove-slot=0", "one"},
+			Command: &RemoveKeySlot{
+				VaultName: "one",
+				Slot:      0,
+			},
+		},
+		{
+			Args: []string{"passwd", "--list-slots", "one"},
+			Command: &ListKeySlots{
+				VaultName: "one",
+			},
+		},
 		{
 			Args: []string{"password", "one"},
 			Command: &Copy{
@@ -532,6 +831,86 @@ var (
 			Command: &Help{Subcommand: "delete"},
 		},
 
+		// Recipients
+		{
+			Args: []string{"recipients", "add", "one", "--name", "teammate", "PkU5+GbNEuRN7hR7/zaFxBLeclt4RO8xcfhaWeGfGHc="},
+			Command: &AddRecipient{
+				VaultName: "one",
+				Recipient: vaulted.Recipient{
+					Name:      "teammate",
+					PublicKey: testPublicKey,
+				},
+			},
+		},
+		{
+			Args: []string{"recipients", "rm", "one", "teammate"},
+			Command: &RemoveRecipient{
+				VaultName: "one",
+				Recipient: "teammate",
+			},
+		},
+		{
+			Args: []string{"recipients", "ls", "one"},
+			Command: &ListRecipients{
+				VaultName: "one",
+			},
+		},
+		{
+			Args:    []string{"recipients", "--help"},
+			Command: &Help{Subcommand: "recipients"},
+		},
+		{
+			Args:    []string{"recipients", "add", "--help"},
+			Command: &Help{Subcommand: "recipients"},
+		},
+
+		// Recovery
+		{
+			Args: []string{"recovery", "split", "one"},
+			Command: &SplitRecovery{
+				VaultName: "one",
+				Shares:    5,
+				Threshold: 3,
+				Format:    RecoveryFormatText,
+			},
+		},
+		{
+			Args: []string{"recovery", "split", "one", "--shares", "3", "--threshold", "2", "--format", "base32"},
+			Command: &SplitRecovery{
+				VaultName: "one",
+				Shares:    3,
+				Threshold: 2,
+				Format:    RecoveryFormatBase32,
+			},
+		},
+		{
+			Args: []string{"recovery", "open", "one"},
+			Command: &OpenRecovery{
+				VaultName: "one",
+			},
+		},
+		{
+			Args:    []string{"recovery", "--help"},
+			Command: &Help{Subcommand: "recovery"},
+		},
+		{
+			Args:    []string{"recovery", "split", "--help"},
+			Command: &Help{Subcommand: "recovery"},
+		},
+
+		// Rollback
+		{
+			Args: []string{"rollback", "one", "3"},
+			Command: &Rollback{
+				VaultName: "one",
+				Revision:  3,
+			},
+		},
+		{
+			Args:    []string{"rollback", "--help"},
+			Command: &Help{Subcommand: "rollback"},
+		},
+
 		// Shell
 		{
 			Args: []string{"shell", "one"},
@@ -580,11 +959,35 @@ var (
 			Command: &Help{Subcommand: "shell"},
 		},
 
+		// Sync
+		{
+			Args: []string{"sync", "init", "ssh://git@example.com/vaults.git"},
+			Command: &SyncInit{
+				Remote: "ssh://git@example.com/vaults.git",
+			},
+		},
+		{
+			Args:    []string{"sync", "push"},
+			Command: &SyncPush{},
+		},
+		{
+			Args:    []string{"sync", "pull"},
+			Command: &SyncPull{},
+		},
+		{
+			Args:    []string{"sync", "--help"},
+			Command: &Help{Subcommand: "sync"},
+		},
+
 		// Upgrade
 		{
 			Args:    []string{"upgrade"},
 			Command: &Upgrade{},
 		},
+		{
+			Args:    []string{"upgrade", "--all"},
+			Command: &Upgrade{All: true},
+		},
 		{
 			Args:    []string{"upgrade", "--help"},
 			Command: &Help{Subcommand: "upgrade"},
@@ -599,6 +1002,28 @@ var (
 			Args:    []string{"-V"},
 			Command: &Version{},
 		},
+
+		// Verify
+		{
+			Args:    []string{"verify"},
+			Command: &Verify{VaultNames: []string{}},
+		},
+		{
+			Args: []string{"verify", "--open", "--json", "one", "two"},
+			Command: &Verify{
+				VaultNames: []string{"one", "two"},
+				Open:       true,
+				JSON:       true,
+			},
+		},
+		{
+			Args:    []string{"verify", "--help"},
+			Command: &Help{Subcommand: "verify"},
+		},
+		{
+			Args:    []string{"help", "verify"},
+			Command: &Help{Subcommand: "verify"},
+		},
 	}
 
 	badParseCases = []parseCase{
@@ -620,6 +1045,28 @@ var (
 		{
 			Args: []string{"add", "one", "two"},
 		},
+		{
+			Args: []string{"add", "--cipher", "rot13", "one"},
+		},
+
+		// Agent
+		{
+			Args: []string{"agent", "one"},
+		},
+		{
+			Args: []string{"agent", "--timeout", "0"},
+		},
+		{
+			Args: []string{"agent", "--timeout", "forever"},
+		},
+
+		// Audit
+		{
+			Args: []string{"audit"},
+		},
+		{
+			Args: []string{"audit", "one", "two"},
+		},
 
 		// Copy
 		{
@@ -641,6 +1088,20 @@ var (
 			Args: []string{"copy", "one", "two", "three"},
 		},
 
+		// Diff
+		{
+			Args: []string{"diff"},
+		},
+		{
+			Args: []string{"diff", "one"},
+		},
+		{
+			Args: []string{"diff", "one", "two", "three"},
+		},
+		{
+			Args: []string{"diff", "-", "-"},
+		},
+
 		// Dump
 		{
 			Args: []string{"dump"},
@@ -712,12 +1173,56 @@ var (
 			Args: []string{"exec", "one", "--no-session", "--refresh", "cmd"},
 		},
 
+		// History
+		{
+			Args: []string{"history"},
+		},
+		{
+			Args: []string{"history", "one", "two"},
+		},
+
 		// List
 		{
-			Args: []string{"ls", "one"},
+			Args: []string{"ls", "one", "two"},
+		},
+		{
+			Args: []string{"list", "one", "two"},
+		},
+		{
+			// may not provide --tree with --long or --json
+			Args: []string{"ls", "--tree", "--long"},
 		},
 		{
-			Args: []string{"list", "one"},
+			Args: []string{"ls", "-t", "--json"},
+		},
+		{
+			// may not provide both --long and --json
+			Args: []string{"ls", "--long", "--json"},
+		},
+
+		// Metadata
+		{
+			Args: []string{"metadata"},
+		},
+		{
+			Args: []string{"metadata", "one", "two"},
+		},
+
+		// Lock
+		{
+			Args: []string{"lock", "one"},
+		},
+
+		// Import
+		{
+			Args: []string{"import"},
+		},
+		{
+			Args: []string{"import", "one", "two"},
+		},
+		{
+			// may only resolve conflicts one way
+			Args: []string{"import", "--skip", "--overwrite", "bundle.vaulted"},
 		},
 
 		// Load
@@ -741,12 +1246,93 @@ var (
 		{
 			Args: []string{"password", "one", "two"},
 		},
+		{
+			Args: []string{"passwd", "--kdf", "bcrypt", "one"},
+		},
+		{
+			Args: []string{"passwd", "--add-slot", "--remove-slot", "1", "one"},
+		},
+		{
+			Args: []string{"passwd", "--add-slot", "--cipher", "aes-256-gcm", "one"},
+		},
+		{
+			Args: []string{"passwd", "--remove-slot", "1", "--fork", "one"},
+		},
+		{
+			Args: []string{"passwd", "--remove-slot", "1", "--kdf", "argon2id", "one"},
+		},
+		{
+			Args: []string{"passwd", "--remove-slot", "one", "one"},
+		},
+		{
+			Args: []string{"passwd", "--add-slot", "--kdf", "bcrypt", "one"},
+		},
+		{
+			Args: []string{"passwd", "--cipher", "rot13", "one"},
+		},
+		{
+			Args: []string{"passwd", "--add-slot", "--recalibrate", "one"},
+		},
 
 		// Remove
 		{
 			Args: []string{"rm"},
 		},
 
+		// Recipients
+		{
+			Args: []string{"recipients"},
+		},
+		{
+			Args: []string{"recipients", "share", "one"},
+		},
+		{
+			Args: []string{"recipients", "add", "one"},
+		},
+		{
+			Args: []string{"recipients", "add", "one", "not-a-public-key"},
+		},
+		{
+			Args: []string{"recipients", "rm", "one"},
+		},
+		{
+			Args: []string{"recipients", "ls"},
+		},
+		{
+			Args: []string{"recipients", "identity", "one"},
+		},
+
+		// Recovery
+		{
+			Args: []string{"recovery"},
+		},
+		{
+			Args: []string{"recovery", "share", "one"},
+		},
+		{
+			Args: []string{"recovery", "split"},
+		},
+		{
+			Args: []string{"recovery", "split", "one", "two"},
+		},
+		{
+			Args: []string{"recovery", "split", "one", "--format", "qr"},
+		},
+		{
+			Args: []string{"recovery", "open"},
+		},
+
+		// Rollback
+		{
+			Args: []string{"rollback", "one"},
+		},
+		{
+			Args: []string{"rollback", "one", "latest"},
+		},
+		{
+			Args: []string{"rollback", "one", "3", "4"},
+		},
+
 		// Shell
 		{
 			Args: []string{"shell"},
@@ -764,10 +1350,30 @@ var (
 			Args: []string{"shell", "one", "--no-session", "--refresh"},
 		},
 
+		// Sync
+		{
+			Args: []string{"sync"},
+		},
+		{
+			Args: []string{"sync", "status"},
+		},
+		{
+			Args: []string{"sync", "init"},
+		},
+		{
+			Args: []string{"sync", "push", "origin"},
+		},
+		{
+			Args: []string{"sync", "pull", "origin"},
+		},
+
 		// Upgrade
 		{
 			Args: []string{"upgrade", "one"},
 		},
+		{
+			Args: []string{"upgrade", "--all", "one"},
+		},
 
 		// Misc
 		{
@@ -858,3 +1464,155 @@ func TestParseArgs(t *testing.T) {
 		}
 	}
 }
+
+func TestParseStoreDirs(t *testing.T) {
+	savedHome, homeSet := os.LookupEnv("VAULTED_HOME")
+	defer func() {
+		if homeSet {
+			os.Setenv("VAULTED_HOME", savedHome)
+		} else {
+			os.Unsetenv("VAULTED_HOME")
+		}
+	}()
+
+	cases := []struct {
+		Home string
+		Args []string
+
+		StoreDirs []string
+	}{
+		{
+			Args:      []string{"ls"},
+			StoreDirs: nil,
+		},
+		{
+			Home:      "/mnt/usb/vaulted:/repo/.vaulted:",
+			Args:      []string{"ls"},
+			StoreDirs: []string{"/mnt/usb/vaulted", "/repo/.vaulted"},
+		},
+		{
+			Home:      "/mnt/usb/vaulted",
+			Args:      []string{"--store", "/repo/.vaulted", "--store", "/shared", "ls"},
+			StoreDirs: []string{"/repo/.vaulted", "/shared"},
+		},
+		{
+			Home:      "/mnt/usb/vaulted",
+			Args:      []string{"--store", "/repo/.vaulted", "-n", "one"},
+			StoreDirs: []string{"/repo/.vaulted"},
+		},
+	}
+
+	for _, c := range cases {
+		os.Setenv("VAULTED_HOME", c.Home)
+
+		_, err := ParseArgs(c.Args)
+		if err != nil {
+			t.Fatalf("Failed to parse %#v: %v", c.Args, err)
+		}
+
+		if !reflect.DeepEqual(c.StoreDirs, StoreDirs) {
+			t.Errorf("Expected stores %#v for %#v, got %#v", c.StoreDirs, c.Args, StoreDirs)
+		}
+	}
+}
+
+func TestNewBackend(t *testing.T) {
+	backend, ok := newBackend([]string{"/mnt/usb/vaulted", "/repo/.vaulted"}).(*vaulted.FileBackend)
+	if !ok {
+		t.Fatal("Expected a file backend")
+	}
+
+	if backend.VaultDir != "/mnt/usb/vaulted/vaults" || backend.CacheDir != "/mnt/usb/vaulted/cache" || backend.HistoryDir != "/mnt/usb/vaulted/history" {
+		t.Errorf("Expected everything to be written to the first store, got %#v", backend)
+	}
+	if !reflect.DeepEqual([]string{"/repo/.vaulted/vaults"}, backend.ReadOnlyVaultDirs) {
+		t.Errorf("Expected the other stores to be searched, got %#v", backend.ReadOnlyVaultDirs)
+	}
+}
+
+func stringPointer(s string) *string {
+	return &s
+}
+
+func TestParsePasswordPolicy(t *testing.T) {
+	savedMinLength, minLengthSet := os.LookupEnv("VAULTED_PASSWORD_MIN_LENGTH")
+	defer func() {
+		if minLengthSet {
+			os.Setenv("VAULTED_PASSWORD_MIN_LENGTH", savedMinLength)
+		} else {
+			os.Unsetenv("VAULTED_PASSWORD_MIN_LENGTH")
+		}
+	}()
+
+	os.Unsetenv("VAULTED_PASSWORD_MIN_LENGTH")
+	_, err := ParseArgs([]string{"add", "one"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	policy, ok := PasswordPolicy.(*vaulted.StrengthPolicy)
+	if !ok || policy.MinLength != vaulted.DefaultMinPasswordLength {
+		t.Fatalf("Expected the default password policy, got %#v", PasswordPolicy)
+	}
+
+	os.Setenv("VAULTED_PASSWORD_MIN_LENGTH", "12")
+	_, err = ParseArgs([]string{"add", "one"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	policy, ok = PasswordPolicy.(*vaulted.StrengthPolicy)
+	if !ok || policy.MinLength != 12 {
+		t.Fatalf("Expected a minimum length of 12, got %#v", PasswordPolicy)
+	}
+
+	_, err = ParseArgs([]string{"--allow-weak-password", "add", "one"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if PasswordPolicy != nil {
+		t.Fatalf("Expected --allow-weak-password to disable the password policy, got %#v", PasswordPolicy)
+	}
+
+	os.Setenv("VAULTED_PASSWORD_MIN_LENGTH", "twelve")
+	_, err = ParseArgs([]string{"add", "one"})
+	if err != ErrInvalidPasswordPolicy {
+		t.Fatalf("Expected %v, got %v", ErrInvalidPasswordPolicy, err)
+	}
+}
+
+func TestParseKDFTarget(t *testing.T) {
+	savedTarget, targetSet := os.LookupEnv("VAULTED_KDF_TARGET")
+	defer func() {
+		if targetSet {
+			os.Setenv("VAULTED_KDF_TARGET", savedTarget)
+		} else {
+			os.Unsetenv("VAULTED_KDF_TARGET")
+		}
+		vaulted.KeyDerivationTarget = vaulted.DefaultKeyDerivationTarget
+	}()
+
+	os.Unsetenv("VAULTED_KDF_TARGET")
+	_, err := ParseArgs([]string{"add", "one"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if vaulted.KeyDerivationTarget != vaulted.DefaultKeyDerivationTarget {
+		t.Fatalf("Expected the default key derivation target, got %v", vaulted.KeyDerivationTarget)
+	}
+
+	os.Setenv("VAULTED_KDF_TARGET", "1.5s")
+	_, err = ParseArgs([]string{"add", "one"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if vaulted.KeyDerivationTarget != 1500*time.Millisecond {
+		t.Fatalf("Expected a key derivation target of 1.5s, got %v", vaulted.KeyDerivationTarget)
+	}
+
+	for _, target := range []string{"fast", "-1s"} {
+		os.Setenv("VAULTED_KDF_TARGET", target)
+		_, err = ParseArgs([]string{"add", "one"})
+		if err != ErrInvalidKDFTarget {
+			t.Fatalf("Expected %v for %q, got %v", ErrInvalidKDFTarget, target, err)
+		}
+	}
+}
@@ -1,24 +1,123 @@
 package main
 
 import (
+	"errors"
+	"fmt"
+	"os"
+
 	"github.com/miquella/vaulted/lib"
 )
 
+var (
+	ErrSealedForRecipients = errors.New("Vault is sealed for recipients (see `vaulted recipients`). Use --kdf to seal it with a password instead.")
+	ErrUsesKeySlots        = errors.New("Vault uses key slots. Use --add-slot and --remove-slot to change its passwords, or --kdf to seal it with a single password instead.")
+)
+
 type Copy struct {
 	OldVaultName string
 	NewVaultName string
+
+	KeyMethod   string
+	Cipher      string
+	Fork        bool
+	Recalibrate bool
 }
 
 func (c *Copy) Run(store vaulted.Store) error {
-	vault, _, err := store.OpenVault(c.OldVaultName)
+	err := checkWritable(store, c.NewVaultName, c.Fork)
+	if err != nil {
+		return err
+	}
+
+	vault, password, err := store.OpenVault(c.OldVaultName)
+	if err != nil {
+		return err
+	}
+
+	slots := false
+	if c.OldVaultName == c.NewVaultName && c.KeyMethod == "" {
+		recipients, err := store.VaultRecipients(c.OldVaultName)
+		if err != nil {
+			return err
+		}
+		if len(recipients) > 0 {
+			return ErrSealedForRecipients
+		}
+
+		keySlots, err := store.KeySlots(c.OldVaultName)
+		if err != nil {
+			return err
+		}
+		if len(keySlots) > 0 {
+			if c.Cipher == "" || c.Recalibrate {
+				return ErrUsesKeySlots
+			}
+
+			// the existing password opens the vault's master key, so every
+			// slot keeps working with the new cipher
+			slots = true
+		}
+	}
+
+	if !slots {
+		password, err = store.Steward().GetPassword(vaulted.SealOperation, c.NewVaultName)
+		if err != nil {
+			return err
+		}
+	}
+
+	options := vaulted.SealOptions{
+		KeyMethod:   c.KeyMethod,
+		Method:      c.Cipher,
+		Recalibrate: c.Recalibrate,
+		Fork:        c.Fork,
+		Operation:   "passwd",
+	}
+
+	if c.OldVaultName != c.NewVaultName {
+		options.Operation = "cp"
+
+		// an existing vault is replaced by the copy (its passwords don't open
+		// the copy)
+		options.Replace = true
+
+		// copies are described the same as the original (metadata changed
+		// without opening the original isn't authenticated, so it isn't
+		// copied)
+		metadata, err := store.VaultMetadata(c.OldVaultName)
+		if err != nil {
+			return err
+		}
+		options.Metadata = &vaulted.VaultMetadata{}
+		if metadata.Unverified {
+			fmt.Fprintf(os.Stderr, "The metadata of vault '%s' was changed without opening it, so it was not copied\n", c.OldVaultName)
+		} else {
+			options.Metadata = metadata
+		}
+	}
+
+	err = store.SealVaultWithOptions(vault, c.NewVaultName, password, options)
 	if err != nil {
 		return err
 	}
 
-	err = store.SealVault(vault, c.NewVaultName)
+	return nil
+}
+
+// checkWritable returns ErrReadOnlyVault (before any passwords are prompted
+// for) if the vault is a system vault that isn't being forked.
+func checkWritable(store vaulted.Store, name string, fork bool) error {
+	if fork || !store.VaultExists(name) {
+		return nil
+	}
+
+	source, err := store.VaultSource(name)
 	if err != nil {
 		return err
 	}
+	if source == vaulted.SystemVault {
+		return vaulted.ErrReadOnlyVault
+	}
 
 	return nil
 }
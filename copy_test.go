@@ -14,6 +14,7 @@ func TestCopy(t *testing.T) {
 		},
 	}
 	store.Passwords["old"] = "one old password"
+	store.Metadata["old"] = &vaulted.VaultMetadata{Description: "Described"}
 
 	c := Copy{
 		OldVaultName: "old",
@@ -36,6 +37,34 @@ func TestCopy(t *testing.T) {
 	if store.Passwords["old"] == store.Passwords["new"] {
 		t.Fatal("Passwords should be different, but aren't!")
 	}
+
+	if store.Metadata["new"] == nil || store.Metadata["new"].Description != "Described" {
+		t.Fatal("The vault metadata was not copied")
+	}
+}
+
+func TestCopyOverExisting(t *testing.T) {
+	store := NewTestStore()
+	store.Vaults["old"] = &vaulted.Vault{}
+	store.Vaults["new"] = &vaulted.Vault{}
+	store.Slots["new"] = []*vaulted.KeySlot{{ID: 0}, {ID: 1}}
+	store.Recoveries["new"] = &vaulted.Recovery{}
+
+	c := Copy{
+		OldVaultName: "old",
+		NewVaultName: "new",
+	}
+	err := c.Run(store)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, exists := store.Slots["new"]; exists {
+		t.Fatal("The key slots of the replaced vault were kept")
+	}
+	if _, exists := store.Recoveries["new"]; exists {
+		t.Fatal("The recovery key of the replaced vault was kept")
+	}
 }
 
 func TestCopyToSelf(t *testing.T) {
@@ -69,3 +98,34 @@ func TestCopyToSelf(t *testing.T) {
 		t.Fatal("Passwords should be different, but aren't!")
 	}
 }
+
+func TestCopyToSelfSystemVault(t *testing.T) {
+	store := NewTestStore()
+	store.Vaults["org"] = &vaulted.Vault{}
+	store.Passwords["org"] = "system password"
+	store.Sources["org"] = vaulted.SystemVault
+
+	c := Copy{
+		OldVaultName: "org",
+		NewVaultName: "org",
+	}
+	err := c.Run(store)
+	if err != vaulted.ErrReadOnlyVault {
+		t.Fatalf("Expected %v, got %v", vaulted.ErrReadOnlyVault, err)
+	}
+	if store.Passwords["org"] != "system password" {
+		t.Fatal("The system vault should not have been changed")
+	}
+
+	c.Fork = true
+	err = c.Run(store)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if store.Sources["org"] != vaulted.ShadowingVault {
+		t.Fatalf("Expected the vault to be forked, got %s", store.Sources["org"])
+	}
+	if store.Passwords["org"] != "prompted seal password" {
+		t.Fatal("The forked vault should have the new password")
+	}
+}
@@ -0,0 +1,142 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/miquella/vaulted/lib"
+)
+
+const (
+	// EX_DIFFERENCES is returned by diff when the vaults differ
+	EX_DIFFERENCES = 1
+)
+
+type Diff struct {
+	OldVaultName string
+	NewVaultName string
+	ShowSecrets  bool
+	JSON         bool
+}
+
+func (d *Diff) Run(store vaulted.Store) error {
+	oldVault, err := d.openVault(store, d.OldVaultName)
+	if err != nil {
+		return err
+	}
+
+	newVault, err := d.openVault(store, d.NewVaultName)
+	if err != nil {
+		return err
+	}
+
+	differences := vaulted.DiffVaults(oldVault, newVault, vaulted.DiffOptions{
+		ShowSecrets: d.ShowSecrets,
+	})
+
+	if d.JSON {
+		if differences == nil {
+			differences = []*vaulted.VaultDifference{}
+		}
+		output, err := json.MarshalIndent(differences, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(output))
+	} else {
+		for _, difference := range differences {
+			printVaultDifference(difference)
+		}
+	}
+
+	if len(differences) > 0 {
+		return ErrorWithExitCode{ErrNoError, EX_DIFFERENCES}
+	}
+	return nil
+}
+
+// openVault opens a vault by name, a revision of a vault (as name@revision),
+// or reads a vault as JSON from stdin (when name is "-").
+func (d *Diff) openVault(store vaulted.Store, name string) (*vaulted.Vault, error) {
+	if name == "-" {
+		jvault, err := ioutil.ReadAll(os.Stdin)
+		if err != nil {
+			return nil, err
+		}
+
+		vault := &vaulted.Vault{}
+		err = json.Unmarshal(jvault, vault)
+		if err != nil {
+			return nil, err
+		}
+		return vault, nil
+	}
+
+	vaultName, revision, isRevision := splitRevision(name)
+	if store.VaultExists(name) || !isRevision {
+		vault, _, err := store.OpenVault(name)
+		return vault, err
+	}
+
+	_, password, err := store.OpenVault(vaultName)
+	if err != nil {
+		return nil, err
+	}
+
+	vault, err := store.OpenVaultRevision(vaultName, revision, password)
+	if err == vaulted.ErrIncorrectPassword {
+		// the revision was sealed before the password was changed
+		revisionName := fmt.Sprintf("%s (revision %d)", vaultName, revision)
+		var revisionPassword string
+		revisionPassword, err = store.Steward().GetPassword(vaulted.OpenOperation, revisionName)
+		if err != nil {
+			return nil, err
+		}
+
+		vault, err = store.OpenVaultRevision(vaultName, revision, revisionPassword)
+	}
+	return vault, err
+}
+
+// splitRevision splits name@revision into the vault's name and the revision.
+func splitRevision(name string) (string, int, bool) {
+	i := strings.LastIndex(name, "@")
+	if i < 1 {
+		return name, 0, false
+	}
+
+	revision, err := strconv.Atoi(name[i+1:])
+	if err != nil || revision < 0 {
+		return name, 0, false
+	}
+
+	return name[:i], revision, true
+}
+
+func printVaultDifference(difference *vaulted.VaultDifference) {
+	field := difference.Field
+	if difference.Key != "" {
+		field = fmt.Sprintf("%s.%s", field, difference.Key)
+	}
+
+	switch difference.Change {
+	case vaulted.DiffAdded:
+		printDifferenceLine("+", field, difference.New)
+	case vaulted.DiffRemoved:
+		printDifferenceLine("-", field, difference.Old)
+	default:
+		printDifferenceLine("~", field, fmt.Sprintf("%s -> %s", difference.Old, difference.New))
+	}
+}
+
+func printDifferenceLine(marker, field, value string) {
+	if value == "" {
+		fmt.Printf("%s %s\n", marker, field)
+	} else {
+		fmt.Printf("%s %s: %s\n", marker, field, value)
+	}
+}
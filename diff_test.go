@@ -0,0 +1,149 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/miquella/vaulted/lib"
+)
+
+func TestDiff(t *testing.T) {
+	store := NewTestStore()
+	store.Vaults["one"] = &vaulted.Vault{
+		Vars: map[string]string{
+			"SHARED": "one",
+			"ONLY":   "one",
+		},
+	}
+	store.Vaults["two"] = &vaulted.Vault{
+		Vars: map[string]string{
+			"SHARED": "two",
+		},
+		AWSKey: &vaulted.AWSKey{
+			AWSCredentials: vaulted.AWSCredentials{
+				ID:     "AKIAEXAMPLEEXAMPLE12",
+				Secret: "secret",
+			},
+		},
+	}
+
+	d := Diff{
+		OldVaultName: "one",
+		NewVaultName: "two",
+	}
+	var err error
+	output := CaptureStdout(func() {
+		err = d.Run(store)
+	})
+	if exitErr, ok := err.(ErrorWithExitCode); !ok || exitErr.ExitCode != EX_DIFFERENCES {
+		t.Fatalf("Expected exit code %d, got %v", EX_DIFFERENCES, err)
+	}
+
+	expected := "- vars.ONLY: (redacted)\n" +
+		"~ vars.SHARED: (redacted) -> (redacted)\n" +
+		"+ aws_key.id: ...LE12\n" +
+		"+ aws_key.secret: (redacted)\n"
+	if string(output) != expected {
+		t.Fatalf("Expected %q, got %q", expected, output)
+	}
+
+	d.ShowSecrets = true
+	output = CaptureStdout(func() {
+		d.Run(store)
+	})
+	if !bytes.Contains(output, []byte("~ vars.SHARED: one -> two\n")) {
+		t.Fatalf("Expected secrets to be shown, got %q", output)
+	}
+
+	d.NewVaultName = "one"
+	output = CaptureStdout(func() {
+		err = d.Run(store)
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(output) != 0 {
+		t.Fatalf("Expected no output, got %q", output)
+	}
+}
+
+func TestDiffStdin(t *testing.T) {
+	store := NewTestStore()
+	store.Vaults["one"] = &vaulted.Vault{
+		Vars: map[string]string{
+			"TEST": "one",
+		},
+	}
+
+	d := Diff{
+		OldVaultName: "one",
+		NewVaultName: "-",
+		JSON:         true,
+	}
+	var err error
+	var output []byte
+	WriteStdin([]byte(`{"vars": {"TEST": "one", "NEW": "value"}}`), func() {
+		output = CaptureStdout(func() {
+			err = d.Run(store)
+		})
+	})
+	if exitErr, ok := err.(ErrorWithExitCode); !ok || exitErr.ExitCode != EX_DIFFERENCES {
+		t.Fatalf("Expected exit code %d, got %v", EX_DIFFERENCES, err)
+	}
+
+	var differences []*vaulted.VaultDifference
+	err = json.Unmarshal(output, &differences)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(differences) != 1 || differences[0].Change != vaulted.DiffAdded || differences[0].Key != "NEW" {
+		t.Fatalf("Unexpected differences: %s", output)
+	}
+}
+
+func TestDiffRevision(t *testing.T) {
+	store := NewTestStore()
+	store.Vaults["one"] = &vaulted.Vault{
+		Vars: map[string]string{
+			"TEST": "CURRENT",
+		},
+	}
+	store.Passwords["one"] = "current password"
+	store.RevisionVaults["one"] = map[int]*vaulted.Vault{
+		1: {
+			Vars: map[string]string{
+				"TEST": "PREVIOUS",
+			},
+		},
+	}
+	store.RevisionPasswords["one"] = map[int]string{
+		1: "prompted open password",
+	}
+
+	d := Diff{
+		OldVaultName: "one@1",
+		NewVaultName: "one",
+		ShowSecrets:  true,
+	}
+	var err error
+	output := CaptureStdout(func() {
+		err = d.Run(store)
+	})
+	if exitErr, ok := err.(ErrorWithExitCode); !ok || exitErr.ExitCode != EX_DIFFERENCES {
+		t.Fatalf("Expected exit code %d, got %v", EX_DIFFERENCES, err)
+	}
+
+	expected := "~ vars.TEST: PREVIOUS -> CURRENT\n"
+	if string(output) != expected {
+		t.Fatalf("Expected %q, got %q", expected, output)
+	}
+
+	d.OldVaultName = "one@2"
+	CaptureStdout(func() {
+		err = d.Run(store)
+	})
+	if err != vaulted.ErrRevisionNotExist {
+		t.Fatalf("Expected %v, got %v", vaulted.ErrRevisionNotExist, err)
+	}
+}
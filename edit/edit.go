@@ -16,11 +16,14 @@ var (
 type Edit struct {
 	New       bool
 	VaultName string
+	Cipher    string
+	Fork      bool
 }
 
 func (e *Edit) Run(store vaulted.Store) error {
 	var password string
 	var vault *vaulted.Vault
+	var revision int
 	var err error
 
 	if e.New {
@@ -48,6 +51,21 @@ func (e *Edit) Run(store vaulted.Store) error {
 		}
 
 	} else {
+		revision, err = store.VaultRevision(e.VaultName)
+		if err != nil {
+			return err
+		}
+
+		if !e.Fork {
+			source, err := store.VaultSource(e.VaultName)
+			if err != nil {
+				return err
+			}
+			if source == vaulted.SystemVault {
+				return vaulted.ErrReadOnlyVault
+			}
+		}
+
 		vault, password, err = store.OpenVault(e.VaultName)
 		if err != nil {
 			return err
@@ -60,9 +78,29 @@ func (e *Edit) Run(store vaulted.Store) error {
 	}
 
 	if e.New {
-		err = store.SealVault(vault, e.VaultName)
+		password, err = store.Steward().GetPassword(vaulted.SealOperation, e.VaultName)
+		if err != nil {
+			return err
+		}
+
+		err = store.SealVaultWithOptions(vault, e.VaultName, password, vaulted.SealOptions{
+			Method:    e.Cipher,
+			Operation: "add",
+		})
 	} else {
-		err = store.SealVaultWithPassword(vault, e.VaultName, password)
+		options := vaulted.SealOptions{
+			Fork:      e.Fork,
+			Operation: "edit",
+		}
+		options.Metadata, err = e.confirmPendingMetadata(store)
+		if err != nil {
+			return err
+		}
+
+		err = store.SealVaultIfUnmodified(vault, e.VaultName, password, revision, options)
+		if err == vaulted.ErrVaultModified {
+			err = e.resolveConflict(store, vault, password, options)
+		}
 	}
 	if err != nil {
 		return err
@@ -82,3 +120,69 @@ func (e *Edit) edit(name string, v *vaulted.Vault) error {
 
 	return mainMenu.Handler()
 }
+
+// confirmPendingMetadata returns the vault's metadata when it was changed
+// without opening the vault and the user chooses to keep the changes (so they
+// are authenticated when the vault is sealed), or nil to discard them.
+func (e *Edit) confirmPendingMetadata(store vaulted.Store) (*vaulted.VaultMetadata, error) {
+	metadata, err := store.VaultMetadata(e.VaultName)
+	if err != nil || !metadata.Unverified {
+		return nil, err
+	}
+
+	pendingMenu := &menu.PendingMetadataMenu{
+		VaultName: e.VaultName,
+		Metadata:  metadata,
+	}
+	err = pendingMenu.Handler()
+	if err != nil || !pendingMenu.Keep {
+		return nil, err
+	}
+
+	return metadata, nil
+}
+
+// resolveConflict lets the user overwrite a vault that was modified since it
+// was opened. The vault is sealed with its current password (which changes
+// when the password of the vault was changed in the meantime), and only if it
+// wasn't modified again while the menu was shown.
+func (e *Edit) resolveConflict(store vaulted.Store, v *vaulted.Vault, password string, options vaulted.SealOptions) error {
+	// the password is requested again when it no longer opens the vault
+	openSaved := func() (*vaulted.Vault, error) {
+		saved, _, err := store.OpenVaultWithPassword(e.VaultName, password)
+		if err == vaulted.ErrIncorrectPassword {
+			saved, password, err = store.OpenVault(e.VaultName)
+		}
+		return saved, err
+	}
+
+	for {
+		revision, err := store.VaultRevision(e.VaultName)
+		if err != nil {
+			return err
+		}
+
+		conflictMenu := &menu.ConflictMenu{
+			Menu: menu.Menu{
+				Vault: v,
+			},
+			VaultName: e.VaultName,
+			LoadSaved: openSaved,
+		}
+
+		err = conflictMenu.Handler()
+		if err != nil {
+			return err
+		}
+
+		_, err = openSaved()
+		if err != nil {
+			return err
+		}
+
+		err = store.SealVaultIfUnmodified(v, e.VaultName, password, revision, options)
+		if err != vaulted.ErrVaultModified {
+			return err
+		}
+	}
+}
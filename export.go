@@ -0,0 +1,57 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/miquella/vaulted/lib"
+)
+
+type Export struct {
+	VaultNames []string
+	Output     string
+}
+
+func (e *Export) Run(store vaulted.Store) error {
+	names := e.VaultNames
+	if len(names) == 0 {
+		var err error
+		names, err = store.ListVaults()
+		if err != nil {
+			return err
+		}
+		sort.Strings(names)
+	}
+
+	bundleName := "bundle"
+	if e.Output != "" {
+		bundleName = filepath.Base(e.Output)
+	}
+
+	passphrase, err := store.Steward().GetPassword(vaulted.SealOperation, bundleName)
+	if err != nil {
+		return err
+	}
+
+	bundle, err := store.ExportVaults(names, passphrase)
+	if err != nil {
+		return err
+	}
+
+	if e.Output == "" {
+		_, err = os.Stdout.Write(bundle)
+		return err
+	}
+
+	err = ioutil.WriteFile(e.Output, bundle, 0600)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Exported %d vault(s) to %s\n", len(names), e.Output)
+
+	return nil
+}
@@ -0,0 +1,66 @@
+package main
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/miquella/vaulted/lib"
+)
+
+func TestExport(t *testing.T) {
+	dir, err := ioutil.TempDir("", "vaulted-export-test-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	store := NewTestStore()
+	store.Vaults["one"] = &vaulted.Vault{}
+	store.Vaults["two"] = &vaulted.Vault{}
+
+	e := Export{
+		Output: filepath.Join(dir, "bundle.vaulted"),
+	}
+	output := CaptureStdout(func() {
+		err = e.Run(store)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := "Exported 2 vault(s) to " + e.Output + "\n"
+	if string(output) != expected {
+		t.Fatalf("Expected %q, got %q", expected, output)
+	}
+
+	info, err := os.Stat(e.Output)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Mode().Perm() != 0600 {
+		t.Fatalf("Expected the bundle to be private, got %v", info.Mode())
+	}
+
+	bundle, err := ioutil.ReadFile(e.Output)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var exported map[string]interface{}
+	err = json.Unmarshal(bundle, &exported)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expectedExport := map[string]interface{}{
+		"vaults":     []interface{}{"one", "two"},
+		"passphrase": "prompted seal password",
+	}
+	if !reflect.DeepEqual(expectedExport, exported) {
+		t.Fatalf("Expected %#v, got %#v", expectedExport, exported)
+	}
+}
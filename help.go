@@ -19,25 +19,38 @@ var (
 	ErrHelp = errors.New("help requested")
 
 	HelpAliases = map[string]string{
-		"add":      "add",
-		"create":   "add",
-		"new":      "add",
-		"cp":       "cp",
-		"copy":     "cp",
-		"dump":     "dump",
-		"edit":     "edit",
-		"env":      "env",
-		"exec":     "exec",
-		"ls":       "ls",
-		"list":     "ls",
-		"load":     "load",
-		"passwd":   "passwd",
-		"password": "passwd",
-		"rm":       "rm",
-		"delete":   "rm",
-		"remove":   "rm",
-		"shell":    "shell",
-		"upgrade":  "upgrade",
+		"add":        "add",
+		"create":     "add",
+		"new":        "add",
+		"agent":      "agent",
+		"audit":      "audit",
+		"cp":         "cp",
+		"copy":       "cp",
+		"diff":       "diff",
+		"dump":       "dump",
+		"edit":       "edit",
+		"env":        "env",
+		"exec":       "exec",
+		"export":     "export",
+		"history":    "history",
+		"import":     "import",
+		"lock":       "lock",
+		"ls":         "ls",
+		"list":       "ls",
+		"load":       "load",
+		"metadata":   "metadata",
+		"passwd":     "passwd",
+		"password":   "passwd",
+		"rm":         "rm",
+		"delete":     "rm",
+		"remove":     "rm",
+		"recipients": "recipients",
+		"recovery":   "recovery",
+		"rollback":   "rollback",
+		"shell":      "shell",
+		"sync":       "sync",
+		"upgrade":    "upgrade",
+		"verify":     "verify",
 	}
 )
 
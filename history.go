@@ -0,0 +1,45 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/miquella/vaulted/lib"
+)
+
+type History struct {
+	VaultName string
+}
+
+func (h *History) Run(store vaulted.Store) error {
+	entries, err := store.VaultHistory(h.VaultName)
+	if err != nil {
+		return err
+	}
+
+	current, err := store.VaultRevision(h.VaultName)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("%-8s  %-19s  %s\n", "REVISION", "DATE", "OPERATION")
+	for i := len(entries) - 1; i >= 0; i-- {
+		entry := entries[i]
+
+		date := "unknown"
+		if !entry.Timestamp.IsZero() {
+			date = entry.Timestamp.Local().Format("2006-01-02 15:04:05")
+		}
+
+		operation := entry.Operation
+		if operation == "" {
+			operation = "unknown"
+		}
+		if entry.Revision == current {
+			operation = fmt.Sprintf("%s (current)", operation)
+		}
+
+		fmt.Printf("%-8d  %-19s  %s\n", entry.Revision, date, operation)
+	}
+
+	return nil
+}
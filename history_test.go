@@ -0,0 +1,44 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/miquella/vaulted/lib"
+)
+
+func TestHistory(t *testing.T) {
+	store := NewTestStore()
+	store.Vaults["one"] = &vaulted.Vault{}
+	store.History["one"] = []*vaulted.HistoryEntry{
+		{
+			Revision: 0,
+		},
+		{
+			Revision:  1,
+			Operation: "edit",
+			Timestamp: time.Date(2018, 5, 1, 12, 30, 0, 0, time.Local),
+		},
+	}
+
+	output := CaptureStdout(func() {
+		h := History{
+			VaultName: "one",
+		}
+		err := h.Run(store)
+		if err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	// the test store always reports revision 0 as the current revision
+	expected := []byte(
+		"REVISION  DATE                 OPERATION\n" +
+			"1         2018-05-01 12:30:00  edit\n" +
+			"0         unknown              unknown (current)\n",
+	)
+	if bytes.Compare(output, expected) != 0 {
+		t.Fatalf("Expected:\n%s\nGot:\n%s", expected, output)
+	}
+}
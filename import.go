@@ -0,0 +1,109 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+
+	"github.com/miquella/vaulted/lib"
+)
+
+const (
+	ImportRename    = "rename"
+	ImportSkip      = "skip"
+	ImportOverwrite = "overwrite"
+)
+
+type Import struct {
+	Filename string
+
+	// OnConflict is how vaults that already exist are imported (ImportRename,
+	// ImportSkip or ImportOverwrite). When empty, nothing is imported if any of
+	// the vaults already exist.
+	OnConflict string
+}
+
+func (i *Import) Run(store vaulted.Store) error {
+	bundle, err := ioutil.ReadFile(i.Filename)
+	if err != nil {
+		return err
+	}
+
+	vaults, err := i.openBundle(store, bundle)
+	if err != nil {
+		return err
+	}
+
+	if i.OnConflict == "" {
+		var existing []string
+		for _, vault := range vaults {
+			if store.VaultExists(vault.Name) {
+				existing = append(existing, vault.Name)
+			}
+		}
+		if len(existing) > 0 {
+			return fmt.Errorf("Vaults already exist: %s (use --rename, --skip or --overwrite to import them)", strings.Join(existing, ", "))
+		}
+	}
+
+	for _, vault := range vaults {
+		name := vault.Name
+		if store.VaultExists(name) {
+			switch i.OnConflict {
+			case ImportSkip:
+				fmt.Printf("Skipped '%s' (already exists)\n", name)
+				continue
+
+			case ImportRename:
+				name = availableVaultName(store, name)
+			}
+		}
+
+		err = store.ImportVault(vault, name)
+		if err != nil {
+			return err
+		}
+
+		if name == vault.Name {
+			fmt.Printf("Imported '%s'\n", name)
+		} else {
+			fmt.Printf("Imported '%s' as '%s'\n", vault.Name, name)
+		}
+	}
+
+	return nil
+}
+
+func (i *Import) openBundle(store vaulted.Store, bundle []byte) ([]*vaulted.BundledVault, error) {
+	steward := store.Steward()
+
+	maxTries := 1
+	if getMax, ok := steward.(vaulted.StewardMaxTries); ok {
+		maxTries = getMax.GetMaxOpenTries()
+	}
+	for try := 0; try < maxTries; try++ {
+		passphrase, err := steward.GetPassword(vaulted.OpenOperation, filepath.Base(i.Filename))
+		if err != nil {
+			return nil, err
+		}
+
+		vaults, err := vaulted.OpenBundle(bundle, passphrase)
+		if err != vaulted.ErrIncorrectPassword {
+			return vaults, err
+		}
+	}
+
+	return nil, vaulted.ErrIncorrectPassword
+}
+
+// availableVaultName returns the first of name-2, name-3, ... that isn't
+// already used by a vault.
+func availableVaultName(store vaulted.Store, name string) string {
+	for n := 2; ; n++ {
+		candidate := fmt.Sprintf("%s-%d", name, n)
+		if !store.VaultExists(candidate) {
+			return candidate
+		}
+	}
+}
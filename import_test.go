@@ -0,0 +1,136 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/miquella/vaulted/lib"
+)
+
+func TestImport(t *testing.T) {
+	filename := writeTestBundle(t, "prompted open password", "one", "two")
+	defer os.RemoveAll(filepath.Dir(filename))
+
+	store := NewTestStore()
+
+	i := Import{Filename: filename}
+	var err error
+	output := CaptureStdout(func() {
+		err = i.Run(store)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := "Imported 'one'\nImported 'two'\n"
+	if string(output) != expected {
+		t.Fatalf("Expected %q, got %q", expected, output)
+	}
+
+	if store.Operations["one"] != "import" || store.Operations["two"] != "import" {
+		t.Fatal("The vaults were not imported")
+	}
+}
+
+func TestImportConflicts(t *testing.T) {
+	filename := writeTestBundle(t, "prompted open password", "one", "two")
+	defer os.RemoveAll(filepath.Dir(filename))
+
+	cases := map[string]struct {
+		Output   string
+		Imported []string
+	}{
+		"": {
+			Output: "",
+		},
+		ImportSkip: {
+			Output:   "Skipped 'one' (already exists)\nImported 'two'\n",
+			Imported: []string{"two"},
+		},
+		ImportRename: {
+			Output:   "Imported 'one' as 'one-3'\nImported 'two'\n",
+			Imported: []string{"one-3", "two"},
+		},
+		ImportOverwrite: {
+			Output:   "Imported 'one'\nImported 'two'\n",
+			Imported: []string{"one", "two"},
+		},
+	}
+
+	for onConflict, expected := range cases {
+		store := NewTestStore()
+		store.Vaults["one"] = &vaulted.Vault{}
+		store.Vaults["one-2"] = &vaulted.Vault{}
+
+		i := Import{
+			Filename:   filename,
+			OnConflict: onConflict,
+		}
+		var err error
+		output := CaptureStdout(func() {
+			err = i.Run(store)
+		})
+		if onConflict == "" {
+			if err == nil {
+				t.Fatal("Expected importing existing vaults to fail")
+			}
+		} else if err != nil {
+			t.Fatalf("%s: %v", onConflict, err)
+		}
+
+		if string(output) != expected.Output {
+			t.Fatalf("%s: expected %q, got %q", onConflict, expected.Output, output)
+		}
+
+		if len(store.Operations) != len(expected.Imported) {
+			t.Fatalf("%s: expected %v to be imported, got %v", onConflict, expected.Imported, store.Operations)
+		}
+		for _, name := range expected.Imported {
+			if store.Operations[name] != "import" {
+				t.Fatalf("%s: expected %s to be imported, got %v", onConflict, name, store.Operations)
+			}
+		}
+	}
+}
+
+func TestImportIncorrectPassphrase(t *testing.T) {
+	filename := writeTestBundle(t, "another passphrase", "one")
+	defer os.RemoveAll(filepath.Dir(filename))
+
+	i := Import{Filename: filename}
+	err := i.Run(NewTestStore())
+	if err != vaulted.ErrIncorrectPassword {
+		t.Fatalf("Expected %v, got %v", vaulted.ErrIncorrectPassword, err)
+	}
+}
+
+func writeTestBundle(t *testing.T, passphrase string, names ...string) string {
+	store := vaulted.New(vaulted.NewStaticSteward("password"), vaulted.NewMemoryBackend())
+	for _, name := range names {
+		err := store.SealVaultWithOptions(&vaulted.Vault{}, name, "password", vaulted.SealOptions{KeyMethod: "pbkdf2-sha512"})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	bundle, err := store.ExportVaults(names, passphrase)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dir, err := ioutil.TempDir("", "vaulted-import-test-")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	filename := filepath.Join(dir, "bundle.vaulted")
+	err = ioutil.WriteFile(filename, bundle, 0600)
+	if err != nil {
+		os.RemoveAll(dir)
+		t.Fatal(err)
+	}
+
+	return filename
+}
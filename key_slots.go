@@ -0,0 +1,80 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/miquella/vaulted/lib"
+)
+
+type AddKeySlot struct {
+	VaultName string
+	KeyMethod string
+}
+
+func (a *AddKeySlot) Run(store vaulted.Store) error {
+	_, password, err := store.OpenVault(a.VaultName)
+	if err != nil {
+		return err
+	}
+
+	newPassword, err := store.Steward().GetPassword(vaulted.SealOperation, a.VaultName)
+	if err != nil {
+		return err
+	}
+
+	id, err := store.AddKeySlot(a.VaultName, password, newPassword, a.KeyMethod)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Added key slot %d to vault '%s'\n", id, a.VaultName)
+
+	return nil
+}
+
+type RemoveKeySlot struct {
+	VaultName string
+	Slot      int
+}
+
+func (r *RemoveKeySlot) Run(store vaulted.Store) error {
+	_, password, err := store.OpenVault(r.VaultName)
+	if err != nil {
+		return err
+	}
+
+	err = store.RemoveKeySlot(r.VaultName, password, r.Slot)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Removed key slot %d from vault '%s'\n", r.Slot, r.VaultName)
+
+	return nil
+}
+
+type ListKeySlots struct {
+	VaultName string
+}
+
+func (l *ListKeySlots) Run(store vaulted.Store) error {
+	slots, err := store.KeySlots(l.VaultName)
+	if err != nil {
+		return err
+	}
+
+	if len(slots) == 0 {
+		fmt.Printf("Vault '%s' does not use key slots.\n", l.VaultName)
+		return nil
+	}
+
+	for _, slot := range slots {
+		method := ""
+		if slot.Key != nil {
+			method = slot.Key.Method
+		}
+		fmt.Printf("%d %s\n", slot.ID, method)
+	}
+
+	return nil
+}
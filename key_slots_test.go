@@ -0,0 +1,119 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/miquella/vaulted/lib"
+)
+
+func TestAddKeySlot(t *testing.T) {
+	store := NewTestStore()
+	store.Vaults["one"] = &vaulted.Vault{}
+	store.Passwords["one"] = "personal"
+
+	a := AddKeySlot{
+		VaultName: "one",
+		KeyMethod: "pbkdf2-sha512",
+	}
+	err := a.Run(store)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	slots := store.Slots["one"]
+	if len(slots) != 2 || slots[1].Key.Method != "pbkdf2-sha512" {
+		t.Fatalf("Expected a pbkdf2-sha512 key slot to be added, got %#v", slots)
+	}
+}
+
+func TestRemoveKeySlot(t *testing.T) {
+	store := NewTestStore()
+	store.Vaults["one"] = &vaulted.Vault{}
+	store.Passwords["one"] = "personal"
+	store.Slots["one"] = []*vaulted.KeySlot{
+		{ID: 0, Key: &vaulted.VaultKey{Method: "argon2id"}},
+		{ID: 1, Key: &vaulted.VaultKey{Method: "argon2id"}},
+	}
+
+	r := RemoveKeySlot{
+		VaultName: "one",
+		Slot:      0,
+	}
+	err := r.Run(store)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(store.Slots["one"]) != 1 || store.Slots["one"][0].ID != 1 {
+		t.Fatalf("Expected only key slot 1 to remain, got %#v", store.Slots["one"])
+	}
+
+	err = r.Run(store)
+	if err != vaulted.ErrKeySlotNotExist {
+		t.Fatalf("Expected %v, got %v", vaulted.ErrKeySlotNotExist, err)
+	}
+
+	r.Slot = 1
+	err = r.Run(store)
+	if err != vaulted.ErrLastKeySlot {
+		t.Fatalf("Expected %v, got %v", vaulted.ErrLastKeySlot, err)
+	}
+}
+
+func TestListKeySlots(t *testing.T) {
+	store := NewTestStore()
+	store.Vaults["one"] = &vaulted.Vault{}
+	store.Slots["one"] = []*vaulted.KeySlot{
+		{ID: 0, Key: &vaulted.VaultKey{Method: "argon2id"}},
+		{ID: 2, Key: &vaulted.VaultKey{Method: "pbkdf2-sha512"}},
+	}
+
+	l := ListKeySlots{VaultName: "one"}
+	output := CaptureStdout(func() {
+		err := l.Run(store)
+		if err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	expected := "0 argon2id\n2 pbkdf2-sha512\n"
+	if string(output) != expected {
+		t.Fatalf("Expected %q, got %q", expected, output)
+	}
+}
+
+func TestPasswdWithKeySlots(t *testing.T) {
+	store := NewTestStore()
+	store.Vaults["one"] = &vaulted.Vault{}
+	store.Passwords["one"] = "personal"
+	store.Slots["one"] = []*vaulted.KeySlot{
+		{ID: 0, Key: &vaulted.VaultKey{Method: "argon2id"}},
+	}
+
+	c := Copy{
+		OldVaultName: "one",
+		NewVaultName: "one",
+	}
+	err := c.Run(store)
+	if err != ErrUsesKeySlots {
+		t.Fatalf("Expected %v, got %v", ErrUsesKeySlots, err)
+	}
+
+	// changing the cipher keeps the password (and therefore the key slots)
+	c.Cipher = "aes-256-gcm"
+	err = c.Run(store)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if store.Passwords["one"] != "personal" {
+		t.Fatalf("Expected the password to be kept, got %q", store.Passwords["one"])
+	}
+
+	// the cost of the slots' key derivation can't be recalibrated
+	c.Recalibrate = true
+	err = c.Run(store)
+	if err != ErrUsesKeySlots {
+		t.Fatalf("Expected %v, got %v", ErrUsesKeySlots, err)
+	}
+}
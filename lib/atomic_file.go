@@ -0,0 +1,59 @@
+package vaulted
+
+import (
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+// atomicFileWriter returns the writer the content of a temporary file is
+// written to (tests replace it to simulate failing writes).
+var atomicFileWriter = func(f *os.File) io.Writer {
+	return f
+}
+
+// writeFileAtomic replaces filename with the content produced by write.
+//
+// The content is written to a temporary file in the same directory, synced to
+// disk and then renamed over filename. A failure (or crash) part way through
+// leaves the original file untouched instead of truncated.
+func writeFileAtomic(filename string, perm os.FileMode, write func(w io.Writer) error) error {
+	dir := filepath.Dir(filename)
+
+	f, err := ioutil.TempFile(dir, "."+filepath.Base(filename)+".tmp")
+	if err != nil {
+		return err
+	}
+
+	err = f.Chmod(perm)
+	if err == nil {
+		err = write(atomicFileWriter(f))
+	}
+	if err == nil {
+		err = f.Sync()
+	}
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(f.Name(), filename)
+	}
+	if err != nil {
+		os.Remove(f.Name())
+		return err
+	}
+
+	return syncDir(dir)
+}
+
+// syncDir flushes directory entries (e.g. a rename) to disk.
+func syncDir(dir string) error {
+	d, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	defer d.Close()
+
+	return d.Sync()
+}
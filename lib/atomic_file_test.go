@@ -0,0 +1,177 @@
+package vaulted
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestWriteFileAtomic(t *testing.T) {
+	dir, err := ioutil.TempDir("", "vaulted")
+	if err != nil {
+		t.Fatalf("failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	filename := filepath.Join(dir, "file")
+	for _, content := range []string{"original", "replaced"} {
+		err = writeFileAtomic(filename, 0600, func(w io.Writer) error {
+			_, err := io.WriteString(w, content)
+			return err
+		})
+		if err != nil {
+			t.Fatalf("failed to write file: %v", err)
+		}
+
+		assertFileContent(t, filename, []byte(content))
+	}
+
+	info, err := os.Stat(filename)
+	if err != nil {
+		t.Fatalf("failed to stat file: %v", err)
+	}
+	if info.Mode().Perm() != 0600 {
+		t.Errorf("expected: %v, got: %v", os.FileMode(0600), info.Mode().Perm())
+	}
+}
+
+func TestWriteFileAtomicFailure(t *testing.T) {
+	dir, err := ioutil.TempDir("", "vaulted")
+	if err != nil {
+		t.Fatalf("failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	filename := filepath.Join(dir, "file")
+	err = ioutil.WriteFile(filename, []byte("original"), 0600)
+	if err != nil {
+		t.Fatalf("failed to write file: %v", err)
+	}
+
+	writeErr := errors.New("disk full")
+	err = writeFileAtomic(filename, 0600, func(w io.Writer) error {
+		io.WriteString(w, "partial")
+		return writeErr
+	})
+	if err != writeErr {
+		t.Fatalf("expected: %v, got: %v", writeErr, err)
+	}
+
+	assertFileContent(t, filename, []byte("original"))
+	assertDirEntries(t, dir, 1)
+}
+
+// failingWriter fails once limit bytes have been written (like a full disk).
+type failingWriter struct {
+	w     io.Writer
+	limit int
+}
+
+var errDiskFull = errors.New("disk full")
+
+func (f *failingWriter) Write(p []byte) (int, error) {
+	if len(p) > f.limit {
+		n, _ := f.w.Write(p[:f.limit])
+		f.limit = 0
+		return n, errDiskFull
+	}
+
+	f.limit -= len(p)
+	return f.w.Write(p)
+}
+
+// failWrites makes the writes of atomic files fail part way through for the
+// rest of the test.
+func failWrites(t *testing.T) {
+	t.Cleanup(func() {
+		atomicFileWriter = func(f *os.File) io.Writer {
+			return f
+		}
+	})
+
+	atomicFileWriter = func(f *os.File) io.Writer {
+		return &failingWriter{w: f, limit: 8}
+	}
+}
+
+func TestWriteVaultFileFailure(t *testing.T) {
+	dir, err := ioutil.TempDir("", "vaulted")
+	if err != nil {
+		t.Fatalf("failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+	backend := NewFileBackend(dir)
+
+	err = writeVaultFile(backend, "vault", &VaultFile{Method: "secretbox"})
+	if err != nil {
+		t.Fatalf("failed to write vault file: %v", err)
+	}
+
+	filename := filepath.Join(dir, "vaults", "vault")
+	original, err := ioutil.ReadFile(filename)
+	if err != nil {
+		t.Fatalf("failed to read vault file: %v", err)
+	}
+
+	failWrites(t)
+	err = writeVaultFile(backend, "vault", &VaultFile{Method: "aes-256-gcm", Revision: 2})
+	if err != errDiskFull {
+		t.Fatalf("expected: %v, got: %v", errDiskFull, err)
+	}
+
+	assertFileContent(t, filename, original)
+	assertDirEntries(t, filepath.Join(dir, "vaults"), 1)
+}
+
+func TestWriteSessionFileFailure(t *testing.T) {
+	dir, err := ioutil.TempDir("", "vaulted")
+	if err != nil {
+		t.Fatalf("failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+	backend := NewFileBackend(dir)
+
+	err = writeSessionFile(backend, "vault", &SessionFile{Method: "secretbox"})
+	if err != nil {
+		t.Fatalf("failed to write session file: %v", err)
+	}
+
+	filename := filepath.Join(dir, "cache", "vault")
+	original, err := ioutil.ReadFile(filename)
+	if err != nil {
+		t.Fatalf("failed to read session file: %v", err)
+	}
+
+	failWrites(t)
+	err = writeSessionFile(backend, "vault", &SessionFile{Method: "aes-256-gcm"})
+	if err != errDiskFull {
+		t.Fatalf("expected: %v, got: %v", errDiskFull, err)
+	}
+
+	assertFileContent(t, filename, original)
+	assertDirEntries(t, filepath.Join(dir, "cache"), 1)
+}
+
+func assertFileContent(t *testing.T, filename string, expected []byte) {
+	content, err := ioutil.ReadFile(filename)
+	if err != nil {
+		t.Fatalf("failed to read file: %v", err)
+	}
+	if !bytes.Equal(expected, content) {
+		t.Fatalf("expected: %q, got: %q", expected, content)
+	}
+}
+
+func assertDirEntries(t *testing.T, dir string, expected int) {
+	entries, err := ioutil.ReadDir(dir)
+	if err != nil {
+		t.Fatalf("failed to read dir: %v", err)
+	}
+	if len(entries) != expected {
+		t.Fatalf("expected %d entries (temporary files should be removed), got %d", expected, len(entries))
+	}
+}
@@ -0,0 +1,545 @@
+package vaulted
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"os"
+	"strconv"
+	"time"
+
+	"golang.org/x/crypto/chacha20poly1305"
+	"golang.org/x/crypto/curve25519"
+)
+
+const (
+	AuditOpen          = "open"
+	AuditSeal          = "seal"
+	AuditRemove        = "remove"
+	AuditCreateSession = "create-session"
+	AuditCachedSession = "cached-session"
+	AuditAssumeRole    = "assume-role"
+
+	auditKeyInfo   = "vaulted audit key"
+	auditChainInfo = "vaulted audit chain"
+)
+
+var (
+	ErrNoAuditKey = errors.New("Vault has no audit log yet (vaults keep an audit log once they are sealed again)")
+
+	errInvalidAuditHead = errors.New("Invalid audit log head")
+)
+
+// AuditKey is the X25519 key pair a vault's audit log is encrypted with.
+// Entries are sealed for the public key, so they are written without the
+// vault being opened (e.g. when opening it fails). The private key is
+// wrapped by a key derived from the vault's master key, so only the vault's
+// owner is able to read the audit log.
+//
+// The key pair is kept each time the vault is sealed (as long as the vault
+// was opened first), so its audit log remains readable when its password
+// changes.
+type AuditKey struct {
+	PublicKey  []byte `json:"public_key"`
+	WrappedKey []byte `json:"wrapped_key"`
+}
+
+// AuditEntry records an operation performed on a vault.
+type AuditEntry struct {
+	Timestamp time.Time `json:"timestamp"`
+	Operation string    `json:"operation"`
+	Role      string    `json:"role,omitempty"`
+	Command   []string  `json:"command,omitempty"`
+	Success   bool      `json:"success"`
+	Error     string    `json:"error,omitempty"`
+}
+
+// AuditLog is a vault's audit log, oldest entry first.
+//
+// Entries that were sealed for a previous audit key of the vault (e.g. before
+// the vault was removed and created again) can't be read, and are nil.
+// BrokenAt is the index of the first entry that doesn't follow the entry
+// before it (meaning entries were removed, reordered or modified), len(Entries)
+// when entries were removed from the end of the log, or -1 when the whole log
+// verifies.
+//
+// Entries appended while the vault is open also record the head of the log
+// (the number of entries and the hash of the last one), authenticated by the
+// vault's audit key, so the entries up to the head can't be removed or
+// modified without the log failing to verify. Entries appended since (e.g.
+// failed attempts to open the vault) are covered the next time the vault is
+// opened. A log whose head is missing doesn't verify (BrokenAt is 0), since
+// the head is only recorded again when the vault gets a new audit key.
+type AuditLog struct {
+	Entries  []*AuditEntry
+	BrokenAt int
+}
+
+// auditRecord is an encrypted entry, as stored in the audit log (one record
+// per line). Each record includes the hash of the line before it, which is
+// authenticated along with the ciphertext, forming a hash chain.
+type auditRecord struct {
+	Previous   []byte        `json:"previous"`
+	Key        *RecipientKey `json:"key"`
+	Ciphertext []byte        `json:"ciphertext"`
+}
+
+// auditHead is the head of an audit log: the number of entries in the log and
+// the hash of the last one, along with a MAC keyed by the vault's audit key
+// (see AuditKey.chainKey).
+type auditHead struct {
+	Entries int    `json:"entries"`
+	Hash    []byte `json:"hash"`
+	MAC     []byte `json:"mac"`
+}
+
+// newAuditKey returns the audit key of a vault being sealed with masterKey.
+// The existing key pair is kept when the previous master key is known,
+// otherwise a new key pair is generated.
+func newAuditKey(existing *AuditKey, previousMasterKey, masterKey []byte) (*AuditKey, error) {
+	var identity *Identity
+	if existing != nil && previousMasterKey != nil {
+		identity, _ = existing.identity(previousMasterKey)
+	}
+	if identity == nil {
+		var err error
+		identity, err = GenerateIdentity()
+		if err != nil {
+			return nil, err
+		}
+	}
+	defer zero(identity.PrivateKey)
+
+	wrappingKey, err := subKey(masterKey, auditKeyInfo)
+	if err != nil {
+		return nil, err
+	}
+	defer zero(wrappingKey)
+
+	aead, err := chacha20poly1305.NewX(wrappingKey)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	_, err = rand.Read(nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AuditKey{
+		PublicKey:  identity.PublicKey,
+		WrappedKey: aead.Seal(nonce, nonce, identity.PrivateKey, identity.PublicKey),
+	}, nil
+}
+
+// identity unwraps the audit key pair using the vault's master key.
+func (ak *AuditKey) identity(masterKey []byte) (*Identity, error) {
+	wrappingKey, err := subKey(masterKey, auditKeyInfo)
+	if err != nil {
+		return nil, err
+	}
+	defer zero(wrappingKey)
+
+	aead, err := chacha20poly1305.NewX(wrappingKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(ak.WrappedKey) < aead.NonceSize() {
+		return nil, ErrInvalidKeyConfig
+	}
+	nonce := ak.WrappedKey[:aead.NonceSize()]
+	privateKey, err := aead.Open(nil, nonce, ak.WrappedKey[aead.NonceSize():], ak.PublicKey)
+	if err != nil {
+		return nil, ErrInvalidKeyConfig
+	}
+
+	var private, public [32]byte
+	copy(private[:], privateKey)
+	curve25519.ScalarBaseMult(&public, &private)
+	zero(private[:])
+	if !bytes.Equal(public[:], ak.PublicKey) {
+		return nil, ErrInvalidKeyConfig
+	}
+
+	return &Identity{
+		PrivateKey: privateKey,
+		PublicKey:  ak.PublicKey,
+	}, nil
+}
+
+// audit appends an entry to a vault's audit log. Vaults without an audit key
+// (vaults that don't exist, or haven't been sealed since audit logs were
+// introduced) aren't audited. Errors are ignored, so auditing never prevents
+// an operation.
+func (s *store) audit(name, operation, role string, err error) {
+	vf, readErr := readVaultFile(s.backend, name)
+	if readErr != nil {
+		return
+	}
+
+	s.auditVaultFile(name, vf, operation, role, err)
+}
+
+// auditVaultFile appends an entry to the audit log of a vault file. The head
+// of the log is recorded as well when the vault's master key is known (the
+// vault was opened or sealed by the store).
+func (s *store) auditVaultFile(name string, vf *VaultFile, operation, role string, err error) {
+	if vf.Audit == nil {
+		return
+	}
+
+	var chainKey []byte
+	if masterKey := s.cachedKey(name, vf); masterKey != nil {
+		chainKey, _ = vf.Audit.chainKey(masterKey)
+		zero(masterKey)
+		defer zero(chainKey)
+	}
+
+	entry := &AuditEntry{
+		Timestamp: time.Now().UTC(),
+		Operation: operation,
+		Role:      role,
+		Command:   os.Args,
+		Success:   err == nil,
+	}
+	if err != nil {
+		entry.Error = err.Error()
+	}
+
+	appendAuditEntry(s.backend, name, vf.Audit.PublicKey, chainKey, entry)
+}
+
+// appendAuditEntry appends an entry to an audit log, recording the new head
+// of the log when chainKey is given.
+func appendAuditEntry(backend Backend, name string, publicKey, chainKey []byte, entry *AuditEntry) error {
+	unlock, err := lockBlob(backend, AuditLogBlob, name)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	log, err := backend.Get(AuditLogBlob, name)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	record := &auditRecord{
+		Previous: lastAuditRecordHash(log),
+	}
+
+	plaintext, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	// each entry is encrypted with its own key, so a zero nonce is safe
+	entryKey := make([]byte, chacha20poly1305.KeySize)
+	_, err = rand.Read(entryKey)
+	if err != nil {
+		return err
+	}
+	defer zero(entryKey)
+
+	recipientKeys, err := wrapRecipientKeys(entryKey, []Recipient{{Name: "audit", PublicKey: publicKey}})
+	if err != nil {
+		return err
+	}
+	record.Key = recipientKeys[0]
+
+	aead, err := chacha20poly1305.New(entryKey)
+	if err != nil {
+		return err
+	}
+	record.Ciphertext = aead.Seal(nil, make([]byte, aead.NonceSize()), plaintext, record.Previous)
+
+	line, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+
+	line = append(line, '\n')
+	err = appendBlob(backend, AuditLogBlob, name, log, line)
+	if err != nil {
+		return err
+	}
+
+	if chainKey == nil {
+		return nil
+	}
+
+	// the head only moves forward while the log still follows it, so entries
+	// removed or modified before the head remain detected (a missing head is
+	// only recorded again for a new audit key, see resetAuditHead)
+	head, err := readAuditHead(backend, name, chainKey)
+	if err != nil || head == nil || !head.follows(log) {
+		return err
+	}
+
+	return writeAuditHead(backend, name, chainKey, append(log, line...))
+}
+
+// resetAuditHead records the head of a vault's audit log as it is, for a new
+// audit key (whose key pair can't verify the previous head).
+func resetAuditHead(backend Backend, name string, chainKey []byte) error {
+	unlock, err := lockBlob(backend, AuditLogBlob, name)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	log, err := backend.Get(AuditLogBlob, name)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	return writeAuditHead(backend, name, chainKey, log)
+}
+
+func writeAuditHead(backend Backend, name string, chainKey, log []byte) error {
+	lines := auditLogLines(log)
+	head := &auditHead{
+		Entries: len(lines),
+	}
+	if len(lines) > 0 {
+		sum := sha256.Sum256(lines[len(lines)-1])
+		head.Hash = sum[:]
+	}
+	head.MAC = head.mac(chainKey)
+
+	data, err := json.Marshal(head)
+	if err != nil {
+		return err
+	}
+	return backend.Put(AuditHeadBlob, name, data)
+}
+
+// chainKey returns the key the head of the audit log is authenticated with,
+// derived from the audit key pair (so it is kept along with the key pair when
+// the vault's password changes).
+func (ak *AuditKey) chainKey(masterKey []byte) ([]byte, error) {
+	identity, err := ak.identity(masterKey)
+	if err != nil {
+		return nil, err
+	}
+	defer zero(identity.PrivateKey)
+
+	return subKey(identity.PrivateKey, auditChainInfo)
+}
+
+func (h *auditHead) mac(chainKey []byte) []byte {
+	mac := hmac.New(sha256.New, chainKey)
+	mac.Write([]byte(strconv.Itoa(h.Entries) + "\n"))
+	mac.Write(h.Hash)
+	return mac.Sum(nil)
+}
+
+// follows returns whether an audit log still contains the entries covered by
+// the head.
+func (h *auditHead) follows(log []byte) bool {
+	if h.Entries == 0 {
+		return true
+	}
+
+	lines := auditLogLines(log)
+	if len(lines) < h.Entries {
+		return false
+	}
+
+	sum := sha256.Sum256(lines[h.Entries-1])
+	return bytes.Equal(sum[:], h.Hash)
+}
+
+// readAuditHead reads the head of a vault's audit log (nil is returned when
+// there is none). errInvalidAuditHead is returned when the head isn't
+// authenticated by chainKey.
+func readAuditHead(backend Backend, name string, chainKey []byte) (*auditHead, error) {
+	data, err := backend.Get(AuditHeadBlob, name)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	head := &auditHead{}
+	err = json.Unmarshal(data, head)
+	if err != nil || !hmac.Equal(head.MAC, head.mac(chainKey)) {
+		return nil, errInvalidAuditHead
+	}
+
+	return head, nil
+}
+
+// lastAuditRecordHash returns the hash of the last record in an audit log
+// (nil for an empty log).
+func lastAuditRecordHash(log []byte) []byte {
+	lines := auditLogLines(log)
+	if len(lines) == 0 {
+		return nil
+	}
+
+	sum := sha256.Sum256(lines[len(lines)-1])
+	return sum[:]
+}
+
+// auditLogLines splits an audit log into its records (one per line).
+func auditLogLines(log []byte) [][]byte {
+	log = bytes.TrimRight(log, "\n")
+	if len(log) == 0 {
+		return nil
+	}
+
+	return bytes.Split(log, []byte("\n"))
+}
+
+// VaultAuditLog opens a vault and reads its audit log, verifying the chain of
+// entries (see AuditLog).
+func (s *store) VaultAuditLog(name string) (*AuditLog, error) {
+	_, _, err := s.OpenVault(name)
+	if err != nil {
+		return nil, err
+	}
+
+	vf, err := readVaultFile(s.backend, name)
+	if err != nil {
+		return nil, err
+	}
+	if vf.Audit == nil {
+		return nil, ErrNoAuditKey
+	}
+
+	masterKey := s.cachedKey(name, vf)
+	if masterKey == nil {
+		return nil, ErrInvalidKeyConfig
+	}
+	defer zero(masterKey)
+
+	identity, err := vf.Audit.identity(masterKey)
+	if err != nil {
+		return nil, err
+	}
+	defer zero(identity.PrivateKey)
+
+	chainKey, err := vf.Audit.chainKey(masterKey)
+	if err != nil {
+		return nil, err
+	}
+	defer zero(chainKey)
+
+	unlock, err := lockBlob(s.backend, AuditLogBlob, name)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	data, err := s.backend.Get(AuditLogBlob, name)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	head, err := readAuditHead(s.backend, name, chainKey)
+	if err == errInvalidAuditHead || (err == nil && head == nil) {
+		// the head was replaced or removed, so none of the entries can be
+		// trusted
+		log := readAuditLog(data, identity, nil)
+		log.BrokenAt = 0
+		return log, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return readAuditLog(data, identity, head), nil
+}
+
+// readAuditLog reads and verifies an audit log, given its head (nil to only
+// verify the chain of entries).
+func readAuditLog(data []byte, identity *Identity, head *auditHead) *AuditLog {
+	log := &AuditLog{BrokenAt: -1}
+	broken := func() {
+		if log.BrokenAt < 0 {
+			log.BrokenAt = len(log.Entries)
+		}
+	}
+
+	var previous, headHash []byte
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(nil, 1<<20)
+	for scanner.Scan() {
+		line := scanner.Bytes()
+
+		record := auditRecord{}
+		err := json.Unmarshal(line, &record)
+		if err != nil || record.Key == nil || !bytes.Equal(record.Previous, previous) {
+			broken()
+		}
+
+		sum := sha256.Sum256(line)
+		previous = sum[:]
+		if head != nil && len(log.Entries)+1 == head.Entries {
+			headHash = previous
+		}
+
+		if err != nil || record.Key == nil || !bytes.Equal(record.Key.PublicKey, identity.PublicKey) {
+			// sealed for a previous audit key
+			log.Entries = append(log.Entries, nil)
+			continue
+		}
+
+		entry, err := openAuditRecord(&record, identity)
+		if err != nil {
+			broken()
+		}
+		log.Entries = append(log.Entries, entry)
+	}
+	if scanner.Err() != nil {
+		broken()
+	}
+
+	if head != nil {
+		switch {
+		case len(log.Entries) < head.Entries:
+			// entries were removed from the end of the log
+			broken()
+		case !bytes.Equal(headHash, head.Hash):
+			// the entries covered by the head were replaced
+			if log.BrokenAt < 0 || log.BrokenAt >= head.Entries {
+				log.BrokenAt = 0
+			}
+		}
+	}
+
+	return log
+}
+
+func openAuditRecord(record *auditRecord, identity *Identity) (*AuditEntry, error) {
+	entryKey, err := unwrapRecipientKey([]*RecipientKey{record.Key}, identity)
+	if err != nil {
+		return nil, err
+	}
+	defer zero(entryKey)
+
+	aead, err := chacha20poly1305.New(entryKey)
+	if err != nil {
+		return nil, err
+	}
+
+	plaintext, err := aead.Open(nil, make([]byte, aead.NonceSize()), record.Ciphertext, record.Previous)
+	if err != nil {
+		return nil, err
+	}
+
+	entry := &AuditEntry{}
+	err = json.Unmarshal(plaintext, entry)
+	if err != nil {
+		return nil, err
+	}
+
+	return entry, nil
+}
@@ -0,0 +1,301 @@
+package vaulted_test
+
+import (
+	"bytes"
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/miquella/vaulted/lib"
+)
+
+func auditOperations(log *vaulted.AuditLog) []string {
+	var operations []string
+	for _, entry := range log.Entries {
+		if entry == nil {
+			operations = append(operations, "?")
+		} else if entry.Success {
+			operations = append(operations, entry.Operation)
+		} else {
+			operations = append(operations, entry.Operation+" failed")
+		}
+	}
+	return operations
+}
+
+func TestAuditLog(t *testing.T) {
+	backend := vaulted.NewMemoryBackend()
+	store := vaulted.New(vaulted.NewStaticSteward("password"), backend)
+
+	err := store.SealVault(&vaulted.Vault{}, "one")
+	if err != nil {
+		t.Fatalf("failed to seal vault: %v", err)
+	}
+
+	_, _, err = vaulted.New(vaulted.NewStaticSteward("wrong"), backend).OpenVault("one")
+	if err != vaulted.ErrIncorrectPassword {
+		t.Fatalf("expected %v, got %v", vaulted.ErrIncorrectPassword, err)
+	}
+
+	vault, password, err := store.OpenVault("one")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = store.CreateSession(vault, "one", password)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = store.GetSession(vault, "one", password)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// changing the password keeps the audit log readable
+	err = store.SealVaultWithPassword(vault, "one", "new password")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	log, err := vaulted.New(vaulted.NewStaticSteward("new password"), backend).VaultAuditLog("one")
+	if err != nil {
+		t.Fatalf("failed to read audit log: %v", err)
+	}
+	if log.BrokenAt != -1 {
+		t.Fatalf("expected the audit log to verify, broken at %d", log.BrokenAt)
+	}
+
+	expected := []string{"seal", "open failed", "open", "create-session", "cached-session", "seal", "open"}
+	operations := auditOperations(log)
+	if !reflect.DeepEqual(expected, operations) {
+		t.Fatalf("expected %v, got %v", expected, operations)
+	}
+	if log.Entries[1].Error != vaulted.ErrIncorrectPassword.Error() {
+		t.Fatalf("expected the failure to be recorded, got %#v", log.Entries[1])
+	}
+	if len(log.Entries[0].Command) == 0 || log.Entries[0].Timestamp.IsZero() {
+		t.Fatalf("expected the command line and time to be recorded, got %#v", log.Entries[0])
+	}
+}
+
+func TestAuditLogTampering(t *testing.T) {
+	backend := vaulted.NewMemoryBackend()
+	store := vaulted.New(vaulted.NewStaticSteward("password"), backend)
+
+	err := store.SealVault(&vaulted.Vault{}, "one")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 2; i++ {
+		_, _, err = store.OpenVault("one")
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	data, err := backend.Get(vaulted.AuditLogBlob, "one")
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := bytes.SplitAfter(data, []byte("\n"))
+
+	// removing an entry breaks the chain
+	tampered := append(append([]byte{}, lines[0]...), lines[2]...)
+	err = backend.Put(vaulted.AuditLogBlob, "one", tampered)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	log, err := store.VaultAuditLog("one")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if log.BrokenAt != 1 {
+		t.Fatalf("expected the chain to break at entry 1, got %d", log.BrokenAt)
+	}
+}
+
+func TestAuditLogTruncated(t *testing.T) {
+	backend := vaulted.NewMemoryBackend()
+	store := vaulted.New(vaulted.NewStaticSteward("password"), backend)
+
+	err := store.SealVault(&vaulted.Vault{}, "one")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, _, err = store.OpenVault("one")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := backend.Get(vaulted.AuditLogBlob, "one")
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := bytes.SplitAfter(data, []byte("\n"))
+
+	// removing the last entry leaves a valid chain, but not the recorded head
+	// (which isn't moved forward by the entries appended since)
+	err = backend.Put(vaulted.AuditLogBlob, "one", lines[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < 2; i++ {
+		log, err := store.VaultAuditLog("one")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if log.BrokenAt != 0 {
+			t.Fatalf("expected the log to fail to verify, broken at %d", log.BrokenAt)
+		}
+	}
+}
+
+func TestAuditLogRemovedHead(t *testing.T) {
+	backend := vaulted.NewMemoryBackend()
+	store := vaulted.New(vaulted.NewStaticSteward("password"), backend)
+
+	err := store.SealVault(&vaulted.Vault{}, "one")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 4; i++ {
+		_, _, err = store.OpenVault("one")
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	data, err := backend.Get(vaulted.AuditLogBlob, "one")
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := bytes.SplitAfter(data, []byte("\n"))
+
+	// the last entries are removed along with the head
+	err = backend.Put(vaulted.AuditLogBlob, "one", bytes.Join(lines[:3], nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = backend.Delete(vaulted.AuditHeadBlob, "one")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the entries appended since don't record a new head
+	for i := 0; i < 2; i++ {
+		log, err := store.VaultAuditLog("one")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if log.BrokenAt != 0 {
+			t.Fatalf("expected the log to fail to verify, broken at %d", log.BrokenAt)
+		}
+	}
+}
+
+func TestAuditLogForgedHead(t *testing.T) {
+	backend := vaulted.NewMemoryBackend()
+	store := vaulted.New(vaulted.NewStaticSteward("password"), backend)
+
+	err := store.SealVault(&vaulted.Vault{}, "one")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = backend.Put(vaulted.AuditHeadBlob, "one", []byte(`{"entries":1,"hash":"AAAA","mac":"AAAA"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	log, err := store.VaultAuditLog("one")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if log.BrokenAt != 0 {
+		t.Fatalf("expected the log to fail to verify, broken at %d", log.BrokenAt)
+	}
+}
+
+func TestAuditLogConcurrentAppends(t *testing.T) {
+	backend := vaulted.NewFileBackend(t.TempDir())
+	store := vaulted.New(vaulted.NewStaticSteward("password"), backend)
+
+	err := store.SealVault(&vaulted.Vault{}, "one")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	const appends = 10
+	var wg sync.WaitGroup
+	errs := make(chan error, 2*appends)
+	for i := 0; i < 2; i++ {
+		// each store appends as a separate process would
+		store := vaulted.New(vaulted.NewStaticSteward("password"), backend)
+		_, _, err := store.OpenVault("one")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < appends; j++ {
+				_, _, err := store.UnlockVault("one")
+				errs <- err
+			}
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	log, err := store.VaultAuditLog("one")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := 1 + 2 + 2*appends + 1; len(log.Entries) != expected {
+		t.Fatalf("expected %d entries, got %d", expected, len(log.Entries))
+	}
+	if log.BrokenAt != -1 {
+		t.Fatalf("expected the audit log to verify, broken at %d", log.BrokenAt)
+	}
+}
+
+func TestAuditLogRemovedVault(t *testing.T) {
+	backend := vaulted.NewMemoryBackend()
+	store := vaulted.New(vaulted.NewStaticSteward("password"), backend)
+
+	err := store.SealVault(&vaulted.Vault{}, "one")
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = store.RemoveVault("one")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// a new vault with the same name can't read the old entries
+	store = vaulted.New(vaulted.NewStaticSteward("password"), backend)
+	err = store.SealVault(&vaulted.Vault{}, "one")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	log, err := store.VaultAuditLog("one")
+	if err != nil {
+		t.Fatal(err)
+	}
+	operations := auditOperations(log)
+	expected := []string{"?", "?", "seal", "open"}
+	if !reflect.DeepEqual(expected, operations) {
+		t.Fatalf("expected %v, got %v", expected, operations)
+	}
+	if log.BrokenAt != -1 {
+		t.Fatalf("expected the audit log to verify, broken at %d", log.BrokenAt)
+	}
+}
@@ -0,0 +1,81 @@
+package vaulted
+
+import (
+	"os"
+)
+
+// BlobKind identifies the collection a blob belongs to. Each kind has its own
+// namespace, so a vault, its history and its session cache share the same
+// name.
+type BlobKind string
+
+const (
+	VaultBlob        BlobKind = "vault"
+	VaultHistoryBlob BlobKind = "vault-history"
+	SessionCacheBlob BlobKind = "session-cache"
+	AuditLogBlob     BlobKind = "audit-log"
+	AuditHeadBlob    BlobKind = "audit-head"
+	LastUsedBlob     BlobKind = "last-used"
+)
+
+// Backend stores the named blobs (encrypted vault, history and session cache
+// files) that make up a store.
+//
+// Get and Delete return an error satisfying os.IsNotExist when the named blob
+// does not exist.
+type Backend interface {
+	Get(kind BlobKind, name string) ([]byte, error)
+	Put(kind BlobKind, name string, data []byte) error
+	List(kind BlobKind) ([]string, error)
+	Delete(kind BlobKind, name string) error
+}
+
+// BlobLocation describes a file a blob is stored in.
+type BlobLocation struct {
+	Path     string
+	Mode     os.FileMode
+	ReadOnly bool
+}
+
+// BackendLocator is implemented by backends that store blobs in files. Locate
+// returns each file the named blob is stored in, in order of precedence (so
+// the first location shadows the rest).
+type BackendLocator interface {
+	Locate(kind BlobKind, name string) ([]BlobLocation, error)
+}
+
+// BackendLocker is implemented by backends that may be shared by several
+// processes. Lock holds an exclusive lock on the named blob (whether or not it
+// exists) until the returned function is called, so a blob can be read and
+// written again without another process writing it in between.
+type BackendLocker interface {
+	Lock(kind BlobKind, name string) (func(), error)
+}
+
+// lockBlob locks the named blob when the backend supports locking.
+func lockBlob(backend Backend, kind BlobKind, name string) (func(), error) {
+	locker, ok := backend.(BackendLocker)
+	if !ok {
+		return func() {}, nil
+	}
+
+	return locker.Lock(kind, name)
+}
+
+// BackendAppender is implemented by backends able to append to a blob without
+// rewriting it. Append creates the blob when it doesn't exist.
+type BackendAppender interface {
+	Append(kind BlobKind, name string, data []byte) error
+}
+
+// appendBlob appends data to the named blob, given its current content (which
+// is written again when the backend can't append to blobs). The blob should be
+// locked.
+func appendBlob(backend Backend, kind BlobKind, name string, current, data []byte) error {
+	appender, ok := backend.(BackendAppender)
+	if !ok {
+		return backend.Put(kind, name, append(current, data...))
+	}
+
+	return appender.Append(kind, name, data)
+}
@@ -0,0 +1,359 @@
+package vaulted_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/miquella/vaulted/lib"
+)
+
+func TestMemoryBackend(t *testing.T) {
+	testBackend(t, vaulted.NewMemoryBackend())
+	testBackendLock(t, vaulted.NewMemoryBackend())
+	testBackendAppend(t, vaulted.NewMemoryBackend())
+}
+
+func TestFileBackend(t *testing.T) {
+	root, err := ioutil.TempDir("", "vaulted")
+	if err != nil {
+		t.Fatalf("failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(root)
+
+	testBackend(t, vaulted.NewFileBackend(root))
+	testBackendLock(t, vaulted.NewFileBackend(root))
+	testBackendAppend(t, vaulted.NewFileBackend(root))
+}
+
+func TestFileBackendReadOnlyVaultDirs(t *testing.T) {
+	root, err := ioutil.TempDir("", "vaulted")
+	if err != nil {
+		t.Fatalf("failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(root)
+
+	readOnly := filepath.Join(root, "system")
+	err = os.Mkdir(readOnly, 0700)
+	if err != nil {
+		t.Fatalf("failed to create read-only dir: %v", err)
+	}
+	err = ioutil.WriteFile(filepath.Join(readOnly, "shared"), []byte("system"), 0600)
+	if err != nil {
+		t.Fatalf("failed to write vault: %v", err)
+	}
+
+	backend := vaulted.NewFileBackend(root)
+	backend.ReadOnlyVaultDirs = []string{readOnly}
+
+	names, err := backend.List(vaulted.VaultBlob)
+	if err != nil {
+		t.Fatalf("failed to list vaults: %v", err)
+	}
+	if !reflect.DeepEqual([]string{"shared"}, names) {
+		t.Fatalf("expected [shared], got %#v", names)
+	}
+
+	err = backend.Delete(vaulted.VaultBlob, "shared")
+	if err == nil || os.IsNotExist(err) {
+		t.Fatalf("expected an error refusing to remove the vault, got %v", err)
+	}
+
+	// writes go to the vault dir, shadowing the read-only vault
+	err = backend.Put(vaulted.VaultBlob, "shared", []byte("user"))
+	if err != nil {
+		t.Fatalf("failed to put vault: %v", err)
+	}
+
+	data, err := backend.Get(vaulted.VaultBlob, "shared")
+	if err != nil {
+		t.Fatalf("failed to get vault: %v", err)
+	}
+	if string(data) != "user" {
+		t.Fatalf("expected %q, got %q", "user", data)
+	}
+	assertFileContent(t, filepath.Join(readOnly, "shared"), "system")
+}
+
+func TestFileBackendReadOnlyRoots(t *testing.T) {
+	root, err := ioutil.TempDir("", "vaulted")
+	if err != nil {
+		t.Fatalf("failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(root)
+
+	usb := filepath.Join(root, "usb")
+	project := filepath.Join(root, "project")
+	for _, store := range []string{usb, project} {
+		backend := vaulted.NewFileBackend(store)
+		err = backend.Put(vaulted.VaultBlob, "shared", []byte(store))
+		if err != nil {
+			t.Fatalf("failed to put vault: %v", err)
+		}
+	}
+	err = vaulted.NewFileBackend(project).Put(vaulted.VaultBlob, "project", []byte("project"))
+	if err != nil {
+		t.Fatalf("failed to put vault: %v", err)
+	}
+
+	backend := vaulted.NewFileBackend(usb, project)
+	names, err := backend.List(vaulted.VaultBlob)
+	if err != nil {
+		t.Fatalf("failed to list vaults: %v", err)
+	}
+	if !reflect.DeepEqual([]string{"shared", "project"}, names) {
+		t.Fatalf("expected [shared project], got %#v", names)
+	}
+
+	// the first store takes precedence
+	data, err := backend.Get(vaulted.VaultBlob, "shared")
+	if err != nil {
+		t.Fatalf("failed to get vault: %v", err)
+	}
+	if string(data) != usb {
+		t.Fatalf("expected %q, got %q", usb, data)
+	}
+
+	err = backend.Delete(vaulted.VaultBlob, "project")
+	if err == nil || os.IsNotExist(err) {
+		t.Fatalf("expected an error refusing to remove the vault, got %v", err)
+	}
+}
+
+func TestFileBackendNestedNames(t *testing.T) {
+	root, err := ioutil.TempDir("", "vaulted")
+	if err != nil {
+		t.Fatalf("failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(root)
+
+	backend := vaulted.NewFileBackend(root)
+	backend.HistoryDir = filepath.Join(backend.VaultDir, ".history")
+
+	for _, name := range []string{"prod/payments", "prod/db/main", "staging"} {
+		err = backend.Put(vaulted.VaultBlob, name, []byte("vault "+name))
+		if err != nil {
+			t.Fatalf("failed to put vault: %v", err)
+		}
+		err = backend.Put(vaulted.VaultHistoryBlob, name, []byte("history "+name))
+		if err != nil {
+			t.Fatalf("failed to put history: %v", err)
+		}
+	}
+	assertFileContent(t, filepath.Join(backend.VaultDir, "prod", "db", "main"), "vault prod/db/main")
+
+	// the history (stored within the vault dir) isn't listed
+	names, err := backend.List(vaulted.VaultBlob)
+	if err != nil {
+		t.Fatalf("failed to list vaults: %v", err)
+	}
+	expected := []string{"prod/db/main", "prod/payments", "staging"}
+	if !reflect.DeepEqual(expected, names) {
+		t.Fatalf("expected %#v, got %#v", expected, names)
+	}
+
+	// directories aren't vaults
+	_, err = backend.Get(vaulted.VaultBlob, "prod")
+	if !os.IsNotExist(err) {
+		t.Fatalf("expected not exist error, got %v", err)
+	}
+
+	// empty directories are removed along with the last vault in them
+	err = backend.Delete(vaulted.VaultBlob, "prod/db/main")
+	if err != nil {
+		t.Fatalf("failed to delete vault: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(backend.VaultDir, "prod", "db")); !os.IsNotExist(err) {
+		t.Fatalf("expected the empty directory to be removed, got %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(backend.VaultDir, "prod")); err != nil {
+		t.Fatalf("expected the directory to remain, got %v", err)
+	}
+
+	for _, name := range []string{"../escape", "prod/../../escape", "/absolute", "prod/", "prod//payments", ".hidden", ""} {
+		err = backend.Put(vaulted.VaultBlob, name, []byte("invalid"))
+		if err != vaulted.ErrInvalidVaultName {
+			t.Fatalf("expected %v for %q, got %v", vaulted.ErrInvalidVaultName, name, err)
+		}
+	}
+	if _, err := os.Stat(filepath.Join(root, "escape")); !os.IsNotExist(err) {
+		t.Fatal("expected no file to be written outside the vault dir")
+	}
+}
+
+func TestStoreWithMemoryBackend(t *testing.T) {
+	backend := vaulted.NewMemoryBackend()
+	store := vaulted.New(vaulted.NewStaticSteward("password"), backend)
+
+	vault := &vaulted.Vault{
+		Vars: map[string]string{"TEST": "TESTING"},
+	}
+	err := store.SealVault(vault, "memory")
+	if err != nil {
+		t.Fatalf("failed to seal vault: %v", err)
+	}
+
+	vaults, err := store.ListVaults()
+	if err != nil {
+		t.Fatalf("failed to list vaults: %v", err)
+	}
+	if !reflect.DeepEqual([]string{"memory"}, vaults) {
+		t.Fatalf("expected [memory], got %#v", vaults)
+	}
+
+	opened, _, err := store.OpenVault("memory")
+	if err != nil {
+		t.Fatalf("failed to open vault: %v", err)
+	}
+	if !reflect.DeepEqual(vault, opened) {
+		t.Fatalf("expected %#v, got %#v", vault, opened)
+	}
+
+	err = store.RemoveVault("memory")
+	if err != nil {
+		t.Fatalf("failed to remove vault: %v", err)
+	}
+	if store.VaultExists("memory") {
+		t.Fatal("expected vault to be removed")
+	}
+}
+
+func testBackendLock(t *testing.T, backend vaulted.BackendLocker) {
+	unlock, err := backend.Lock(vaulted.VaultBlob, "locked")
+	if err != nil {
+		t.Fatalf("failed to lock blob: %v", err)
+	}
+
+	locked := make(chan struct{})
+	go func() {
+		unlock, err := backend.Lock(vaulted.VaultBlob, "locked")
+		if err == nil {
+			unlock()
+		}
+		close(locked)
+	}()
+
+	select {
+	case <-locked:
+		t.Fatal("expected the blob to stay locked")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	// other blobs are locked independently
+	unlockOther, err := backend.Lock(vaulted.SessionCacheBlob, "locked")
+	if err != nil {
+		t.Fatalf("failed to lock blob: %v", err)
+	}
+	unlockOther()
+
+	unlock()
+	select {
+	case <-locked:
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected the blob to be unlocked")
+	}
+}
+
+func testBackendAppend(t *testing.T, backend interface {
+	vaulted.Backend
+	vaulted.BackendAppender
+}) {
+	for _, line := range []string{"one\n", "two\n"} {
+		err := backend.Append(vaulted.AuditLogBlob, "appended", []byte(line))
+		if err != nil {
+			t.Fatalf("failed to append to blob: %v", err)
+		}
+	}
+
+	data, err := backend.Get(vaulted.AuditLogBlob, "appended")
+	if err != nil {
+		t.Fatalf("failed to get blob: %v", err)
+	}
+	if string(data) != "one\ntwo\n" {
+		t.Fatalf("expected %q, got %q", "one\ntwo\n", data)
+	}
+}
+
+func testBackend(t *testing.T, backend vaulted.Backend) {
+	_, err := backend.Get(vaulted.VaultBlob, "missing")
+	if !os.IsNotExist(err) {
+		t.Fatalf("expected not exist error, got %v", err)
+	}
+
+	err = backend.Delete(vaulted.VaultBlob, "missing")
+	if !os.IsNotExist(err) {
+		t.Fatalf("expected not exist error, got %v", err)
+	}
+
+	for _, name := range []string{"one", "two"} {
+		err = backend.Put(vaulted.VaultBlob, name, []byte("vault "+name))
+		if err != nil {
+			t.Fatalf("failed to put vault: %v", err)
+		}
+	}
+	err = backend.Put(vaulted.SessionCacheBlob, "one", []byte("cache one"))
+	if err != nil {
+		t.Fatalf("failed to put session cache: %v", err)
+	}
+
+	// replace an existing blob
+	err = backend.Put(vaulted.VaultBlob, "one", []byte("vault one (updated)"))
+	if err != nil {
+		t.Fatalf("failed to put vault: %v", err)
+	}
+
+	data, err := backend.Get(vaulted.VaultBlob, "one")
+	if err != nil {
+		t.Fatalf("failed to get vault: %v", err)
+	}
+	if string(data) != "vault one (updated)" {
+		t.Fatalf("expected %q, got %q", "vault one (updated)", data)
+	}
+
+	data, err = backend.Get(vaulted.SessionCacheBlob, "one")
+	if err != nil {
+		t.Fatalf("failed to get session cache: %v", err)
+	}
+	if string(data) != "cache one" {
+		t.Fatalf("expected %q, got %q", "cache one", data)
+	}
+
+	names, err := backend.List(vaulted.VaultBlob)
+	if err != nil {
+		t.Fatalf("failed to list vaults: %v", err)
+	}
+	sort.Strings(names)
+	if !reflect.DeepEqual([]string{"one", "two"}, names) {
+		t.Fatalf("expected [one two], got %#v", names)
+	}
+
+	err = backend.Delete(vaulted.VaultBlob, "one")
+	if err != nil {
+		t.Fatalf("failed to delete vault: %v", err)
+	}
+
+	_, err = backend.Get(vaulted.VaultBlob, "one")
+	if !os.IsNotExist(err) {
+		t.Fatalf("expected not exist error, got %v", err)
+	}
+
+	// each kind has its own namespace
+	_, err = backend.Get(vaulted.SessionCacheBlob, "one")
+	if err != nil {
+		t.Fatalf("expected session cache to remain, got %v", err)
+	}
+}
+
+func assertFileContent(t *testing.T, filename, expected string) {
+	content, err := ioutil.ReadFile(filename)
+	if err != nil {
+		t.Fatalf("failed to read file: %v", err)
+	}
+	if string(content) != expected {
+		t.Fatalf("expected %q, got %q", expected, content)
+	}
+}
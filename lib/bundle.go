@@ -0,0 +1,196 @@
+package vaulted
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+const (
+	BundleVersion = 1
+
+	// BundleEncryptionMethod is used to encrypt bundles, since (unlike the
+	// default method for vaults) it authenticates the bundle's header.
+	BundleEncryptionMethod = "xchacha20poly1305"
+)
+
+var (
+	ErrInvalidBundle = errors.New("Invalid vault bundle")
+)
+
+// BundledVault is a vault held by a bundle (see Store.ExportVaults). The vault
+// file is kept exactly as it was sealed, so the vault keeps its key derivation
+// and encryption methods, and is still opened with its own password (or
+// identity) once imported.
+type BundledVault struct {
+	Name      string     `json:"name"`
+	VaultFile *VaultFile `json:"vault"`
+}
+
+type bundleContent struct {
+	Vaults []*BundledVault `json:"vaults"`
+}
+
+// bundleFile is the encrypted form of a bundle, sealed with its passphrase.
+type bundleFile struct {
+	Version    int       `json:"version"`
+	Key        *VaultKey `json:"key"`
+	Method     string    `json:"method"`
+	Details    Details   `json:"details"`
+	Ciphertext []byte    `json:"ciphertext"`
+}
+
+func (bf *bundleFile) associatedData() ([]byte, error) {
+	return json.Marshal(struct {
+		Version int       `json:"version"`
+		Key     *VaultKey `json:"key"`
+		Method  string    `json:"method"`
+	}{
+		Version: bf.Version,
+		Key:     bf.Key,
+		Method:  bf.Method,
+	})
+}
+
+// ExportVaults returns a bundle holding the named vaults, encrypted with
+// passphrase. The vaults are not opened, so their passwords aren't required.
+func (s *store) ExportVaults(names []string, passphrase string) ([]byte, error) {
+	content := bundleContent{
+		Vaults: []*BundledVault{},
+	}
+	for _, name := range names {
+		vf, err := readVaultFile(s.backend, name)
+		if err != nil {
+			return nil, err
+		}
+
+		content.Vaults = append(content.Vaults, &BundledVault{
+			Name:      name,
+			VaultFile: vf,
+		})
+	}
+
+	plaintext, err := json.Marshal(content)
+	if err != nil {
+		return nil, err
+	}
+
+	bf := &bundleFile{
+		Version: BundleVersion,
+		Key:     newVaultKey(nil),
+		Method:  BundleEncryptionMethod,
+		Details: make(Details),
+	}
+	if bf.Key == nil {
+		return nil, ErrInvalidKeyConfig
+	}
+
+	key, err := bf.Key.key(passphrase, encryptionKeySize)
+	if err != nil {
+		return nil, err
+	}
+	defer zero(key)
+
+	em, err := lookupEncryptionMethod(bf.Method)
+	if err != nil {
+		return nil, err
+	}
+
+	additionalData, err := bf.associatedData()
+	if err != nil {
+		return nil, err
+	}
+
+	bf.Ciphertext, err = sealContent(em, key, plaintext, additionalData, bf.Details)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(bf)
+}
+
+// OpenBundle decrypts a bundle created by Store.ExportVaults, returning the
+// vaults it holds. ErrIncorrectPassword is returned when the passphrase is
+// wrong.
+func OpenBundle(data []byte, passphrase string) ([]*BundledVault, error) {
+	bf := &bundleFile{}
+	err := json.Unmarshal(data, bf)
+	if err != nil || bf.Key == nil {
+		return nil, ErrInvalidBundle
+	}
+
+	if bf.Version != BundleVersion {
+		return nil, fmt.Errorf("Unsupported vault bundle version: %d", bf.Version)
+	}
+
+	key, err := bf.Key.key(passphrase, encryptionKeySize)
+	if err != nil {
+		return nil, err
+	}
+	defer zero(key)
+
+	em, err := lookupEncryptionMethod(bf.Method)
+	if err != nil {
+		return nil, err
+	}
+
+	additionalData, err := bf.associatedData()
+	if err != nil {
+		return nil, err
+	}
+
+	plaintext, err := openContent(em, key, bf.Ciphertext, additionalData, bf.Details)
+	if err != nil {
+		return nil, err
+	}
+
+	content := bundleContent{}
+	err = json.Unmarshal(plaintext, &content)
+	if err != nil {
+		return nil, ErrInvalidBundle
+	}
+
+	for _, vault := range content.Vaults {
+		if vault.Name == "" || vault.VaultFile == nil || vault.VaultFile.Key == nil {
+			return nil, ErrInvalidBundle
+		}
+	}
+
+	return content.Vaults, nil
+}
+
+// ImportVault stores a vault from a bundle as name. An existing vault with the
+// same name is replaced, and kept in the vault's history.
+func (s *store) ImportVault(vault *BundledVault, name string) error {
+	err := ValidateVaultName(name)
+	if err != nil {
+		return err
+	}
+
+	err = s.checkWritable(name)
+	if err != nil {
+		return err
+	}
+
+	unlock, err := lockBlob(s.backend, VaultBlob, name)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	// the vault is replaced in a single write, so a failed import leaves the
+	// existing vault untouched
+	existingVaultFile, _ := readVaultFile(s.backend, name)
+	err = writeVaultFile(s.backend, name, vault.VaultFile)
+	if err != nil {
+		return err
+	}
+
+	return recordHistory(s.backend, name, existingVaultFile, &HistoryEntry{
+		Revision:  vault.VaultFile.Revision,
+		Operation: "import",
+		Timestamp: time.Now(),
+		VaultFile: vault.VaultFile,
+	})
+}
@@ -0,0 +1,191 @@
+package vaulted_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/miquella/vaulted/lib"
+)
+
+func TestExportImportVaults(t *testing.T) {
+	source := vaulted.New(vaulted.NewStaticSteward("password"), vaulted.NewMemoryBackend())
+
+	err := source.SealVaultWithOptions(&vaulted.Vault{Vars: map[string]string{"TEST": "one"}}, "one", "one password", vaulted.SealOptions{
+		KeyMethod: "pbkdf2-sha512",
+		Method:    "aes-256-gcm",
+	})
+	if err != nil {
+		t.Fatalf("failed to seal vault: %v", err)
+	}
+
+	err = source.SealVaultWithPassword(&vaulted.Vault{Vars: map[string]string{"TEST": "two"}}, "two", "two password")
+	if err != nil {
+		t.Fatalf("failed to seal vault: %v", err)
+	}
+
+	bundle, err := source.ExportVaults([]string{"one", "two"}, "passphrase")
+	if err != nil {
+		t.Fatalf("failed to export vaults: %v", err)
+	}
+
+	_, err = vaulted.OpenBundle(bundle, "wrong passphrase")
+	if err != vaulted.ErrIncorrectPassword {
+		t.Fatalf("expected %v, got %v", vaulted.ErrIncorrectPassword, err)
+	}
+
+	vaults, err := vaulted.OpenBundle(bundle, "passphrase")
+	if err != nil {
+		t.Fatalf("failed to open bundle: %v", err)
+	}
+	if len(vaults) != 2 || vaults[0].Name != "one" || vaults[1].Name != "two" {
+		t.Fatalf("unexpected vaults: %#v", vaults)
+	}
+
+	destination := vaulted.New(vaulted.NewStaticSteward("password"), vaulted.NewMemoryBackend())
+	for _, vault := range vaults {
+		err = destination.ImportVault(vault, vault.Name)
+		if err != nil {
+			t.Fatalf("failed to import vault: %v", err)
+		}
+	}
+
+	// imported vaults keep their passwords and methods
+	v, _, err := destination.OpenVaultWithPassword("one", "one password")
+	if err != nil {
+		t.Fatalf("failed to open imported vault: %v", err)
+	}
+	if v.Vars["TEST"] != "one" {
+		t.Fatalf("expected: one, got: %s", v.Vars["TEST"])
+	}
+
+	report, err := destination.VerifyVault("one")
+	if err != nil {
+		t.Fatalf("failed to verify vault: %v", err)
+	}
+	if report.KeyMethod != "pbkdf2-sha512" || report.Method != "aes-256-gcm" {
+		t.Fatalf("expected the vault's methods to be kept, got %#v", report)
+	}
+
+	history, err := destination.VaultHistory("one")
+	if err != nil {
+		t.Fatalf("failed to get history: %v", err)
+	}
+	if len(history) != 1 || history[0].Operation != "import" {
+		t.Fatalf("unexpected history: %#v", history)
+	}
+
+	// importing replaces an existing vault
+	err = destination.ImportVault(vaults[1], "one")
+	if err != nil {
+		t.Fatalf("failed to import vault: %v", err)
+	}
+
+	v, _, err = destination.OpenVaultWithPassword("one", "two password")
+	if err != nil {
+		t.Fatalf("failed to open imported vault: %v", err)
+	}
+	if v.Vars["TEST"] != "two" {
+		t.Fatalf("expected: two, got: %s", v.Vars["TEST"])
+	}
+
+	// the replaced vault is kept in the history
+	history, err = destination.VaultHistory("one")
+	if err != nil {
+		t.Fatalf("failed to get history: %v", err)
+	}
+	if len(history) != 2 || history[0].Operation != "import" || history[1].Operation != "import" {
+		t.Fatalf("unexpected history: %#v", history)
+	}
+}
+
+// failingBackend fails to write vaults.
+type failingBackend struct {
+	*vaulted.MemoryBackend
+}
+
+func (b failingBackend) Put(kind vaulted.BlobKind, name string, data []byte) error {
+	if kind == vaulted.VaultBlob {
+		return errors.New("disk full")
+	}
+	return b.MemoryBackend.Put(kind, name, data)
+}
+
+func TestImportVaultFailure(t *testing.T) {
+	source := vaulted.New(vaulted.NewStaticSteward("password"), vaulted.NewMemoryBackend())
+	err := source.SealVaultWithPassword(&vaulted.Vault{}, "one", "password")
+	if err != nil {
+		t.Fatalf("failed to seal vault: %v", err)
+	}
+	bundle, err := source.ExportVaults([]string{"one"}, "passphrase")
+	if err != nil {
+		t.Fatalf("failed to export vaults: %v", err)
+	}
+	vaults, err := vaulted.OpenBundle(bundle, "passphrase")
+	if err != nil {
+		t.Fatalf("failed to open bundle: %v", err)
+	}
+
+	backend := vaulted.NewMemoryBackend()
+	destination := vaulted.New(vaulted.NewStaticSteward("password"), backend)
+	err = destination.SealVaultWithPassword(&vaulted.Vault{Vars: map[string]string{"TEST": "existing"}}, "one", "password")
+	if err != nil {
+		t.Fatalf("failed to seal vault: %v", err)
+	}
+
+	failing := vaulted.New(vaulted.NewStaticSteward("password"), failingBackend{backend})
+	err = failing.ImportVault(vaults[0], "one")
+	if err == nil {
+		t.Fatal("expected the import to fail")
+	}
+
+	v, _, err := destination.OpenVaultWithPassword("one", "password")
+	if err != nil {
+		t.Fatalf("failed to open existing vault: %v", err)
+	}
+	if v.Vars["TEST"] != "existing" {
+		t.Fatalf("expected the existing vault to be kept, got %#v", v.Vars)
+	}
+
+	history, err := destination.VaultHistory("one")
+	if err != nil || len(history) != 1 {
+		t.Fatalf("expected the history to be kept, got %#v (%v)", history, err)
+	}
+}
+
+func TestOpenBundleDetectsTampering(t *testing.T) {
+	store := vaulted.New(vaulted.NewStaticSteward("password"), vaulted.NewMemoryBackend())
+
+	err := store.SealVaultWithPassword(&vaulted.Vault{}, "one", "password")
+	if err != nil {
+		t.Fatalf("failed to seal vault: %v", err)
+	}
+
+	bundle, err := store.ExportVaults([]string{"one"}, "passphrase")
+	if err != nil {
+		t.Fatalf("failed to export vaults: %v", err)
+	}
+
+	_, err = vaulted.OpenBundle([]byte("not a bundle"), "passphrase")
+	if err != vaulted.ErrInvalidBundle {
+		t.Fatalf("expected %v, got %v", vaulted.ErrInvalidBundle, err)
+	}
+
+	// alter the header without affecting the derived key
+	var bf map[string]interface{}
+	err = json.Unmarshal(bundle, &bf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	bf["key"].(map[string]interface{})["details"].(map[string]interface{})["tampered"] = true
+
+	tampered, err := json.Marshal(bf)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = vaulted.OpenBundle(tampered, "passphrase")
+	if err != vaulted.ErrIncorrectPassword {
+		t.Fatalf("expected %v, got %v", vaulted.ErrIncorrectPassword, err)
+	}
+}
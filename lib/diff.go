@@ -0,0 +1,215 @@
+package vaulted
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"golang.org/x/crypto/ssh"
+)
+
+const (
+	DiffAdded   = "added"
+	DiffRemoved = "removed"
+	DiffChanged = "changed"
+
+	redactedValue = "(redacted)"
+)
+
+// VaultDifference is a difference between two vaults (see DiffVaults).
+//
+// Field is the part of the vault that differs ("vars", "ssh_keys", "aws_key",
+// "duration", "includes" or "include_aws_key"), and Key the variable, SSH key
+// or AWS key field within it (if any). Old and New describe the values, they
+// are empty when the value was added or removed.
+type VaultDifference struct {
+	Change string `json:"change"`
+	Field  string `json:"field"`
+	Key    string `json:"key,omitempty"`
+	Old    string `json:"old,omitempty"`
+	New    string `json:"new,omitempty"`
+}
+
+// DiffOptions controls how the values of differences are described.
+//
+// Secrets (variable values, AWS secret keys and session tokens) are redacted
+// unless ShowSecrets is set. SSH keys are always described by the SHA256
+// fingerprint of their public key, and AWS key IDs by their last four
+// characters, which is enough to recognise them.
+type DiffOptions struct {
+	ShowSecrets bool
+}
+
+// DiffVaults returns the differences between two vaults, sorted by field and
+// key. A nil vault is considered empty.
+func DiffVaults(a, b *Vault, options DiffOptions) []*VaultDifference {
+	if a == nil {
+		a = &Vault{}
+	}
+	if b == nil {
+		b = &Vault{}
+	}
+
+	var differences []*VaultDifference
+
+	differences = append(differences, diffMaps("vars", a.Vars, b.Vars, options.secret)...)
+	differences = append(differences, diffMaps("ssh_keys", a.SSHKeys, b.SSHKeys, sshKeyFingerprint)...)
+	differences = append(differences, diffMaps("aws_key", awsKeyFields(a.AWSKey), awsKeyFields(b.AWSKey), options.awsKeyField)...)
+
+	if a.Duration != b.Duration {
+		differences = append(differences, diffValues("duration", "", durationString(a.Duration), durationString(b.Duration)))
+	}
+
+	differences = append(differences, diffMaps("includes", setOf(a.Includes), setOf(b.Includes), nil)...)
+
+	if a.IncludeAWSKey != b.IncludeAWSKey {
+		differences = append(differences, diffValues("include_aws_key", "", fmt.Sprint(a.IncludeAWSKey), fmt.Sprint(b.IncludeAWSKey)))
+	}
+
+	return differences
+}
+
+// diffMaps compares the entries of two maps, describing their values using
+// describe (entries are described by their key alone when describe is nil).
+func diffMaps(field string, a, b map[string]string, describe func(key, value string) string) []*VaultDifference {
+	keys := make(map[string]bool)
+	for key := range a {
+		keys[key] = true
+	}
+	for key := range b {
+		keys[key] = true
+	}
+
+	sorted := make([]string, 0, len(keys))
+	for key := range keys {
+		sorted = append(sorted, key)
+	}
+	sort.Strings(sorted)
+
+	var differences []*VaultDifference
+	for _, key := range sorted {
+		oldValue, inA := a[key]
+		newValue, inB := b[key]
+		if inA && inB && oldValue == newValue {
+			continue
+		}
+
+		var oldDescription, newDescription string
+		if describe != nil {
+			if inA {
+				oldDescription = describe(key, oldValue)
+			}
+			if inB {
+				newDescription = describe(key, newValue)
+			}
+		}
+
+		difference := diffValues(field, key, oldDescription, newDescription)
+		switch {
+		case !inA:
+			difference.Change = DiffAdded
+		case !inB:
+			difference.Change = DiffRemoved
+		}
+		differences = append(differences, difference)
+	}
+
+	return differences
+}
+
+func diffValues(field, key, oldValue, newValue string) *VaultDifference {
+	return &VaultDifference{
+		Change: DiffChanged,
+		Field:  field,
+		Key:    key,
+		Old:    oldValue,
+		New:    newValue,
+	}
+}
+
+func (o DiffOptions) secret(key, value string) string {
+	if o.ShowSecrets {
+		return value
+	}
+	return redactedValue
+}
+
+func (o DiffOptions) awsKeyField(key, value string) string {
+	switch key {
+	case "id":
+		if o.ShowSecrets {
+			return value
+		}
+		return redactKeyID(value)
+	case "secret", "token":
+		return o.secret(key, value)
+	default:
+		return value
+	}
+}
+
+// redactKeyID keeps the last four characters of a key ID.
+func redactKeyID(id string) string {
+	runes := []rune(id)
+	if len(runes) <= 4 {
+		return strings.Repeat("*", len(runes))
+	}
+	return "..." + string(runes[len(runes)-4:])
+}
+
+// sshKeyFingerprint describes an SSH private key by the fingerprint of its
+// public key.
+func sshKeyFingerprint(key, value string) string {
+	signer, err := ssh.ParsePrivateKey([]byte(value))
+	if err != nil {
+		return "(unrecognized key)"
+	}
+	return ssh.FingerprintSHA256(signer.PublicKey())
+}
+
+// awsKeyFields returns the fields of an AWS key that are set, by the names
+// they are stored with.
+func awsKeyFields(k *AWSKey) map[string]string {
+	fields := make(map[string]string)
+	if k == nil {
+		return fields
+	}
+
+	set := func(name, value string) {
+		if value != "" {
+			fields[name] = value
+		}
+	}
+	set("id", k.ID)
+	set("secret", k.Secret)
+	set("token", k.Token)
+	if k.Expiration != nil {
+		set("expiration", k.Expiration.UTC().Format("2006-01-02T15:04:05Z"))
+	}
+	if k.Region != nil {
+		set("region", *k.Region)
+	}
+	set("mfa", k.MFA)
+	set("role", k.Role)
+	if k.ForgoTempCredGeneration {
+		set("forgoTempCredGeneration", "true")
+	}
+
+	return fields
+}
+
+func durationString(duration time.Duration) string {
+	if duration == 0 {
+		return "default"
+	}
+	return duration.String()
+}
+
+func setOf(values []string) map[string]string {
+	set := make(map[string]string)
+	for _, value := range values {
+		set[value] = ""
+	}
+	return set
+}
@@ -0,0 +1,150 @@
+package vaulted_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/miquella/vaulted/lib"
+	"golang.org/x/crypto/ssh"
+)
+
+func generateSSHKey(t *testing.T) (string, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	der, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	publicKey, err := ssh.NewPublicKey(&key.PublicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	privateKey := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der})
+	return string(privateKey), ssh.FingerprintSHA256(publicKey)
+}
+
+func TestDiffVaults(t *testing.T) {
+	oldKey, oldFingerprint := generateSSHKey(t)
+	newKey, newFingerprint := generateSSHKey(t)
+
+	a := &vaulted.Vault{
+		Duration: time.Hour,
+		AWSKey: &vaulted.AWSKey{
+			AWSCredentials: vaulted.AWSCredentials{
+				ID:     "AKIAOLDOLDOLDOLD1234",
+				Secret: "old secret",
+			},
+			MFA: "arn:aws:iam::111111111111:mfa/me",
+		},
+		Vars: map[string]string{
+			"KEPT":    "same",
+			"CHANGED": "old value",
+			"REMOVED": "removed value",
+		},
+		SSHKeys: map[string]string{
+			"rotated": oldKey,
+			"removed": oldKey,
+		},
+	}
+	b := &vaulted.Vault{
+		Duration: 2 * time.Hour,
+		AWSKey: &vaulted.AWSKey{
+			AWSCredentials: vaulted.AWSCredentials{
+				ID:     "AKIANEWNEWNEWNEW5678",
+				Secret: "new secret",
+			},
+			MFA:  "arn:aws:iam::111111111111:mfa/me",
+			Role: "arn:aws:iam::111111111111:role/admin",
+		},
+		Vars: map[string]string{
+			"KEPT":    "same",
+			"CHANGED": "new value",
+			"ADDED":   "added value",
+		},
+		SSHKeys: map[string]string{
+			"rotated": newKey,
+		},
+		Includes: []string{"shared"},
+	}
+
+	expected := []*vaulted.VaultDifference{
+		{Change: vaulted.DiffAdded, Field: "vars", Key: "ADDED", New: "(redacted)"},
+		{Change: vaulted.DiffChanged, Field: "vars", Key: "CHANGED", Old: "(redacted)", New: "(redacted)"},
+		{Change: vaulted.DiffRemoved, Field: "vars", Key: "REMOVED", Old: "(redacted)"},
+		{Change: vaulted.DiffRemoved, Field: "ssh_keys", Key: "removed", Old: oldFingerprint},
+		{Change: vaulted.DiffChanged, Field: "ssh_keys", Key: "rotated", Old: oldFingerprint, New: newFingerprint},
+		{Change: vaulted.DiffChanged, Field: "aws_key", Key: "id", Old: "...1234", New: "...5678"},
+		{Change: vaulted.DiffAdded, Field: "aws_key", Key: "role", New: "arn:aws:iam::111111111111:role/admin"},
+		{Change: vaulted.DiffChanged, Field: "aws_key", Key: "secret", Old: "(redacted)", New: "(redacted)"},
+		{Change: vaulted.DiffChanged, Field: "duration", Old: "1h0m0s", New: "2h0m0s"},
+		{Change: vaulted.DiffAdded, Field: "includes", Key: "shared"},
+	}
+
+	differences := vaulted.DiffVaults(a, b, vaulted.DiffOptions{})
+	if !reflect.DeepEqual(differences, expected) {
+		t.Fatalf("unexpected differences:\n%s", describeDifferences(differences))
+	}
+
+	for _, difference := range differences {
+		for _, secret := range []string{"old value", "new value", "added value", "removed value", "old secret", "new secret", "AKIA"} {
+			if strings.Contains(difference.Old, secret) || strings.Contains(difference.New, secret) {
+				t.Fatalf("secret %q was not redacted: %+v", secret, difference)
+			}
+		}
+	}
+
+	differences = vaulted.DiffVaults(a, b, vaulted.DiffOptions{ShowSecrets: true})
+	if differences[1].Old != "old value" || differences[1].New != "new value" {
+		t.Fatalf("expected the values of vars to be shown, got %+v", differences[1])
+	}
+	if differences[5].Old != "AKIAOLDOLDOLDOLD1234" {
+		t.Fatalf("expected the key ID to be shown, got %+v", differences[5])
+	}
+
+	if differences := vaulted.DiffVaults(a, a, vaulted.DiffOptions{}); len(differences) != 0 {
+		t.Fatalf("expected no differences, got:\n%s", describeDifferences(differences))
+	}
+}
+
+func TestDiffVaultsEmpty(t *testing.T) {
+	differences := vaulted.DiffVaults(nil, &vaulted.Vault{
+		AWSKey: &vaulted.AWSKey{
+			AWSCredentials: vaulted.AWSCredentials{
+				ID:     "ID",
+				Secret: "secret",
+			},
+		},
+		SSHKeys: map[string]string{
+			"broken": "not a key",
+		},
+	}, vaulted.DiffOptions{})
+
+	expected := []*vaulted.VaultDifference{
+		{Change: vaulted.DiffAdded, Field: "ssh_keys", Key: "broken", New: "(unrecognized key)"},
+		{Change: vaulted.DiffAdded, Field: "aws_key", Key: "id", New: "**"},
+		{Change: vaulted.DiffAdded, Field: "aws_key", Key: "secret", New: "(redacted)"},
+	}
+	if !reflect.DeepEqual(differences, expected) {
+		t.Fatalf("unexpected differences:\n%s", describeDifferences(differences))
+	}
+}
+
+func describeDifferences(differences []*vaulted.VaultDifference) string {
+	var lines []string
+	for _, difference := range differences {
+		lines = append(lines, strings.TrimSpace(strings.Join([]string{difference.Change, difference.Field, difference.Key, difference.Old, difference.New}, " ")))
+	}
+	return strings.Join(lines, "\n")
+}
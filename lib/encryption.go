@@ -0,0 +1,155 @@
+package vaulted
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"fmt"
+
+	"golang.org/x/crypto/chacha20poly1305"
+	"golang.org/x/crypto/nacl/secretbox"
+)
+
+const (
+	// DefaultEncryptionMethod authenticates the vault file's header (see
+	// VaultFile.associatedData), unlike secretbox
+	DefaultEncryptionMethod = "xchacha20poly1305"
+
+	encryptionKeySize = 32
+)
+
+// encryptionMethod encrypts and decrypts the content of vault and session
+// files.
+//
+// Additional data is authenticated (but not encrypted) along with the content
+// by methods that support it. This is used to detect tampering with the
+// unencrypted header fields of a file.
+type encryptionMethod interface {
+	nonceSize() int
+	seal(key, nonce, plaintext, additionalData []byte) ([]byte, error)
+	open(key, nonce, ciphertext, additionalData []byte) ([]byte, bool)
+	authenticatesAdditionalData() bool
+}
+
+var encryptionMethods = map[string]encryptionMethod{
+	"secretbox":         secretboxMethod{},
+	"xchacha20poly1305": aeadMethod{newAEAD: chacha20poly1305.NewX, size: chacha20poly1305.NonceSizeX},
+	"aes-256-gcm":       aeadMethod{newAEAD: newAESGCM, size: 12},
+}
+
+// ValidateEncryptionMethod returns an error if method is not a supported
+// encryption method.
+func ValidateEncryptionMethod(method string) error {
+	_, err := lookupEncryptionMethod(method)
+	return err
+}
+
+func lookupEncryptionMethod(method string) (encryptionMethod, error) {
+	if em, ok := encryptionMethods[method]; ok {
+		return em, nil
+	}
+	return nil, fmt.Errorf("Invalid encryption method: %s", method)
+}
+
+// sealContent encrypts plaintext, storing the generated nonce in details.
+func sealContent(em encryptionMethod, key, plaintext, additionalData []byte, details Details) ([]byte, error) {
+	nonce := make([]byte, em.nonceSize())
+	_, err := rand.Read(nonce)
+	if err != nil {
+		return nil, err
+	}
+	details.SetBytes("nonce", nonce)
+
+	return em.seal(key, nonce, plaintext, additionalData)
+}
+
+// openContent decrypts ciphertext using the nonce stored in details.
+func openContent(em encryptionMethod, key, ciphertext, additionalData []byte, details Details) ([]byte, error) {
+	nonce := details.Bytes("nonce")
+	if len(nonce) == 0 || len(nonce) > em.nonceSize() {
+		return nil, ErrInvalidEncryptionConfig
+	}
+	if len(nonce) < em.nonceSize() {
+		padded := make([]byte, em.nonceSize())
+		copy(padded, nonce)
+		nonce = padded
+	}
+
+	plaintext, ok := em.open(key, nonce, ciphertext, additionalData)
+	if !ok {
+		return nil, ErrIncorrectPassword
+	}
+
+	return plaintext, nil
+}
+
+// secretboxMethod does not support additional data, it is only kept for
+// compatibility with existing vaults.
+type secretboxMethod struct{}
+
+func (secretboxMethod) nonceSize() int {
+	return 24
+}
+
+func (secretboxMethod) authenticatesAdditionalData() bool {
+	return false
+}
+
+func (secretboxMethod) seal(key, nonce, plaintext, additionalData []byte) ([]byte, error) {
+	boxKey := [32]byte{}
+	copy(boxKey[:], key)
+	boxNonce := [24]byte{}
+	copy(boxNonce[:], nonce)
+
+	return secretbox.Seal(nil, plaintext, &boxNonce, &boxKey), nil
+}
+
+func (secretboxMethod) open(key, nonce, ciphertext, additionalData []byte) ([]byte, bool) {
+	boxKey := [32]byte{}
+	copy(boxKey[:], key)
+	boxNonce := [24]byte{}
+	copy(boxNonce[:], nonce)
+
+	return secretbox.Open(nil, ciphertext, &boxNonce, &boxKey)
+}
+
+type aeadMethod struct {
+	newAEAD func(key []byte) (cipher.AEAD, error)
+	size    int
+}
+
+func (m aeadMethod) nonceSize() int {
+	return m.size
+}
+
+func (m aeadMethod) authenticatesAdditionalData() bool {
+	return true
+}
+
+func (m aeadMethod) seal(key, nonce, plaintext, additionalData []byte) ([]byte, error) {
+	aead, err := m.newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return aead.Seal(nil, nonce, plaintext, additionalData), nil
+}
+
+func (m aeadMethod) open(key, nonce, ciphertext, additionalData []byte) ([]byte, bool) {
+	aead, err := m.newAEAD(key)
+	if err != nil {
+		return nil, false
+	}
+
+	plaintext, err := aead.Open(nil, nonce, ciphertext, additionalData)
+	return plaintext, err == nil
+}
+
+func newAESGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,370 @@
+package vaulted
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/miquella/xdg"
+)
+
+// FileBackend stores each blob as a file within a directory.
+type FileBackend struct {
+	// VaultDir is where vaults are written
+	VaultDir string
+
+	// ReadOnlyVaultDirs are searched (in order) for vaults that don't exist
+	// in VaultDir. Vaults in these directories are never modified.
+	ReadOnlyVaultDirs []string
+
+	// HistoryDir is where previous revisions of vaults are written
+	HistoryDir string
+
+	// CacheDir is where session caches are written
+	CacheDir string
+
+	// AuditDir is where the audit logs of vaults are written
+	AuditDir string
+
+	// AuditHeadDir is where the heads of the audit logs of vaults (see
+	// AuditLog) are written
+	AuditHeadDir string
+
+	// LastUsedDir is where the times vaults were last used are written
+	LastUsedDir string
+
+	// LockDir is where the lock files of blobs are kept (see Lock). Blobs
+	// are not locked when it is empty.
+	LockDir string
+}
+
+// NewFileBackend creates a backend rooted at a custom directory. Vaults are
+// stored in root/vaults, their history in root/history, their audit logs in
+// root/audit (and the heads of their audit logs in root/audit-head), session
+// caches in root/cache, the times vaults were last used in root/last-used and
+// lock files in root/locks.
+//
+// Vaults that don't exist in root are searched for in the vaults directory of
+// each of readOnlyRoots (in order), which are never written to.
+func NewFileBackend(root string, readOnlyRoots ...string) *FileBackend {
+	var readOnlyVaultDirs []string
+	for _, readOnlyRoot := range readOnlyRoots {
+		readOnlyVaultDirs = append(readOnlyVaultDirs, filepath.Join(readOnlyRoot, "vaults"))
+	}
+
+	return &FileBackend{
+		VaultDir:          filepath.Join(root, "vaults"),
+		ReadOnlyVaultDirs: readOnlyVaultDirs,
+		HistoryDir:        filepath.Join(root, "history"),
+		CacheDir:          filepath.Join(root, "cache"),
+		AuditDir:          filepath.Join(root, "audit"),
+		AuditHeadDir:      filepath.Join(root, "audit-head"),
+		LastUsedDir:       filepath.Join(root, "last-used"),
+		LockDir:           filepath.Join(root, "locks"),
+	}
+}
+
+// NewXDGBackend creates a backend that stores vaults and session caches
+// according to the XDG Base Directory Specification.
+func NewXDGBackend() *FileBackend {
+	return &FileBackend{
+		VaultDir:          xdg.DATA_HOME.Join("vaulted"),
+		ReadOnlyVaultDirs: xdg.DATA_DIRS.Join("vaulted"),
+		HistoryDir:        xdg.DATA_HOME.Join("vaulted", ".history"),
+		CacheDir:          xdg.CACHE_HOME.Join("vaulted"),
+		AuditDir:          xdg.DATA_HOME.Join("vaulted", ".audit"),
+		AuditHeadDir:      xdg.DATA_HOME.Join("vaulted", ".audit-head"),
+		LastUsedDir:       xdg.CACHE_HOME.Join("vaulted", ".last-used"),
+		LockDir:           xdg.CACHE_HOME.Join("vaulted", ".locks"),
+	}
+}
+
+func (b *FileBackend) Get(kind BlobKind, name string) ([]byte, error) {
+	err := ValidateVaultName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, dir := range b.searchDirs(kind) {
+		filename := blobPath(dir, name)
+		data, err := ioutil.ReadFile(filename)
+		if os.IsNotExist(err) || isDir(filename) {
+			continue
+		}
+
+		return data, err
+	}
+
+	return nil, os.ErrNotExist
+}
+
+func (b *FileBackend) Put(kind BlobKind, name string, data []byte) error {
+	err := ValidateVaultName(name)
+	if err != nil {
+		return err
+	}
+
+	dir, err := b.writeDir(kind)
+	if err != nil {
+		return err
+	}
+
+	filename := blobPath(dir, name)
+	err = os.MkdirAll(filepath.Dir(filename), 0700)
+	if err != nil {
+		return err
+	}
+
+	return writeFileAtomic(filename, 0600, func(w io.Writer) error {
+		_, err := w.Write(data)
+		return err
+	})
+}
+
+// Append appends data to a blob's file, which is opened with O_APPEND (so
+// the file is never rewritten), creating the file when it doesn't exist.
+func (b *FileBackend) Append(kind BlobKind, name string, data []byte) error {
+	err := ValidateVaultName(name)
+	if err != nil {
+		return err
+	}
+
+	dir, err := b.writeDir(kind)
+	if err != nil {
+		return err
+	}
+
+	filename := blobPath(dir, name)
+	err = os.MkdirAll(filepath.Dir(filename), 0700)
+	if err != nil {
+		return err
+	}
+
+	f, err := os.OpenFile(filename, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0600)
+	if err != nil {
+		return err
+	}
+
+	_, err = f.Write(data)
+	if err == nil {
+		err = f.Sync()
+	}
+	closeErr := f.Close()
+	if err != nil {
+		return err
+	}
+	return closeErr
+}
+
+func (b *FileBackend) List(kind BlobKind) ([]string, error) {
+	var found []string
+	emitted := map[string]bool{}
+	for _, dir := range b.searchDirs(kind) {
+		err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+			if err != nil {
+				if os.IsNotExist(err) && path == dir {
+					return filepath.SkipDir
+				}
+				return err
+			}
+
+			// skip hidden files and directories (e.g. temporary files left
+			// behind by an interrupted write, or the history directory)
+			if path != dir && strings.HasPrefix(info.Name(), ".") {
+				if info.IsDir() {
+					return filepath.SkipDir
+				}
+				return nil
+			}
+
+			if !info.Mode().IsRegular() {
+				return nil
+			}
+
+			relative, err := filepath.Rel(dir, path)
+			if err != nil {
+				return err
+			}
+
+			name := filepath.ToSlash(relative)
+			if !emitted[name] {
+				emitted[name] = true
+				found = append(found, name)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return found, nil
+}
+
+func (b *FileBackend) Delete(kind BlobKind, name string) error {
+	err := ValidateVaultName(name)
+	if err != nil {
+		return err
+	}
+
+	dir, err := b.writeDir(kind)
+	if err != nil {
+		return err
+	}
+
+	filename := blobPath(dir, name)
+	err = os.Remove(filename)
+	if err == nil {
+		removeEmptyDirs(filepath.Dir(filename), dir)
+		return nil
+	}
+	if !os.IsNotExist(err) {
+		return err
+	}
+
+	if kind == VaultBlob {
+		for _, readOnlyDir := range b.ReadOnlyVaultDirs {
+			untouchable := blobPath(readOnlyDir, name)
+			if _, err := os.Stat(untouchable); err == nil {
+				return fmt.Errorf("Because %s is outside the vaulted managed directory (%s), it must be removed manually", untouchable, b.VaultDir)
+			}
+		}
+	}
+
+	return os.ErrNotExist
+}
+
+func (b *FileBackend) Locate(kind BlobKind, name string) ([]BlobLocation, error) {
+	err := ValidateVaultName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	writeDir, err := b.writeDir(kind)
+	if err != nil {
+		return nil, err
+	}
+
+	var locations []BlobLocation
+	for _, dir := range b.searchDirs(kind) {
+		filename := blobPath(dir, name)
+		info, err := os.Stat(filename)
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		if info.IsDir() {
+			continue
+		}
+
+		locations = append(locations, BlobLocation{
+			Path:     filename,
+			Mode:     info.Mode(),
+			ReadOnly: dir != writeDir,
+		})
+	}
+
+	return locations, nil
+}
+
+// Lock locks a blob using a lock file in LockDir, which is held until the
+// returned function is called.
+func (b *FileBackend) Lock(kind BlobKind, name string) (func(), error) {
+	err := ValidateVaultName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	if b.LockDir == "" {
+		return func() {}, nil
+	}
+
+	filename := blobPath(filepath.Join(b.LockDir, string(kind)), name)
+	err = os.MkdirAll(filepath.Dir(filename), 0700)
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := os.OpenFile(filename, os.O_RDWR|os.O_CREATE, 0600)
+	if err != nil {
+		return nil, err
+	}
+
+	err = lockFile(f)
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+
+	return func() {
+		unlockFile(f)
+		f.Close()
+	}, nil
+}
+
+func (b *FileBackend) writeDir(kind BlobKind) (string, error) {
+	switch kind {
+	case VaultBlob:
+		return b.VaultDir, nil
+	case VaultHistoryBlob:
+		return b.HistoryDir, nil
+	case SessionCacheBlob:
+		return b.CacheDir, nil
+	case AuditLogBlob:
+		return b.AuditDir, nil
+	case AuditHeadBlob:
+		return b.AuditHeadDir, nil
+	case LastUsedBlob:
+		return b.LastUsedDir, nil
+	default:
+		return "", fmt.Errorf("Invalid blob kind: %s", kind)
+	}
+}
+
+func (b *FileBackend) searchDirs(kind BlobKind) []string {
+	switch kind {
+	case VaultBlob:
+		return append([]string{b.VaultDir}, b.ReadOnlyVaultDirs...)
+	case VaultHistoryBlob:
+		return []string{b.HistoryDir}
+	case SessionCacheBlob:
+		return []string{b.CacheDir}
+	case AuditLogBlob:
+		return []string{b.AuditDir}
+	case AuditHeadBlob:
+		return []string{b.AuditHeadDir}
+	case LastUsedBlob:
+		return []string{b.LastUsedDir}
+	default:
+		return nil
+	}
+}
+
+// blobPath returns the file a blob is stored in. The parts of hierarchical
+// names (e.g. "prod/payments") are stored as subdirectories.
+func blobPath(dir, name string) string {
+	return filepath.Join(dir, filepath.FromSlash(name))
+}
+
+// isDir returns whether filename is a directory (e.g. "prod" when a vault
+// named "prod/payments" exists).
+func isDir(filename string) bool {
+	info, err := os.Stat(filename)
+	return err == nil && info.IsDir()
+}
+
+// removeEmptyDirs removes dir and its parents (up to, but not including, root)
+// for as long as they are empty.
+func removeEmptyDirs(dir, root string) {
+	for dir != root && strings.HasPrefix(dir, root) {
+		if os.Remove(dir) != nil {
+			return
+		}
+		dir = filepath.Dir(dir)
+	}
+}
@@ -0,0 +1,17 @@
+//go:build !darwin && !dragonfly && !freebsd && !linux && !netbsd && !openbsd
+
+package vaulted
+
+import (
+	"os"
+)
+
+// lockFile does nothing where flock(2) is unavailable, so blobs are only
+// protected from concurrent writes by the checks made before writing them.
+func lockFile(f *os.File) error {
+	return nil
+}
+
+func unlockFile(f *os.File) error {
+	return nil
+}
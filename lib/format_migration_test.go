@@ -0,0 +1,93 @@
+package vaulted
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+)
+
+func TestMigrateKeySchedule(t *testing.T) {
+	backend := NewMemoryBackend()
+	s := New(NewStaticSteward("password"), backend).(*store)
+
+	vault := &Vault{Vars: map[string]string{"TEST": "MIGRATED"}}
+	err := s.SealVaultWithPassword(vault, "one", "password")
+	if err != nil {
+		t.Fatalf("failed to seal vault: %v", err)
+	}
+
+	vf, err := readVaultFile(backend, "one")
+	if err != nil {
+		t.Fatal(err)
+	}
+	masterKey, err := vf.Key.key("password", encryptionKeySize)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// a vault file in format version 1, encrypted with the master key itself
+	vf.FormatVersion = 1
+	vf.KeySchedule = ""
+	err = vf.encrypt(vault, masterKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = updateVaultFile(backend, "one", vf)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the migration needs the key, so reading the file leaves it as is
+	vf, err = readVaultFile(backend, "one")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if vf.FormatVersion != 1 {
+		t.Fatalf("expected format version 1 until the vault is opened, got %d", vf.FormatVersion)
+	}
+
+	opened, _, err := New(NewStaticSteward("password"), backend).OpenVaultWithPassword("one", "password")
+	if err != nil {
+		t.Fatalf("failed to open vault: %v", err)
+	}
+	if !reflect.DeepEqual(vault, opened) {
+		t.Fatalf("expected %#v, got %#v", vault, opened)
+	}
+
+	upgraded, err := readVaultFile(backend, "one")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if upgraded.FormatVersion != CurrentFormatVersion || upgraded.KeySchedule != HKDFKeySchedule {
+		t.Fatalf("expected the vault to be re-encrypted in format version %d, got %d (%q)", CurrentFormatVersion, upgraded.FormatVersion, upgraded.KeySchedule)
+	}
+	if upgraded.Revision != vf.Revision {
+		t.Fatalf("expected the revision to be kept, got %d", upgraded.Revision)
+	}
+
+	// the content is no longer encrypted with the master key
+	em, _ := lookupEncryptionMethod(upgraded.Method)
+	additionalData, _ := upgraded.associatedData()
+	_, err = openContent(em, masterKey, upgraded.Ciphertext, additionalData, upgraded.Details)
+	if err != ErrIncorrectPassword {
+		t.Fatalf("expected %v, got %v", ErrIncorrectPassword, err)
+	}
+	opened, err = openVaultFileWithKey(upgraded, masterKey)
+	if err != nil {
+		t.Fatalf("failed to open upgraded vault: %v", err)
+	}
+	if !reflect.DeepEqual(vault, opened) {
+		t.Fatalf("expected %#v, got %#v", vault, opened)
+	}
+
+	// a file claiming an older version than it is in is left unchanged
+	again := *upgraded
+	again.FormatVersion = 1
+	err = migrateVaultFileWithKey(&again, masterKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if again.FormatVersion != CurrentFormatVersion || !bytes.Equal(again.Ciphertext, upgraded.Ciphertext) {
+		t.Fatal("expected migrating an upgraded vault file again to leave it unchanged")
+	}
+}
@@ -0,0 +1,195 @@
+package vaulted
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+const (
+	// CurrentFormatVersion is the format version of the vault files written
+	// by this version of Vaulted. Vault files written before the format was
+	// versioned have no version (0).
+	CurrentFormatVersion = 2
+)
+
+var (
+	ErrUnsupportedFormatVersion = errors.New("Vault uses a newer format (a newer version of Vaulted is required)")
+)
+
+// formatMigration converts a vault file from the previous format version to
+// the version it is registered for.
+//
+// migrate runs each time a vault file is read (before it is opened), so it may
+// only change how the file is represented, not the fields it authenticates
+// (see VaultFile.associatedData). migrateWithKey runs once the vault's master
+// key is known (when the vault is opened or upgraded), for migrations that
+// re-encrypt the vault; files keep their previous version until then.
+//
+// The format version isn't authenticated (so files are able to be migrated
+// without their key), so a file may claim an older version than it is in.
+// Migrations must be idempotent: running a migration on a file that is
+// already in the newer format leaves it unchanged.
+type formatMigration struct {
+	description    string
+	migrate        func(vf *VaultFile) error
+	migrateWithKey func(vf *VaultFile, masterKey []byte) error
+}
+
+// formatMigrations holds the migration to each format version (from the
+// version before it).
+var formatMigrations = map[int]formatMigration{
+	1: {
+		description: "record the format version of the vault file",
+	},
+	2: {
+		description:    "derive the content key from the master key (see HKDFKeySchedule)",
+		migrateWithKey: migrateKeySchedule,
+	},
+}
+
+// migrateVaultFile converts a vault file to the current format version,
+// running each migration registered since the file's version in order. It
+// stops at the first migration that needs the vault's master key (see
+// migrateVaultFileWithKey).
+func migrateVaultFile(vf *VaultFile) error {
+	return runFormatMigrations(vf, nil)
+}
+
+// migrateVaultFileWithKey converts a vault file to the current format
+// version, including the migrations that need the vault's master key.
+func migrateVaultFileWithKey(vf *VaultFile, masterKey []byte) error {
+	return runFormatMigrations(vf, masterKey)
+}
+
+func runFormatMigrations(vf *VaultFile, masterKey []byte) error {
+	if vf.FormatVersion > CurrentFormatVersion {
+		return ErrUnsupportedFormatVersion
+	}
+
+	for version := vf.FormatVersion + 1; version <= CurrentFormatVersion; version++ {
+		migration, ok := formatMigrations[version]
+		if !ok {
+			return fmt.Errorf("No migration to format version %d", version)
+		}
+
+		var err error
+		switch {
+		case migration.migrateWithKey != nil && masterKey == nil:
+			return nil
+		case migration.migrateWithKey != nil:
+			err = migration.migrateWithKey(vf, masterKey)
+		case migration.migrate != nil:
+			err = migration.migrate(vf)
+		}
+		if err != nil {
+			return fmt.Errorf("Failed to migrate to format version %d (%s): %v", version, migration.description, err)
+		}
+		vf.FormatVersion = version
+	}
+
+	return nil
+}
+
+// migrateKeySchedule re-encrypts the content of a vault sealed before content
+// keys were derived from the master key (which used the master key itself).
+func migrateKeySchedule(vf *VaultFile, masterKey []byte) error {
+	if vf.KeySchedule == HKDFKeySchedule {
+		return nil
+	}
+
+	vault, err := openVaultFileWithKey(vf, masterKey)
+	if err != nil {
+		return err
+	}
+
+	vf.KeySchedule = HKDFKeySchedule
+	vf.Details = vf.Details.Clone()
+	return vf.encrypt(vault, masterKey)
+}
+
+// upgradeVaultFile runs the migrations of a vault file that need the vault's
+// master key, writing the file in the current format. The vault isn't sealed
+// again, so its revision, history and session cache are kept.
+func (s *store) upgradeVaultFile(name string, vf *VaultFile, masterKey []byte) error {
+	if vf.FormatVersion >= CurrentFormatVersion {
+		return nil
+	}
+
+	err := s.checkWritable(name)
+	if err != nil {
+		return err
+	}
+
+	unlock, err := lockBlob(s.backend, VaultBlob, name)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	err = checkUnmodified(s.backend, name, vf)
+	if err != nil {
+		return err
+	}
+
+	upgraded := *vf
+	err = migrateVaultFileWithKey(&upgraded, masterKey)
+	if err != nil {
+		return err
+	}
+
+	return updateVaultFile(s.backend, name, &upgraded)
+}
+
+// UpgradeVault rewrites a vault file in the current format version, returning
+// the version the file had. Files already in the current format are left
+// untouched. The vault is only opened (prompting the store's steward as
+// needed) when a migration has to re-encrypt it; the vault's history is kept
+// as is (revisions are migrated when they are opened).
+func (s *store) UpgradeVault(name string) (int, error) {
+	data, err := s.backend.Get(VaultBlob, name)
+	if err != nil {
+		return 0, err
+	}
+
+	vf := &VaultFile{}
+	err = json.Unmarshal(data, vf)
+	if err != nil {
+		return 0, err
+	}
+
+	version := vf.FormatVersion
+	if version == CurrentFormatVersion {
+		return version, nil
+	}
+
+	err = s.checkWritable(name)
+	if err != nil {
+		return version, err
+	}
+
+	err = migrateVaultFile(vf)
+	if err != nil {
+		return version, err
+	}
+	if vf.FormatVersion == CurrentFormatVersion {
+		return version, updateVaultFile(s.backend, name, vf)
+	}
+
+	// the remaining migrations re-encrypt the vault, so they need its key
+	masterKey := s.cachedKey(name, vf)
+	if masterKey == nil {
+		_, _, err = s.UnlockVault(name)
+		if err != nil {
+			return version, err
+		}
+
+		masterKey = s.cachedKey(name, vf)
+		if masterKey == nil {
+			return version, ErrKeyNotCached
+		}
+	}
+	defer zero(masterKey)
+
+	return version, s.upgradeVaultFile(name, vf, masterKey)
+}
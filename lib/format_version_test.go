@@ -0,0 +1,142 @@
+package vaulted_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/miquella/vaulted/lib"
+)
+
+func TestUpgradeVault(t *testing.T) {
+	root, err := ioutil.TempDir("", "vaulted")
+	if err != nil {
+		t.Fatalf("failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(root)
+
+	backend := vaulted.NewFileBackend(root)
+	backend.ReadOnlyVaultDirs = []string{filepath.Join(root, "system")}
+	store := vaulted.New(vaulted.NewStaticSteward("password"), backend)
+
+	vault := &vaulted.Vault{
+		Vars: map[string]string{
+			"TEST": "VALUE",
+		},
+	}
+	err = store.SealVaultWithPassword(vault, "one", "password")
+	if err != nil {
+		t.Fatalf("failed to seal vault: %v", err)
+	}
+
+	filename := filepath.Join(backend.VaultDir, "one")
+	if formatVersion(t, filename) != vaulted.CurrentFormatVersion {
+		t.Fatalf("expected a new vault to use format version %d", vaulted.CurrentFormatVersion)
+	}
+
+	// a vault file written before the format was versioned
+	setFormatVersion(t, filename, 0)
+
+	version, err := vaulted.New(vaulted.NewStaticSteward("password"), backend).UpgradeVault("one")
+	if err != nil {
+		t.Fatalf("failed to upgrade vault: %v", err)
+	}
+	if version != 0 || formatVersion(t, filename) != vaulted.CurrentFormatVersion {
+		t.Fatalf("expected the vault to be upgraded from format version 0, got %d", version)
+	}
+
+	v, _, err := store.OpenVaultWithPassword("one", "password")
+	if err != nil {
+		t.Fatalf("failed to open upgraded vault: %v", err)
+	}
+	if !reflect.DeepEqual(vault, v) {
+		t.Fatalf("expected %#v, got %#v", vault, v)
+	}
+
+	// opening a vault upgrades it too (migrations are idempotent, so a file
+	// claiming an older version is upgraded again without harm)
+	setFormatVersion(t, filename, 1)
+
+	v, _, err = store.OpenVaultWithPassword("one", "password")
+	if err != nil {
+		t.Fatalf("failed to open vault: %v", err)
+	}
+	if !reflect.DeepEqual(vault, v) {
+		t.Fatalf("expected %#v, got %#v", vault, v)
+	}
+	if formatVersion(t, filename) != vaulted.CurrentFormatVersion {
+		t.Fatal("expected the vault to be upgraded when it was opened")
+	}
+
+	version, err = store.UpgradeVault("one")
+	if err != nil || version != vaulted.CurrentFormatVersion {
+		t.Fatalf("expected the vault to be up to date, got %d (%v)", version, err)
+	}
+
+	// a vault file written by a newer version
+	setFormatVersion(t, filename, vaulted.CurrentFormatVersion+1)
+
+	_, _, err = store.OpenVaultWithPassword("one", "password")
+	if err != vaulted.ErrUnsupportedFormatVersion {
+		t.Fatalf("expected %v, got %v", vaulted.ErrUnsupportedFormatVersion, err)
+	}
+	_, err = store.UpgradeVault("one")
+	if err != vaulted.ErrUnsupportedFormatVersion {
+		t.Fatalf("expected %v, got %v", vaulted.ErrUnsupportedFormatVersion, err)
+	}
+
+	// system vaults are read-only
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	writeFile(t, filepath.Join(root, "system", "two"), string(data))
+	setFormatVersion(t, filepath.Join(root, "system", "two"), 0)
+
+	_, err = store.UpgradeVault("two")
+	if err != vaulted.ErrReadOnlyVault {
+		t.Fatalf("expected %v, got %v", vaulted.ErrReadOnlyVault, err)
+	}
+}
+
+func formatVersion(t *testing.T, filename string) int {
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	vf := vaulted.VaultFile{}
+	err = json.Unmarshal(data, &vf)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return vf.FormatVersion
+}
+
+func setFormatVersion(t *testing.T, filename string, version int) {
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	vf := vaulted.VaultFile{}
+	err = json.Unmarshal(data, &vf)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	vf.FormatVersion = version
+	data, err = json.Marshal(vf)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = ioutil.WriteFile(filename, data, 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,97 @@
+package vaulted
+
+import (
+	"encoding/json"
+	"os"
+	"time"
+)
+
+var (
+	// HistoryLength is the number of revisions kept in each vault's history
+	// (including the current revision)
+	HistoryLength = 10
+)
+
+// HistoryEntry is a revision of a vault. The vault file is kept exactly as it
+// was sealed, so opening it requires the password that was in use at the
+// time.
+type HistoryEntry struct {
+	Revision  int        `json:"revision"`
+	Operation string     `json:"operation,omitempty"`
+	Timestamp time.Time  `json:"timestamp"`
+	VaultFile *VaultFile `json:"vault"`
+}
+
+type historyFile struct {
+	Entries []*HistoryEntry `json:"entries"`
+}
+
+func readHistory(backend Backend, name string) ([]*HistoryEntry, error) {
+	data, err := backend.Get(VaultHistoryBlob, name)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	hf := historyFile{}
+	err = json.Unmarshal(data, &hf)
+	if err != nil {
+		return nil, err
+	}
+
+	return hf.Entries, nil
+}
+
+func writeHistory(backend Backend, name string, entries []*HistoryEntry) error {
+	data, err := json.Marshal(historyFile{Entries: entries})
+	if err != nil {
+		return err
+	}
+
+	return backend.Put(VaultHistoryBlob, name, data)
+}
+
+// recordHistory adds a newly sealed vault file to the vault's history,
+// discarding the oldest revisions beyond HistoryLength.
+//
+// previous is the vault file that was replaced (if any). It is added to the
+// history as well when it predates the history being kept.
+func recordHistory(backend Backend, name string, previous *VaultFile, entry *HistoryEntry) error {
+	entries, err := readHistory(backend, name)
+	if err != nil {
+		return err
+	}
+
+	if previous != nil && findHistoryEntry(entries, previous.Revision) == nil {
+		entries = append(entries, &HistoryEntry{
+			Revision:  previous.Revision,
+			VaultFile: previous,
+		})
+	}
+	entries = append(entries, entry)
+
+	if len(entries) > HistoryLength {
+		entries = entries[len(entries)-HistoryLength:]
+	}
+
+	return writeHistory(backend, name, entries)
+}
+
+// findHistoryEntry returns the most recent entry for a revision. A vault
+// replaced by an import is kept in the history, so its revisions may be
+// repeated by the imported vault (whose revisions take precedence).
+func findHistoryEntry(entries []*HistoryEntry, revision int) *HistoryEntry {
+	for i := len(entries) - 1; i >= 0; i-- {
+		if entries[i].Revision == revision {
+			return entries[i]
+		}
+	}
+
+	return nil
+}
+
+func removeHistory(backend Backend, name string) error {
+	return backend.Delete(VaultHistoryBlob, name)
+}
@@ -0,0 +1,84 @@
+package vaulted
+
+import (
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/miquella/xdg"
+	"golang.org/x/crypto/curve25519"
+)
+
+var (
+	ErrNoIdentity      = errors.New("No identity available to open the vault")
+	ErrInvalidIdentity = errors.New("Invalid identity")
+)
+
+// Identity is an X25519 key pair used to open vaults sealed for recipients.
+type Identity struct {
+	PrivateKey []byte `json:"private_key"`
+	PublicKey  []byte `json:"public_key"`
+}
+
+// DefaultIdentityFile returns the location of the user's identity file.
+func DefaultIdentityFile() string {
+	return xdg.CONFIG_HOME.Join("vaulted", "identity")
+}
+
+func GenerateIdentity() (*Identity, error) {
+	var privateKey, publicKey [32]byte
+	_, err := rand.Read(privateKey[:])
+	if err != nil {
+		return nil, err
+	}
+
+	curve25519.ScalarBaseMult(&publicKey, &privateKey)
+
+	return &Identity{
+		PrivateKey: privateKey[:],
+		PublicKey:  publicKey[:],
+	}, nil
+}
+
+func ReadIdentityFile(filename string) (*Identity, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	identity := Identity{}
+	err = json.NewDecoder(f).Decode(&identity)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(identity.PrivateKey) != 32 || len(identity.PublicKey) != 32 {
+		return nil, ErrInvalidIdentity
+	}
+
+	return &identity, nil
+}
+
+func WriteIdentityFile(filename string, identity *Identity) error {
+	err := os.MkdirAll(filepath.Dir(filename), 0700)
+	if err != nil {
+		return err
+	}
+
+	return writeFileAtomic(filename, 0600, func(w io.Writer) error {
+		return json.NewEncoder(w).Encode(identity)
+	})
+}
+
+// Recipient returns the recipient that vaults are sealed for so this identity
+// is able to open them.
+func (id *Identity) Recipient(name string) Recipient {
+	return Recipient{
+		Name:      name,
+		PublicKey: id.PublicKey,
+	}
+}
@@ -0,0 +1,113 @@
+package vaulted
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ResolveIncludes returns a copy of a vault with the content of the vaults it
+// includes merged in (see Vault.Includes). Included vaults are unlocked as
+// they would be by UnlockVault (prompting the store's steward as needed), and
+// may include other vaults themselves.
+//
+// Included vaults are merged in the order they are listed, so later includes
+// take precedence over earlier ones, while the vault's own content takes
+// precedence over all of its includes. An AWS key is only taken from an
+// included vault if the vault sets IncludeAWSKey and has no AWS key of its own
+// (the region, MFA device and role the vault sets still apply to it).
+func (s *store) ResolveIncludes(vault *Vault, name string) (*Vault, error) {
+	return s.resolveIncludes(vault, []string{name}, make(map[string]*Vault))
+}
+
+// resolveIncludes resolves the includes of a vault, given the path of vaults
+// that included it (to detect cycles) and the vaults resolved so far (so
+// vaults included more than once are only unlocked once).
+func (s *store) resolveIncludes(vault *Vault, path []string, resolved map[string]*Vault) (*Vault, error) {
+	if len(vault.Includes) == 0 {
+		return vault, nil
+	}
+
+	merged := &Vault{
+		Duration: vault.Duration,
+	}
+
+	for _, include := range vault.Includes {
+		includePath := append(append([]string{}, path...), include)
+		for _, name := range path {
+			if name == include {
+				return nil, fmt.Errorf("Vault includes form a cycle: %s", strings.Join(includePath, " -> "))
+			}
+		}
+
+		included, exists := resolved[include]
+		if !exists {
+			if !s.VaultExists(include) {
+				return nil, fmt.Errorf("Included vault '%s' does not exist (included by '%s')", include, path[len(path)-1])
+			}
+
+			v, _, err := s.UnlockVault(include)
+			if err != nil {
+				return nil, err
+			}
+
+			included, err = s.resolveIncludes(v, includePath, resolved)
+			if err != nil {
+				return nil, err
+			}
+			resolved[include] = included
+		}
+
+		merged.mergeVars(included)
+		if vault.IncludeAWSKey && included.AWSKey.Valid() {
+			key := *included.AWSKey
+			merged.AWSKey = &key
+		}
+	}
+
+	merged.mergeVars(vault)
+	if vault.AWSKey.Valid() || merged.AWSKey == nil {
+		merged.AWSKey = vault.AWSKey
+	} else if vault.AWSKey != nil {
+		// the settings of the vault's own (partial) AWS key apply to the
+		// included AWS key
+		merged.AWSKey.mergeSettings(vault.AWSKey)
+	}
+
+	return merged, nil
+}
+
+// mergeSettings copies the settings of another AWS key that are set (its
+// region, MFA device and role, and whether temporary credentials are
+// generated) into the key, keeping the key's credentials.
+func (k *AWSKey) mergeSettings(other *AWSKey) {
+	if other.Region != nil && *other.Region != "" {
+		k.Region = other.Region
+	}
+	if other.MFA != "" {
+		k.MFA = other.MFA
+	}
+	if other.Role != "" {
+		k.Role = other.Role
+	}
+	if other.ForgoTempCredGeneration {
+		k.ForgoTempCredGeneration = true
+	}
+}
+
+// mergeVars copies the vars and SSH keys of another vault into the vault
+// (replacing any that already exist).
+func (v *Vault) mergeVars(other *Vault) {
+	if len(other.Vars) > 0 && v.Vars == nil {
+		v.Vars = make(map[string]string)
+	}
+	for key, value := range other.Vars {
+		v.Vars[key] = value
+	}
+
+	if len(other.SSHKeys) > 0 && v.SSHKeys == nil {
+		v.SSHKeys = make(map[string]string)
+	}
+	for key, value := range other.SSHKeys {
+		v.SSHKeys[key] = value
+	}
+}
@@ -0,0 +1,189 @@
+package vaulted_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/miquella/vaulted/lib"
+)
+
+func sealTestVaults(t *testing.T, store vaulted.Store, vaults map[string]*vaulted.Vault) {
+	for name, vault := range vaults {
+		err := store.SealVaultWithPassword(vault, name, "password")
+		if err != nil {
+			t.Fatalf("failed to seal vault '%s': %v", name, err)
+		}
+	}
+}
+
+func TestResolveIncludes(t *testing.T) {
+	store := vaulted.New(vaulted.NewStaticSteward("password"), vaulted.NewMemoryBackend())
+	sealTestVaults(t, store, map[string]*vaulted.Vault{
+		"proxy": {
+			Vars: map[string]string{"HTTP_PROXY": "proxy", "REGISTRY": "proxy", "SHARED": "proxy"},
+		},
+		"registry": {
+			Vars:     map[string]string{"REGISTRY": "registry", "SHARED": "registry"},
+			SSHKeys:  map[string]string{"deploy": "registry key"},
+			Includes: []string{"base"},
+		},
+		"base": {
+			Vars: map[string]string{"BASE": "base"},
+			AWSKey: &vaulted.AWSKey{
+				AWSCredentials: vaulted.AWSCredentials{ID: "base-id", Secret: "base-secret"},
+			},
+		},
+	})
+
+	vault := &vaulted.Vault{
+		Vars:     map[string]string{"SHARED": "account"},
+		Includes: []string{"proxy", "registry"},
+	}
+	resolved, err := store.ResolveIncludes(vault, "account")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// later includes take precedence, and the vault's own vars over all
+	expectedVars := map[string]string{
+		"HTTP_PROXY": "proxy",
+		"REGISTRY":   "registry",
+		"SHARED":     "account",
+		"BASE":       "base",
+	}
+	if !reflect.DeepEqual(expectedVars, resolved.Vars) {
+		t.Fatalf("expected vars %v, got %v", expectedVars, resolved.Vars)
+	}
+	if resolved.SSHKeys["deploy"] != "registry key" {
+		t.Fatalf("expected the included SSH key, got %v", resolved.SSHKeys)
+	}
+	if resolved.AWSKey != nil {
+		t.Fatalf("expected no AWS key unless included, got %#v", resolved.AWSKey)
+	}
+	if len(vault.Vars) != 1 {
+		t.Fatalf("expected the vault to be unmodified, got %v", vault.Vars)
+	}
+}
+
+func TestResolveIncludesAWSKey(t *testing.T) {
+	store := vaulted.New(vaulted.NewStaticSteward("password"), vaulted.NewMemoryBackend())
+	sealTestVaults(t, store, map[string]*vaulted.Vault{
+		"base": {
+			AWSKey: &vaulted.AWSKey{
+				AWSCredentials: vaulted.AWSCredentials{ID: "base-id", Secret: "base-secret"},
+			},
+		},
+	})
+
+	region := "us-west-2"
+	vault := &vaulted.Vault{
+		AWSKey: &vaulted.AWSKey{
+			AWSCredentials: vaulted.AWSCredentials{Region: &region},
+			MFA:            "arn:aws:iam::111111111111:mfa/account",
+			Role:           "arn:aws:iam::111111111111:role/account",
+		},
+		Includes:      []string{"base"},
+		IncludeAWSKey: true,
+	}
+	resolved, err := store.ResolveIncludes(vault, "account")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resolved.AWSKey == nil || resolved.AWSKey.ID != "base-id" {
+		t.Fatalf("expected the included AWS key, got %#v", resolved.AWSKey)
+	}
+	if resolved.AWSKey.Region == nil || *resolved.AWSKey.Region != region {
+		t.Fatal("expected the vault's region to apply to the included AWS key")
+	}
+	if resolved.AWSKey.MFA != vault.AWSKey.MFA || resolved.AWSKey.Role != vault.AWSKey.Role {
+		t.Fatalf("expected the vault's MFA and role to apply to the included AWS key, got %#v", resolved.AWSKey)
+	}
+
+	// the vault's own AWS key takes precedence
+	vault.AWSKey = &vaulted.AWSKey{
+		AWSCredentials: vaulted.AWSCredentials{ID: "account-id", Secret: "account-secret"},
+	}
+	resolved, err = store.ResolveIncludes(vault, "account")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resolved.AWSKey.ID != "account-id" {
+		t.Fatalf("expected the vault's own AWS key, got %#v", resolved.AWSKey)
+	}
+}
+
+func TestResolveIncludesCycle(t *testing.T) {
+	store := vaulted.New(vaulted.NewStaticSteward("password"), vaulted.NewMemoryBackend())
+	sealTestVaults(t, store, map[string]*vaulted.Vault{
+		"one":   {Includes: []string{"two"}},
+		"two":   {Includes: []string{"three"}},
+		"three": {Includes: []string{"one"}},
+	})
+
+	vault, _, err := store.OpenVault("one")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = store.ResolveIncludes(vault, "one")
+	if err == nil || !strings.Contains(err.Error(), "one -> two -> three -> one") {
+		t.Fatalf("expected an include cycle error, got %v", err)
+	}
+
+	// including a vault more than once (without a cycle) is fine
+	sealTestVaults(t, store, map[string]*vaulted.Vault{
+		"three": {Vars: map[string]string{"THREE": "three"}},
+	})
+	vault.Includes = []string{"two", "three"}
+	resolved, err := store.ResolveIncludes(vault, "one")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resolved.Vars["THREE"] != "three" {
+		t.Fatalf("expected the included var, got %v", resolved.Vars)
+	}
+}
+
+func TestResolveIncludesMissingVault(t *testing.T) {
+	store := vaulted.New(vaulted.NewStaticSteward("password"), vaulted.NewMemoryBackend())
+
+	vault := &vaulted.Vault{Includes: []string{"missing"}}
+	_, err := store.ResolveIncludes(vault, "one")
+	if err == nil || !strings.Contains(err.Error(), "'missing' does not exist") {
+		t.Fatalf("expected a missing include error, got %v", err)
+	}
+}
+
+func TestGetSessionWithIncludes(t *testing.T) {
+	store := vaulted.New(vaulted.NewStaticSteward("password"), vaulted.NewMemoryBackend())
+	sealTestVaults(t, store, map[string]*vaulted.Vault{
+		"common":  {Vars: map[string]string{"PROXY": "old"}},
+		"account": {Includes: []string{"common"}},
+	})
+
+	vault, password, err := store.OpenVault("account")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	session, err := store.GetSession(vault, "account", password)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if session.Vars["PROXY"] != "old" {
+		t.Fatalf("expected the included var, got %v", session.Vars)
+	}
+
+	// changing the included vault invalidates the cached session
+	sealTestVaults(t, store, map[string]*vaulted.Vault{
+		"common": {Vars: map[string]string{"PROXY": "new"}},
+	})
+	session, err = store.GetSession(vault, "account", password)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if session.Vars["PROXY"] != "new" {
+		t.Fatalf("expected the updated included var, got %v", session.Vars)
+	}
+}
@@ -0,0 +1,157 @@
+package vaulted
+
+import (
+	"crypto/sha512"
+	"fmt"
+	"sync"
+	"time"
+
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/pbkdf2"
+)
+
+const (
+	DefaultKeyDerivationTarget = 500 * time.Millisecond
+
+	// pbkdf2CalibrationIterations is the number of iterations timed to
+	// calibrate pbkdf2-sha512
+	pbkdf2CalibrationIterations = 1 << 14
+
+	// maxCostFactor bounds a calibrated cost to this many times the method's
+	// default cost (e.g. when the timed derivation was too quick to measure)
+	maxCostFactor = 100
+)
+
+var (
+	// KeyDerivationTarget is how long deriving a vault's key should take. The
+	// cost of the key derivation method is calibrated to the machine when a
+	// vault is created, when its key derivation method is switched, and when
+	// it is sealed with SealOptions.Recalibrate (the cost is never lower than
+	// the method's default). Calibration is disabled when it is zero.
+	KeyDerivationTarget = DefaultKeyDerivationTarget
+)
+
+// kdfCalibration times a key derivation method, returning the cost parameters
+// (stored in the key's details) that make deriving a key take about target.
+// Larger parameters are always more costly.
+type kdfCalibration func(target time.Duration) Details
+
+var kdfCalibrations = map[string]kdfCalibration{
+	"pbkdf2-sha512": calibratePBKDF2,
+	"argon2id":      calibrateArgon2id,
+}
+
+var calibrations = struct {
+	sync.Mutex
+	details map[string]Details
+}{
+	details: make(map[string]Details),
+}
+
+// calibratedVaultKey returns the key configuration for a new key using the
+// key derivation method, with its cost calibrated to KeyDerivationTarget.
+// The cost parameters are never lower than the method's defaults, or than
+// those in minimum (e.g. the details of the key being replaced).
+func calibratedVaultKey(method string, minimum Details) (*VaultKey, error) {
+	vk, err := defaultVaultKey(method)
+	if err != nil {
+		return nil, err
+	}
+
+	calibrated := calibratedDetails(method, KeyDerivationTarget)
+	for name := range calibrated {
+		if calibrated.Int(name) > vk.Details.Int(name) {
+			vk.Details.SetInt(name, calibrated.Int(name))
+		}
+	}
+
+	// only the cost parameters are raised (the salt is always new)
+	for name := range vk.Details {
+		if minimum.Int(name) > vk.Details.Int(name) {
+			vk.Details.SetInt(name, minimum.Int(name))
+		}
+	}
+
+	return vk, nil
+}
+
+// calibratedDetails returns the cost parameters of a key derivation method
+// for the target (nil when calibration is disabled, or the method has no
+// cost to calibrate). Each method is only timed once per target.
+func calibratedDetails(method string, target time.Duration) Details {
+	calibrate, ok := kdfCalibrations[method]
+	if !ok || target <= 0 {
+		return nil
+	}
+
+	calibrations.Lock()
+	defer calibrations.Unlock()
+
+	key := fmt.Sprintf("%s/%s", method, target)
+	if details, ok := calibrations.details[key]; ok {
+		return details
+	}
+
+	details := calibrate(target)
+	calibrations.details[key] = details
+	return details
+}
+
+func calibratePBKDF2(target time.Duration) Details {
+	elapsed := timeKeyDerivation(func(password, salt []byte) {
+		pbkdf2.Key(password, salt, pbkdf2CalibrationIterations, encryptionKeySize, sha512.New)
+	})
+
+	return Details{
+		"iterations": scaleCost(pbkdf2CalibrationIterations, elapsed, target, maxCostFactor*BaseIterations),
+	}
+}
+
+// calibrateArgon2id only calibrates the number of passes, the memory (and
+// parallelism) are kept at their defaults.
+func calibrateArgon2id(target time.Duration) Details {
+	elapsed := timeKeyDerivation(func(password, salt []byte) {
+		argon2.IDKey(password, salt, 1, Argon2Memory, Argon2Parallelism, encryptionKeySize)
+	})
+
+	return Details{
+		"time": scaleCost(1, elapsed, target, maxCostFactor*Argon2Time),
+	}
+}
+
+// timeKeyDerivation returns the fastest of a few runs of derive, so the
+// result isn't skewed by the first run (e.g. by allocating memory).
+func timeKeyDerivation(derive func(password, salt []byte)) time.Duration {
+	password := []byte("calibration password")
+	salt := make([]byte, 32)
+
+	var fastest time.Duration
+	for i := 0; i < 3; i++ {
+		start := time.Now()
+		derive(password, salt)
+		elapsed := time.Since(start)
+
+		if i == 0 || elapsed < fastest {
+			fastest = elapsed
+		}
+	}
+
+	return fastest
+}
+
+// scaleCost scales a cost that took elapsed to one taking target, between 1
+// and maximum.
+func scaleCost(cost int, elapsed, target time.Duration, maximum int) int {
+	if elapsed <= 0 {
+		elapsed = 1
+	}
+
+	scaled := float64(cost) * float64(target) / float64(elapsed)
+	if scaled > float64(maximum) {
+		return maximum
+	}
+	if scaled < 1 {
+		return 1
+	}
+	return int(scaled)
+}
@@ -0,0 +1,177 @@
+package vaulted
+
+import (
+	"testing"
+	"time"
+)
+
+// stubCalibration replaces the calibration of a key derivation method for the
+// duration of a test.
+func stubCalibration(t *testing.T, method string, details Details) {
+	calibration := kdfCalibrations[method]
+	target := KeyDerivationTarget
+	t.Cleanup(func() {
+		kdfCalibrations[method] = calibration
+		KeyDerivationTarget = target
+
+		calibrations.Lock()
+		calibrations.details = make(map[string]Details)
+		calibrations.Unlock()
+	})
+
+	kdfCalibrations[method] = func(target time.Duration) Details {
+		return details
+	}
+	KeyDerivationTarget = time.Second
+}
+
+func TestCalibratedVaultKey(t *testing.T) {
+	stubCalibration(t, "pbkdf2-sha512", Details{"iterations": BaseIterations * 4})
+	stubCalibration(t, "argon2id", Details{"time": 1})
+
+	vk, err := calibratedVaultKey("pbkdf2-sha512", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if vk.Details.Int("iterations") != BaseIterations*4 {
+		t.Fatalf("expected %d iterations, got %d", BaseIterations*4, vk.Details.Int("iterations"))
+	}
+
+	// the cost is never lower than the existing cost
+	vk, err = calibratedVaultKey("pbkdf2-sha512", Details{"iterations": BaseIterations * 8, "salt": "c2FsdA=="})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if vk.Details.Int("iterations") != BaseIterations*8 {
+		t.Fatalf("expected %d iterations, got %d", BaseIterations*8, vk.Details.Int("iterations"))
+	}
+	if vk.Details.String("salt") != "" {
+		t.Fatal("the salt should not be kept")
+	}
+
+	// or lower than the default cost
+	vk, err = calibratedVaultKey("argon2id", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if vk.Details.Int("time") != Argon2Time || vk.Details.Int("memory") != Argon2Memory {
+		t.Fatalf("expected the default cost, got %v", vk.Details)
+	}
+
+	// calibration is disabled without a target
+	KeyDerivationTarget = 0
+	vk, err = calibratedVaultKey("pbkdf2-sha512", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	iterations := vk.Details.Int("iterations")
+	if iterations < BaseIterations || iterations >= BaseIterations+AdditionIterationsRange {
+		t.Fatalf("expected the default number of iterations, got %d", iterations)
+	}
+
+	_, err = calibratedVaultKey("bcrypt", nil)
+	if err == nil {
+		t.Fatal("expected an invalid key derivation method to fail")
+	}
+}
+
+func TestCalibrateKeyDerivation(t *testing.T) {
+	details := calibratePBKDF2(10 * time.Millisecond)
+	if details.Int("iterations") < 1 {
+		t.Fatalf("expected a number of iterations, got %v", details)
+	}
+
+	details = calibrateArgon2id(time.Nanosecond)
+	if details.Int("time") != 1 {
+		t.Fatalf("expected a single pass, got %v", details)
+	}
+}
+
+func TestScaleCost(t *testing.T) {
+	if cost := scaleCost(10, time.Second, 2*time.Second, 1000); cost != 20 {
+		t.Fatalf("expected a cost of 20, got %d", cost)
+	}
+	if cost := scaleCost(10, time.Second, time.Millisecond, 1000); cost != 1 {
+		t.Fatalf("expected a cost of 1, got %d", cost)
+	}
+
+	// a derivation too quick to measure doesn't give an absurd cost
+	for _, elapsed := range []time.Duration{0, time.Nanosecond} {
+		if cost := scaleCost(pbkdf2CalibrationIterations, elapsed, time.Hour, maxCostFactor*BaseIterations); cost != maxCostFactor*BaseIterations {
+			t.Fatalf("expected the cost to be clamped to %d, got %d", maxCostFactor*BaseIterations, cost)
+		}
+	}
+
+	details := calibrateArgon2id(time.Hour)
+	if details.Int("time") > maxCostFactor*Argon2Time {
+		t.Fatalf("expected at most %d passes, got %v", maxCostFactor*Argon2Time, details)
+	}
+}
+
+func TestSealVaultRecalibrate(t *testing.T) {
+	backend := NewMemoryBackend()
+	store := New(NewStaticSteward("password"), backend)
+
+	KeyDerivationTarget = 0
+	err := store.SealVaultWithOptions(&Vault{}, "one", "password", SealOptions{
+		KeyMethod: "pbkdf2-sha512",
+	})
+	if err != nil {
+		t.Fatalf("failed to seal vault: %v", err)
+	}
+
+	stubCalibration(t, "pbkdf2-sha512", Details{"iterations": BaseIterations * 4})
+
+	iterations := func() int {
+		vf, err := readVaultFile(backend, "one")
+		if err != nil {
+			t.Fatal(err)
+		}
+		return vf.Key.Details.Int("iterations")
+	}
+
+	// the cost is kept when the vault is sealed again
+	before := iterations()
+	err = store.SealVaultWithPassword(&Vault{}, "one", "password")
+	if err != nil {
+		t.Fatalf("failed to seal vault: %v", err)
+	}
+	if iterations() != before {
+		t.Fatalf("expected %d iterations, got %d", before, iterations())
+	}
+
+	err = store.SealVaultWithOptions(&Vault{}, "one", "password", SealOptions{
+		Recalibrate: true,
+	})
+	if err != nil {
+		t.Fatalf("failed to seal vault: %v", err)
+	}
+	if iterations() != BaseIterations*4 {
+		t.Fatalf("expected %d iterations, got %d", BaseIterations*4, iterations())
+	}
+
+	_, _, err = store.OpenVault("one")
+	if err != nil {
+		t.Fatalf("failed to open recalibrated vault: %v", err)
+	}
+}
+
+func TestVaultKeyRefusesExcessiveCosts(t *testing.T) {
+	for _, details := range []Details{
+		{"iterations": maxCostFactor*BaseIterations + 1},
+		{"time": maxCostFactor*Argon2Time + 1, "memory": Argon2Memory, "parallelism": Argon2Parallelism},
+		{"time": Argon2Time, "memory": 1<<32 + Argon2Memory, "parallelism": Argon2Parallelism},
+	} {
+		method := "argon2id"
+		if _, ok := details["iterations"]; ok {
+			method = "pbkdf2-sha512"
+		}
+		details.SetBytes("salt", []byte("salt"))
+
+		vk := &VaultKey{Method: method, Details: details}
+		_, err := vk.key("password", encryptionKeySize)
+		if err != ErrInvalidKeyConfig {
+			t.Fatalf("expected %v for %v, got %v", ErrInvalidKeyConfig, details, err)
+		}
+	}
+}
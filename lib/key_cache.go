@@ -0,0 +1,113 @@
+package vaulted
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+)
+
+var (
+	ErrKeyNotCached = errors.New("Key is not cached")
+)
+
+// keyFingerprint identifies the key a vault file is sealed with (without
+// revealing anything about the key itself). nil is returned for vaults whose
+// keys are not derived from a password.
+func keyFingerprint(vf *VaultFile) []byte {
+	if vf.Key == nil || vf.Key.Method == RecipientKeyMethod {
+		return nil
+	}
+
+	data, err := json.Marshal(vf.Key)
+	if err != nil {
+		return nil
+	}
+
+	sum := sha256.Sum256(data)
+	return sum[:]
+}
+
+// openedKey is the master key of a vault opened (or sealed) by the store.
+type openedKey struct {
+	fingerprint []byte
+	key         *SecureBuffer
+}
+
+// openedKeyFingerprint identifies the master key of a vault file (including
+// the data keys of vaults sealed for recipients, which change each time the
+// vault is sealed).
+func openedKeyFingerprint(vf *VaultFile) []byte {
+	data, err := json.Marshal(struct {
+		Key        *VaultKey       `json:"key"`
+		Recipients []*RecipientKey `json:"recipients,omitempty"`
+	}{
+		Key:        vf.Key,
+		Recipients: vf.Recipients,
+	})
+	if err != nil {
+		return nil
+	}
+
+	sum := sha256.Sum256(data)
+	return sum[:]
+}
+
+// cachedKey returns a copy of the master key of a vault file when it was
+// already opened by the store, or is held by the steward's key cache (nil is
+// returned otherwise). The caller is responsible for wiping the key.
+func (s *store) cachedKey(name string, vf *VaultFile) []byte {
+	s.keysLock.Lock()
+	opened := s.keys[name]
+	s.keysLock.Unlock()
+	if opened != nil && bytes.Equal(opened.fingerprint, openedKeyFingerprint(vf)) {
+		if key := opened.key.Copy(); key != nil {
+			return key
+		}
+	}
+
+	keyCache, ok := s.steward.(StewardKeyCache)
+	fingerprint := keyFingerprint(vf)
+	if !ok || fingerprint == nil {
+		return nil
+	}
+
+	key, err := keyCache.GetKey(name, fingerprint)
+	if err != nil {
+		return nil
+	}
+
+	s.rememberKey(name, vf, key)
+	return key
+}
+
+// cacheKey keeps the master key of a vault file, and hands it to the
+// steward's key cache. Errors are ignored, since the key is only cached for
+// convenience.
+func (s *store) cacheKey(name string, vf *VaultFile, key []byte) {
+	s.rememberKey(name, vf, key)
+
+	keyCache, ok := s.steward.(StewardKeyCache)
+	fingerprint := keyFingerprint(vf)
+	if !ok || fingerprint == nil {
+		return
+	}
+
+	keyCache.PutKey(name, fingerprint, key)
+}
+
+// rememberKey keeps a copy of the master key of a vault file in a
+// SecureBuffer, wiping the key previously kept for the vault.
+func (s *store) rememberKey(name string, vf *VaultFile, key []byte) {
+	s.keysLock.Lock()
+	defer s.keysLock.Unlock()
+
+	if previous := s.keys[name]; previous != nil {
+		previous.key.Destroy()
+	}
+
+	s.keys[name] = &openedKey{
+		fingerprint: openedKeyFingerprint(vf),
+		key:         NewSecureBuffer(key),
+	}
+}
@@ -0,0 +1,126 @@
+package vaulted_test
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/miquella/vaulted/lib"
+)
+
+type keyCacheSteward struct {
+	vaulted.StaticSteward
+
+	prompts int
+	keys    map[string][]byte
+}
+
+func (s *keyCacheSteward) GetPassword(operation vaulted.Operation, name string) (string, error) {
+	s.prompts++
+	return s.Password, nil
+}
+
+func (s *keyCacheSteward) GetKey(name string, fingerprint []byte) ([]byte, error) {
+	key, ok := s.keys[name+string(fingerprint)]
+	if !ok {
+		return nil, vaulted.ErrKeyNotCached
+	}
+	return append([]byte{}, key...), nil
+}
+
+func (s *keyCacheSteward) PutKey(name string, fingerprint, key []byte) error {
+	s.keys[name+string(fingerprint)] = append([]byte{}, key...)
+	return nil
+}
+
+func TestUnlockVault(t *testing.T) {
+	steward := &keyCacheSteward{
+		StaticSteward: vaulted.StaticSteward{Password: "password"},
+		keys:          make(map[string][]byte),
+	}
+	backend := vaulted.NewMemoryBackend()
+	store := vaulted.New(steward, backend)
+
+	vault := &vaulted.Vault{
+		Vars: map[string]string{"TEST": "UNLOCKED"},
+	}
+	err := store.SealVaultWithPassword(vault, "cached", "password")
+	if err != nil {
+		t.Fatalf("failed to seal vault: %v", err)
+	}
+
+	// sealing caches the new key, so no password is needed
+	unlocked, password, err := store.UnlockVault("cached")
+	if err != nil {
+		t.Fatalf("failed to unlock vault: %v", err)
+	}
+	if password != "" {
+		t.Fatalf("expected no password, got %q", password)
+	}
+	if !reflect.DeepEqual(vault, unlocked) {
+		t.Fatalf("expected %#v, got %#v", vault, unlocked)
+	}
+	if steward.prompts != 0 {
+		t.Fatalf("expected no password prompts, got %d", steward.prompts)
+	}
+
+	// the cached key is not used once the vault is sealed with another key
+	// (by another store, since the keys opened by a store are kept by it)
+	for name := range steward.keys {
+		steward.keys[name] = bytes.Repeat([]byte{1}, 32)
+	}
+	store = vaulted.New(steward, backend)
+
+	_, password, err = store.UnlockVault("cached")
+	if err != nil {
+		t.Fatalf("failed to unlock vault: %v", err)
+	}
+	if password != "password" || steward.prompts != 1 {
+		t.Fatalf("expected the password to be requested once, got %q after %d prompts", password, steward.prompts)
+	}
+
+	// opening the vault with its password caches the key again
+	_, _, err = store.UnlockVault("cached")
+	if err != nil {
+		t.Fatalf("failed to unlock vault: %v", err)
+	}
+	if steward.prompts != 1 {
+		t.Fatalf("expected the cached key to be used, got %d prompts", steward.prompts)
+	}
+
+	_, _, err = store.UnlockVault("missing")
+	if err == nil {
+		t.Fatal("expected unlocking a missing vault to fail")
+	}
+}
+
+func TestUnlockVaultWithStewardKey(t *testing.T) {
+	steward := &keyCacheSteward{
+		StaticSteward: vaulted.StaticSteward{Password: "password"},
+		keys:          make(map[string][]byte),
+	}
+	backend := vaulted.NewMemoryBackend()
+
+	vault := &vaulted.Vault{
+		Vars: map[string]string{"TEST": "UNLOCKED"},
+	}
+	err := vaulted.New(steward, backend).SealVaultWithPassword(vault, "cached", "password")
+	if err != nil {
+		t.Fatalf("failed to seal vault: %v", err)
+	}
+
+	// the keys used by the store are wiped, but not the copies held by the
+	// steward
+	for i := 0; i < 2; i++ {
+		unlocked, _, err := vaulted.New(steward, backend).UnlockVault("cached")
+		if err != nil {
+			t.Fatalf("failed to unlock vault: %v", err)
+		}
+		if !reflect.DeepEqual(vault, unlocked) {
+			t.Fatalf("expected %#v, got %#v", vault, unlocked)
+		}
+	}
+	if steward.prompts != 0 {
+		t.Fatalf("expected no password prompts, got %d", steward.prompts)
+	}
+}
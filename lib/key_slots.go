@@ -0,0 +1,135 @@
+package vaulted
+
+import (
+	"crypto/rand"
+	"errors"
+
+	"golang.org/x/crypto/chacha20poly1305"
+)
+
+const (
+	// KeySlotsKeyMethod is the key method of vaults whose master key is
+	// wrapped by several passwords (key slots)
+	KeySlotsKeyMethod = "key-slots"
+)
+
+var (
+	ErrKeySlotNotExist      = errors.New("Key slot does not exist")
+	ErrLastKeySlot          = errors.New("The last key slot of a vault cannot be removed")
+	ErrKeySlotsNotSupported = errors.New("Key slots cannot be used with vaults sealed for recipients")
+)
+
+// KeySlot is a copy of a vault's master key wrapped with a key derived from
+// one of the vault's passwords. Each slot has its own key derivation method
+// and parameters.
+type KeySlot struct {
+	ID         int       `json:"id"`
+	Key        *VaultKey `json:"key"`
+	Nonce      []byte    `json:"nonce"`
+	WrappedKey []byte    `json:"wrapped_key"`
+}
+
+func newKeySlot(id int, masterKey []byte, password, keyMethod string) (*KeySlot, error) {
+	if keyMethod == "" {
+		keyMethod = DefaultKeyMethod
+	}
+
+	vk, err := calibratedVaultKey(keyMethod, nil)
+	if err != nil {
+		return nil, err
+	}
+	vk = newVaultKey(vk)
+
+	wrappingKey, err := vk.key(password, chacha20poly1305.KeySize)
+	if err != nil {
+		return nil, err
+	}
+	defer zero(wrappingKey)
+
+	aead, err := chacha20poly1305.NewX(wrappingKey)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	_, err = rand.Read(nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	return &KeySlot{
+		ID:         id,
+		Key:        vk,
+		Nonce:      nonce,
+		WrappedKey: aead.Seal(nil, nonce, masterKey, nil),
+	}, nil
+}
+
+// open unwraps the master key using the slot's password.
+func (ks *KeySlot) open(password string) ([]byte, error) {
+	if ks.Key == nil {
+		return nil, ErrInvalidKeyConfig
+	}
+
+	wrappingKey, err := ks.Key.key(password, chacha20poly1305.KeySize)
+	if err != nil {
+		return nil, err
+	}
+	defer zero(wrappingKey)
+
+	aead, err := chacha20poly1305.NewX(wrappingKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(ks.Nonce) != aead.NonceSize() {
+		return nil, ErrInvalidKeyConfig
+	}
+
+	masterKey, err := aead.Open(nil, ks.Nonce, ks.WrappedKey, nil)
+	if err != nil {
+		return nil, ErrIncorrectPassword
+	}
+
+	return masterKey, nil
+}
+
+// openKeySlots unwraps the master key from the first slot the password opens.
+func openKeySlots(slots []*KeySlot, password string) ([]byte, error) {
+	for _, slot := range slots {
+		masterKey, err := slot.open(password)
+		if err == ErrIncorrectPassword {
+			continue
+		}
+
+		return masterKey, err
+	}
+
+	return nil, ErrIncorrectPassword
+}
+
+func nextKeySlotID(slots []*KeySlot) int {
+	id := 0
+	for _, slot := range slots {
+		if slot.ID >= id {
+			id = slot.ID + 1
+		}
+	}
+	return id
+}
+
+// removeKeySlot returns the slots without the slot with the given ID (and
+// whether it was found).
+func removeKeySlot(slots []*KeySlot, id int) ([]*KeySlot, bool) {
+	var remaining []*KeySlot
+	found := false
+	for _, slot := range slots {
+		if slot.ID == id {
+			found = true
+			continue
+		}
+		remaining = append(remaining, slot)
+	}
+
+	return remaining, found
+}
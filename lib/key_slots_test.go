@@ -0,0 +1,246 @@
+package vaulted_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/miquella/vaulted/lib"
+)
+
+func TestKeySlots(t *testing.T) {
+	backend := vaulted.NewMemoryBackend()
+	store := vaulted.New(vaulted.NewStaticSteward("personal"), backend)
+
+	vault := &vaulted.Vault{
+		Vars: map[string]string{"TEST": "SLOTS"},
+	}
+	err := store.SealVaultWithOptions(vault, "slots", "personal", vaulted.SealOptions{
+		KeyMethod: "pbkdf2-sha512",
+	})
+	if err != nil {
+		t.Fatalf("failed to seal vault: %v", err)
+	}
+
+	slots, err := store.KeySlots("slots")
+	if err != nil {
+		t.Fatalf("failed to list key slots: %v", err)
+	}
+	if slots != nil {
+		t.Fatalf("expected no key slots, got %#v", slots)
+	}
+
+	// switching to key slots keeps the existing password in the first slot
+	id, err := store.AddKeySlot("slots", "personal", "recovery", "")
+	if err != nil {
+		t.Fatalf("failed to add key slot: %v", err)
+	}
+	if id != 1 {
+		t.Fatalf("expected key slot 1, got %d", id)
+	}
+
+	slots, err = store.KeySlots("slots")
+	if err != nil {
+		t.Fatalf("failed to list key slots: %v", err)
+	}
+	if len(slots) != 2 || slots[0].Key.Method != "pbkdf2-sha512" || slots[1].Key.Method != vaulted.DefaultKeyMethod {
+		t.Fatalf("unexpected key slots: %#v", slots)
+	}
+
+	for _, password := range []string{"personal", "recovery"} {
+		opened, _, err := store.OpenVaultWithPassword("slots", password)
+		if err != nil {
+			t.Fatalf("failed to open vault with %q: %v", password, err)
+		}
+		if !reflect.DeepEqual(vault, opened) {
+			t.Fatalf("expected %#v, got %#v", vault, opened)
+		}
+	}
+
+	_, _, err = store.OpenVaultWithPassword("slots", "wrong")
+	if err != vaulted.ErrIncorrectPassword {
+		t.Fatalf("expected %v, got %v", vaulted.ErrIncorrectPassword, err)
+	}
+
+	// adding a slot keeps the master key, sealing a new revision
+	before := readVaultFileFromBackend(t, backend, "slots")
+	_, err = store.AddKeySlot("slots", "recovery", "spare", "pbkdf2-sha512")
+	if err != nil {
+		t.Fatalf("failed to add key slot: %v", err)
+	}
+	after := readVaultFileFromBackend(t, backend, "slots")
+	if !bytes.Equal(before.Key.Details.Bytes("id"), after.Key.Details.Bytes("id")) || after.Revision != before.Revision+1 {
+		t.Fatal("expected adding a key slot to keep the master key in a new revision")
+	}
+	history, err := store.VaultHistory("slots")
+	if err != nil {
+		t.Fatalf("failed to read history: %v", err)
+	}
+	if last := history[len(history)-1]; last.Revision != after.Revision || last.Operation != "passwd" {
+		t.Fatalf("expected the new revision to be recorded in the history, got %#v", last)
+	}
+
+	_, err = store.AddKeySlot("slots", "wrong", "other", "")
+	if err != vaulted.ErrIncorrectPassword {
+		t.Fatalf("expected %v, got %v", vaulted.ErrIncorrectPassword, err)
+	}
+
+	// sealing with any slot's password keeps every slot
+	vault.Vars["TEST"] = "RESEALED"
+	err = store.SealVaultWithPassword(vault, "slots", "spare")
+	if err != nil {
+		t.Fatalf("failed to seal vault: %v", err)
+	}
+
+	opened, _, err := store.OpenVaultWithPassword("slots", "personal")
+	if err != nil {
+		t.Fatalf("failed to open vault: %v", err)
+	}
+	if !reflect.DeepEqual(vault, opened) {
+		t.Fatalf("expected %#v, got %#v", vault, opened)
+	}
+
+	err = store.SealVaultWithPassword(vault, "slots", "wrong")
+	if err != vaulted.ErrIncorrectPassword {
+		t.Fatalf("expected %v, got %v", vaulted.ErrIncorrectPassword, err)
+	}
+
+	// removing a slot
+	err = store.RemoveKeySlot("slots", "recovery", 0)
+	if err != nil {
+		t.Fatalf("failed to remove key slot: %v", err)
+	}
+
+	_, _, err = store.OpenVaultWithPassword("slots", "personal")
+	if err != vaulted.ErrIncorrectPassword {
+		t.Fatalf("expected %v, got %v", vaulted.ErrIncorrectPassword, err)
+	}
+
+	// the removed password no longer opens revisions from the history either
+	revision, err := store.VaultRevision("slots")
+	if err != nil {
+		t.Fatalf("failed to get revision: %v", err)
+	}
+	_, err = store.OpenVaultRevision("slots", revision-1, "personal")
+	if err != vaulted.ErrIncorrectPassword {
+		t.Fatalf("expected %v, got %v", vaulted.ErrIncorrectPassword, err)
+	}
+
+	// slots added later open older revisions
+	_, err = store.OpenVaultRevision("slots", revision-1, "spare")
+	if err != nil {
+		t.Fatalf("failed to open revision: %v", err)
+	}
+
+	err = store.RemoveKeySlot("slots", "recovery", 0)
+	if err != vaulted.ErrKeySlotNotExist {
+		t.Fatalf("expected %v, got %v", vaulted.ErrKeySlotNotExist, err)
+	}
+
+	err = store.RemoveKeySlot("slots", "recovery", 2)
+	if err != nil {
+		t.Fatalf("failed to remove key slot: %v", err)
+	}
+
+	err = store.RemoveKeySlot("slots", "recovery", 1)
+	if err != vaulted.ErrLastKeySlot {
+		t.Fatalf("expected %v, got %v", vaulted.ErrLastKeySlot, err)
+	}
+
+	// switching the key derivation method seals with a single password again
+	err = store.SealVaultWithOptions(vault, "slots", "single", vaulted.SealOptions{
+		KeyMethod: "pbkdf2-sha512",
+	})
+	if err != nil {
+		t.Fatalf("failed to seal vault: %v", err)
+	}
+
+	slots, err = store.KeySlots("slots")
+	if err != nil {
+		t.Fatalf("failed to list key slots: %v", err)
+	}
+	if slots != nil {
+		t.Fatalf("expected no key slots, got %#v", slots)
+	}
+
+	err = store.RemoveKeySlot("slots", "single", 1)
+	if err != vaulted.ErrKeySlotNotExist {
+		t.Fatalf("expected %v, got %v", vaulted.ErrKeySlotNotExist, err)
+	}
+}
+
+func TestKeySlotsSealedForRecipients(t *testing.T) {
+	identity := generateTestIdentity(t)
+	store := vaulted.New(&vaulted.StaticSteward{Identity: identity}, vaulted.NewMemoryBackend())
+
+	err := store.SealVaultWithOptions(&vaulted.Vault{}, "team", "", vaulted.SealOptions{
+		Recipients: []vaulted.Recipient{identity.Recipient("")},
+	})
+	if err != nil {
+		t.Fatalf("failed to seal vault: %v", err)
+	}
+
+	_, err = store.AddKeySlot("team", "", "password", "")
+	if err != vaulted.ErrKeySlotsNotSupported {
+		t.Fatalf("expected %v, got %v", vaulted.ErrKeySlotsNotSupported, err)
+	}
+}
+
+func TestSealVaultReplace(t *testing.T) {
+	backend := vaulted.NewMemoryBackend()
+	store := vaulted.New(vaulted.NewStaticSteward("password"), backend)
+
+	recovery, _, err := vaulted.NewRecoveryKey(3, 2)
+	if err != nil {
+		t.Fatalf("failed to create recovery key: %v", err)
+	}
+	err = store.SealVaultWithOptions(&vaulted.Vault{}, "one", "password", vaulted.SealOptions{Recovery: recovery})
+	if err != nil {
+		t.Fatalf("failed to seal vault: %v", err)
+	}
+	_, err = store.AddKeySlot("one", "password", "spare", "")
+	if err != nil {
+		t.Fatalf("failed to add key slot: %v", err)
+	}
+
+	// another vault is copied over the vault (opened with its own password)
+	vault := &vaulted.Vault{Vars: map[string]string{"TEST": "REPLACED"}}
+	err = store.SealVaultWithOptions(vault, "one", "other", vaulted.SealOptions{Replace: true})
+	if err != nil {
+		t.Fatalf("failed to replace vault: %v", err)
+	}
+
+	vf := readVaultFileFromBackend(t, backend, "one")
+	if vf.Key.Method != vaulted.DefaultKeyMethod || vf.Slots != nil || vf.Recovery != nil {
+		t.Fatalf("expected the key slots and recovery key to be replaced, got %#v", vf)
+	}
+
+	opened, _, err := store.OpenVaultWithPassword("one", "other")
+	if err != nil {
+		t.Fatalf("failed to open vault: %v", err)
+	}
+	if !reflect.DeepEqual(vault, opened) {
+		t.Fatalf("expected %#v, got %#v", vault, opened)
+	}
+
+	_, err = store.OpenVaultRevision("one", vf.Revision-1, "spare")
+	if err != nil {
+		t.Fatalf("expected the replaced vault to be kept in the history: %v", err)
+	}
+}
+
+func readVaultFileFromBackend(t *testing.T, backend vaulted.Backend, name string) *vaulted.VaultFile {
+	data, err := backend.Get(vaulted.VaultBlob, name)
+	if err != nil {
+		t.Fatalf("failed to read vault file: %v", err)
+	}
+
+	vf := vaulted.VaultFile{}
+	err = json.Unmarshal(data, &vf)
+	if err != nil {
+		t.Fatalf("failed to parse vault file: %v", err)
+	}
+
+	return &vf
+}
@@ -0,0 +1,16 @@
+package vaulted_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/miquella/vaulted/lib"
+)
+
+func TestMain(m *testing.M) {
+	// derive keys with the default cost, so tests aren't slowed down by the
+	// cost being calibrated to the machine
+	vaulted.KeyDerivationTarget = 0
+
+	os.Exit(m.Run())
+}
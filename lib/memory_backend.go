@@ -0,0 +1,97 @@
+package vaulted
+
+import (
+	"os"
+	"sort"
+	"sync"
+)
+
+// MemoryBackend keeps blobs in memory. It is primarily useful for tests and
+// for embedding the library where vaults shouldn't touch the filesystem.
+type MemoryBackend struct {
+	mu    sync.Mutex
+	blobs map[BlobKind]map[string][]byte
+	locks map[string]*sync.Mutex
+}
+
+func NewMemoryBackend() *MemoryBackend {
+	return &MemoryBackend{
+		blobs: make(map[BlobKind]map[string][]byte),
+		locks: make(map[string]*sync.Mutex),
+	}
+}
+
+func (b *MemoryBackend) Get(kind BlobKind, name string) ([]byte, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	data, exists := b.blobs[kind][name]
+	if !exists {
+		return nil, os.ErrNotExist
+	}
+
+	return append([]byte(nil), data...), nil
+}
+
+func (b *MemoryBackend) Put(kind BlobKind, name string, data []byte) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.blobs[kind] == nil {
+		b.blobs[kind] = make(map[string][]byte)
+	}
+	b.blobs[kind][name] = append([]byte(nil), data...)
+
+	return nil
+}
+
+func (b *MemoryBackend) Append(kind BlobKind, name string, data []byte) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.blobs[kind] == nil {
+		b.blobs[kind] = make(map[string][]byte)
+	}
+	b.blobs[kind][name] = append(append([]byte(nil), b.blobs[kind][name]...), data...)
+
+	return nil
+}
+
+func (b *MemoryBackend) List(kind BlobKind) ([]string, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	var names []string
+	for name := range b.blobs[kind] {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names, nil
+}
+
+func (b *MemoryBackend) Delete(kind BlobKind, name string) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if _, exists := b.blobs[kind][name]; !exists {
+		return os.ErrNotExist
+	}
+	delete(b.blobs[kind], name)
+
+	return nil
+}
+
+func (b *MemoryBackend) Lock(kind BlobKind, name string) (func(), error) {
+	b.mu.Lock()
+	key := string(kind) + "/" + name
+	lock := b.locks[key]
+	if lock == nil {
+		lock = &sync.Mutex{}
+		b.locks[key] = lock
+	}
+	b.mu.Unlock()
+
+	lock.Lock()
+	return lock.Unlock, nil
+}
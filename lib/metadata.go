@@ -0,0 +1,203 @@
+package vaulted
+
+import (
+	"encoding/json"
+	"reflect"
+	"time"
+)
+
+// VaultMetadata describes a vault, so it can be listed without being opened.
+// It is stored unencrypted in the vault file.
+//
+// Everything except LastUsed is authenticated along with the vault's content
+// when it is sealed (see VaultFile.associatedData), so the metadata can't be
+// swapped without the vault failing to open. LastUsed is updated each time
+// the vault is used, so it is kept apart from the vault file (see
+// recordLastUsed); vault files written by older versions may still hold it.
+type VaultMetadata struct {
+	Description string   `json:"description,omitempty"`
+	Tags        []string `json:"tags,omitempty"`
+	Team        string   `json:"team,omitempty"`
+
+	Created  *time.Time `json:"created,omitempty"`
+	Modified *time.Time `json:"modified,omitempty"`
+	LastUsed *time.Time `json:"last_used,omitempty"`
+
+	// Unverified is set (by Store.VaultMetadata) when the description, tags
+	// or team were changed without opening the vault (see
+	// Store.SetVaultMetadata), so they are not authenticated yet.
+	Unverified bool `json:"unverified,omitempty"`
+}
+
+// HasTag returns whether the vault is tagged with tag.
+func (md *VaultMetadata) HasTag(tag string) bool {
+	for _, t := range md.Tags {
+		if t == tag {
+			return true
+		}
+	}
+	return false
+}
+
+func (md *VaultMetadata) clone() *VaultMetadata {
+	if md == nil {
+		return &VaultMetadata{}
+	}
+
+	copied := *md
+	copied.Tags = append([]string(nil), md.Tags...)
+	if len(copied.Tags) == 0 {
+		copied.Tags = nil
+	}
+	return &copied
+}
+
+// sameDescription returns whether the description, tags and team of the
+// metadata match.
+func (md *VaultMetadata) sameDescription(other *VaultMetadata) bool {
+	a, b := md.clone(), other.clone()
+	return a.Description == b.Description && a.Team == b.Team && reflect.DeepEqual(a.Tags, b.Tags)
+}
+
+// authenticated returns the fields of the metadata covered by the vault's
+// associated data, or nil when there are none (as for vaults sealed before
+// metadata was added, which may have had LastUsed recorded since).
+func (md *VaultMetadata) authenticated() *VaultMetadata {
+	if md == nil {
+		return nil
+	}
+
+	authenticated := md.clone()
+	authenticated.LastUsed = nil
+	authenticated.Unverified = false
+	if reflect.DeepEqual(authenticated, &VaultMetadata{}) {
+		return nil
+	}
+	return authenticated
+}
+
+// metadata returns the vault file's metadata, including changes that are not
+// authenticated yet.
+func (vf *VaultFile) metadata() *VaultMetadata {
+	md := vf.Metadata.clone()
+	if vf.PendingMetadata != nil {
+		pending := vf.PendingMetadata.clone()
+		md.Description = pending.Description
+		md.Tags = pending.Tags
+		md.Team = pending.Team
+		md.Unverified = true
+	}
+	return md
+}
+
+// sealedMetadata returns the metadata of a vault being sealed at now. The
+// existing vault's authenticated metadata is kept, unless replaced by the
+// description, tags and team of replacement. Changes made without opening the
+// vault are discarded, since anyone able to write the vault file could have
+// made them.
+func sealedMetadata(existingVaultFile *VaultFile, replacement *VaultMetadata, now time.Time) *VaultMetadata {
+	md := &VaultMetadata{}
+	if existingVaultFile != nil {
+		md = existingVaultFile.Metadata.clone()
+	}
+
+	if replacement != nil {
+		replacement = replacement.clone()
+		md.Description = replacement.Description
+		md.Tags = replacement.Tags
+		md.Team = replacement.Team
+	}
+
+	if md.Created == nil {
+		md.Created = &now
+	}
+	md.Modified = &now
+	md.Unverified = false
+
+	return md
+}
+
+// VaultMetadata returns the metadata of a vault (without opening it). Changes
+// made by SetVaultMetadata since the vault was last sealed are included, and
+// marked as Unverified.
+func (s *store) VaultMetadata(name string) (*VaultMetadata, error) {
+	vf, err := readVaultFile(s.backend, name)
+	if err != nil {
+		return nil, err
+	}
+
+	md := vf.metadata()
+	if lastUsed := readLastUsed(s.backend, name); lastUsed != nil && (md.LastUsed == nil || lastUsed.After(*md.LastUsed)) {
+		md.LastUsed = lastUsed
+	}
+	return md, nil
+}
+
+// SetVaultMetadata changes the description, tags and team of a vault without
+// opening it (the other fields of metadata are ignored).
+//
+// Since the changes can't be authenticated without the vault's key, they are
+// kept apart from the vault's authenticated metadata (and reported as
+// Unverified). Sealing the vault discards them, unless they are passed as
+// SealOptions.Metadata (e.g. once the user has confirmed them).
+func (s *store) SetVaultMetadata(name string, metadata *VaultMetadata) error {
+	vf, err := readVaultFile(s.backend, name)
+	if err != nil {
+		return err
+	}
+
+	err = s.checkWritable(name)
+	if err != nil {
+		return err
+	}
+
+	pending := metadata.clone()
+	vf.PendingMetadata = &VaultMetadata{
+		Description: pending.Description,
+		Tags:        pending.Tags,
+		Team:        pending.Team,
+	}
+	if vf.PendingMetadata.sameDescription(vf.Metadata) {
+		vf.PendingMetadata = nil
+	}
+
+	return updateVaultFile(s.backend, name, vf)
+}
+
+// recordLastUsed records the time a vault was last used (unless it was used
+// since). The time is kept apart from the vault file, so using a vault never
+// modifies it (or conflicts with it being sealed, or synced, at the same time).
+// Errors are ignored, since failing to record the time shouldn't prevent the
+// vault from being used.
+func (s *store) recordLastUsed(name string, at time.Time) {
+	unlock, err := lockBlob(s.backend, LastUsedBlob, name)
+	if err != nil {
+		return
+	}
+	defer unlock()
+
+	if lastUsed := readLastUsed(s.backend, name); lastUsed != nil && !at.After(*lastUsed) {
+		return
+	}
+
+	data, err := json.Marshal(at)
+	if err != nil {
+		return
+	}
+
+	s.backend.Put(LastUsedBlob, name, data)
+}
+
+// readLastUsed returns the time recorded by recordLastUsed (nil if none).
+func readLastUsed(backend Backend, name string) *time.Time {
+	data, err := backend.Get(LastUsedBlob, name)
+	if err != nil {
+		return nil
+	}
+
+	var lastUsed time.Time
+	if json.Unmarshal(data, &lastUsed) != nil {
+		return nil
+	}
+	return &lastUsed
+}
@@ -0,0 +1,251 @@
+package vaulted_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/miquella/vaulted/lib"
+)
+
+func TestVaultMetadata(t *testing.T) {
+	backend := vaulted.NewMemoryBackend()
+	store := vaulted.New(vaulted.NewStaticSteward("password"), backend)
+
+	err := store.SealVaultWithOptions(&vaulted.Vault{}, "one", "password", vaulted.SealOptions{
+		Method: "xchacha20poly1305",
+		Metadata: &vaulted.VaultMetadata{
+			Description: "Production",
+			Tags:        []string{"aws", "prod"},
+			Team:        "ops",
+		},
+	})
+	if err != nil {
+		t.Fatalf("failed to seal vault: %v", err)
+	}
+
+	sealed, err := store.VaultMetadata("one")
+	if err != nil {
+		t.Fatalf("failed to get metadata: %v", err)
+	}
+	if sealed.Description != "Production" || !reflect.DeepEqual([]string{"aws", "prod"}, sealed.Tags) || sealed.Team != "ops" {
+		t.Fatalf("unexpected metadata: %#v", sealed)
+	}
+	if sealed.Created == nil || sealed.Modified == nil || sealed.LastUsed != nil || sealed.Unverified {
+		t.Fatalf("unexpected metadata: %#v", sealed)
+	}
+
+	// resealing keeps the metadata (and when the vault was created)
+	err = store.SealVaultWithPassword(&vaulted.Vault{}, "one", "password")
+	if err != nil {
+		t.Fatalf("failed to seal vault: %v", err)
+	}
+
+	resealed, err := store.VaultMetadata("one")
+	if err != nil {
+		t.Fatalf("failed to get metadata: %v", err)
+	}
+	if resealed.Description != "Production" || !resealed.Created.Equal(*sealed.Created) || resealed.Modified.Before(*sealed.Modified) {
+		t.Fatalf("unexpected metadata: %#v", resealed)
+	}
+
+	// using the vault records when it was used (without modifying the vault
+	// file)
+	before, err := backend.Get(vaulted.VaultBlob, "one")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, _, err = store.UnlockVault("one")
+	if err != nil {
+		t.Fatalf("failed to unlock vault: %v", err)
+	}
+
+	used, err := store.VaultMetadata("one")
+	if err != nil {
+		t.Fatalf("failed to get metadata: %v", err)
+	}
+	if used.LastUsed == nil {
+		t.Fatal("expected the vault's last use to be recorded")
+	}
+
+	after, err := backend.Get(vaulted.VaultBlob, "one")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(before, after) {
+		t.Fatal("using the vault should not modify the vault file")
+	}
+
+	// the last use recorded in vault files by older versions is kept apart
+	// from the vault file once it is sealed again
+	vf := &vaulted.VaultFile{}
+	err = json.Unmarshal(after, vf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	later := used.LastUsed.Add(time.Hour)
+	vf.Metadata.LastUsed = &later
+	data, err := json.Marshal(vf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = backend.Put(vaulted.VaultBlob, "one", data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = store.SealVaultWithPassword(&vaulted.Vault{}, "one", "password")
+	if err != nil {
+		t.Fatalf("failed to seal vault: %v", err)
+	}
+
+	data, err = backend.Get(vaulted.VaultBlob, "one")
+	if err != nil {
+		t.Fatal(err)
+	}
+	vf = &vaulted.VaultFile{}
+	err = json.Unmarshal(data, vf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if vf.Metadata.LastUsed != nil {
+		t.Fatal("expected the vault file not to record the vault's last use")
+	}
+
+	used, err = store.VaultMetadata("one")
+	if err != nil {
+		t.Fatalf("failed to get metadata: %v", err)
+	}
+	if used.LastUsed == nil || !used.LastUsed.Equal(later) {
+		t.Fatalf("expected the vault to be last used at %v, got %v", later, used.LastUsed)
+	}
+}
+
+func TestSetVaultMetadata(t *testing.T) {
+	backend := vaulted.NewMemoryBackend()
+	store := vaulted.New(vaulted.NewStaticSteward("password"), backend)
+
+	err := store.SealVaultWithOptions(&vaulted.Vault{}, "one", "password", vaulted.SealOptions{
+		Method:   "aes-256-gcm",
+		Metadata: &vaulted.VaultMetadata{Description: "Old"},
+	})
+	if err != nil {
+		t.Fatalf("failed to seal vault: %v", err)
+	}
+
+	err = store.SetVaultMetadata("one", &vaulted.VaultMetadata{
+		Description: "New",
+		Tags:        []string{"prod"},
+	})
+	if err != nil {
+		t.Fatalf("failed to set metadata: %v", err)
+	}
+
+	metadata, err := store.VaultMetadata("one")
+	if err != nil {
+		t.Fatalf("failed to get metadata: %v", err)
+	}
+	if metadata.Description != "New" || !metadata.HasTag("prod") || !metadata.Unverified {
+		t.Fatalf("expected unverified changes, got %#v", metadata)
+	}
+
+	// the vault still opens, and sealing it discards the changes
+	vault, password, err := store.OpenVault("one")
+	if err != nil {
+		t.Fatalf("failed to open vault: %v", err)
+	}
+
+	err = store.SealVaultWithPassword(vault, "one", password)
+	if err != nil {
+		t.Fatalf("failed to seal vault: %v", err)
+	}
+
+	sealed, err := store.VaultMetadata("one")
+	if err != nil {
+		t.Fatalf("failed to get metadata: %v", err)
+	}
+	if sealed.Description != "Old" || sealed.HasTag("prod") || sealed.Unverified {
+		t.Fatalf("expected the changes to be discarded, got %#v", sealed)
+	}
+
+	// unless they are confirmed, which authenticates them
+	err = store.SetVaultMetadata("one", metadata)
+	if err != nil {
+		t.Fatalf("failed to set metadata: %v", err)
+	}
+	err = store.SealVaultWithOptions(vault, "one", password, vaulted.SealOptions{Metadata: metadata})
+	if err != nil {
+		t.Fatalf("failed to seal vault: %v", err)
+	}
+
+	metadata, err = store.VaultMetadata("one")
+	if err != nil {
+		t.Fatalf("failed to get metadata: %v", err)
+	}
+	if metadata.Description != "New" || !metadata.HasTag("prod") || metadata.Unverified {
+		t.Fatalf("expected verified changes, got %#v", metadata)
+	}
+
+	// reverting to the sealed metadata leaves nothing unverified
+	err = store.SetVaultMetadata("one", metadata)
+	if err != nil {
+		t.Fatalf("failed to set metadata: %v", err)
+	}
+
+	metadata, err = store.VaultMetadata("one")
+	if err != nil {
+		t.Fatalf("failed to get metadata: %v", err)
+	}
+	if metadata.Unverified {
+		t.Fatalf("expected verified metadata, got %#v", metadata)
+	}
+}
+
+func TestOpenVaultDetectsMetadataTampering(t *testing.T) {
+	// the default encryption method ("") authenticates the metadata as well
+	for _, method := range []string{"", "xchacha20poly1305", "aes-256-gcm"} {
+		backend := vaulted.NewMemoryBackend()
+		store := vaulted.New(vaulted.NewStaticSteward("password"), backend)
+
+		err := store.SealVaultWithOptions(&vaulted.Vault{}, "one", "password", vaulted.SealOptions{
+			Method:   method,
+			Metadata: &vaulted.VaultMetadata{Description: "Staging"},
+		})
+		if err != nil {
+			t.Fatalf("failed to seal vault: %v", err)
+		}
+
+		data, err := backend.Get(vaulted.VaultBlob, "one")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		vf := &vaulted.VaultFile{}
+		err = json.Unmarshal(data, vf)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if method == "" && vf.Method != vaulted.DefaultEncryptionMethod {
+			t.Fatalf("expected the default encryption method, got %s", vf.Method)
+		}
+
+		vf.Metadata.Description = "Production"
+		data, err = json.Marshal(vf)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		err = backend.Put(vaulted.VaultBlob, "one", data)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		_, _, err = vaulted.New(vaulted.NewStaticSteward("password"), backend).OpenVault("one")
+		if err != vaulted.ErrIncorrectPassword {
+			t.Fatalf("%s: expected: %v, got: %v", vf.Method, vaulted.ErrIncorrectPassword, err)
+		}
+	}
+}
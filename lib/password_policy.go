@@ -0,0 +1,377 @@
+package vaulted
+
+import (
+	"bufio"
+	"errors"
+	"math"
+	"os"
+	"strings"
+	"unicode"
+)
+
+const (
+	DefaultMinPasswordLength  = 8
+	DefaultMinPasswordEntropy = 35
+)
+
+var (
+	ErrPasswordTooShort = errors.New("Password is too short")
+	ErrPasswordTooWeak  = errors.New("Password is too weak (it is made of patterns that are easy to guess)")
+	ErrPasswordDenied   = errors.New("Password is too common (or is on the deny list)")
+)
+
+// PasswordPolicy decides whether a password is strong enough to seal a vault
+// with. CheckPassword returns an error describing why the password was
+// refused, or nil if it is acceptable.
+type PasswordPolicy interface {
+	CheckPassword(name, password string) error
+}
+
+// StrengthPolicy is the default password policy. Passwords must be at least
+// MinLength characters long, must not be on the deny list (or be the vault's
+// name), and must have an estimated entropy (see EstimateEntropy) of at least
+// MinEntropy bits.
+type StrengthPolicy struct {
+	MinLength  int
+	MinEntropy float64
+	DenyList   []string
+}
+
+// NewStrengthPolicy returns a StrengthPolicy using the default minimums and
+// deny list (a list of commonly used passwords).
+func NewStrengthPolicy() *StrengthPolicy {
+	return &StrengthPolicy{
+		MinLength:  DefaultMinPasswordLength,
+		MinEntropy: DefaultMinPasswordEntropy,
+		DenyList:   append([]string{}, commonPasswords...),
+	}
+}
+
+func (p *StrengthPolicy) CheckPassword(name, password string) error {
+	if len([]rune(password)) < p.MinLength {
+		return ErrPasswordTooShort
+	}
+
+	lower := strings.ToLower(password)
+	if lower == strings.ToLower(name) {
+		return ErrPasswordDenied
+	}
+	for _, denied := range p.DenyList {
+		if lower == strings.ToLower(denied) {
+			return ErrPasswordDenied
+		}
+	}
+
+	if EstimateEntropy(password, append([]string{name}, p.DenyList...)...) < p.MinEntropy {
+		return ErrPasswordTooWeak
+	}
+
+	return nil
+}
+
+// ReadDenyList reads a deny list from a file, one password per line (blank
+// lines and lines starting with '#' are ignored).
+func ReadDenyList(filename string) ([]string, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var denyList []string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		denyList = append(denyList, line)
+	}
+
+	return denyList, scanner.Err()
+}
+
+// EstimateEntropy estimates the entropy of a password (in bits), in the
+// spirit of zxcvbn: the password is split into the sequence of patterns that
+// is easiest to guess (dictionary words, sequences such as "abc" or "4321",
+// keyboard rows, repeated characters and years), with any characters not part
+// of a pattern guessed by brute force.
+//
+// The dictionary is made of userInputs (e.g. the vault's name), which are
+// considered the easiest words to guess, followed by commonly used passwords.
+func EstimateEntropy(password string, userInputs ...string) float64 {
+	runes := []rune(password)
+	if len(runes) == 0 {
+		return 0
+	}
+
+	dictionary := make(map[string]int)
+	for _, word := range userInputs {
+		for _, part := range strings.FieldsFunc(strings.ToLower(word), isWordSeparator) {
+			if _, present := dictionary[part]; !present {
+				dictionary[part] = len(dictionary) + 1
+			}
+		}
+	}
+	for _, word := range commonPasswords {
+		if _, present := dictionary[word]; !present {
+			dictionary[word] = len(dictionary) + 1
+		}
+	}
+
+	bruteforce := math.Log2(float64(bruteforceCardinality(runes)))
+
+	// best[i] is the entropy of the easiest way to guess runes[:i]
+	best := make([]float64, len(runes)+1)
+	for j := 1; j <= len(runes); j++ {
+		best[j] = best[j-1] + bruteforce
+		for i := 0; i <= j-3; i++ {
+			if bits, ok := patternEntropy(runes[i:j], dictionary); ok {
+				best[j] = math.Min(best[j], best[i]+bits)
+			}
+		}
+	}
+
+	return best[len(runes)]
+}
+
+// patternEntropy returns the entropy of token if it matches one of the
+// patterns EstimateEntropy knows about.
+func patternEntropy(token []rune, dictionary map[string]int) (float64, bool) {
+	bits := math.Inf(1)
+
+	word := strings.ToLower(string(token))
+	if rank, ok := dictionary[word]; ok {
+		bits = math.Min(bits, math.Log2(float64(rank))+uppercaseEntropy(token))
+	}
+	if rank, ok := dictionary[unleet(word)]; ok && unleet(word) != word {
+		// one more bit for each substitution that was used
+		bits = math.Min(bits, math.Log2(float64(rank))+uppercaseEntropy(token)+float64(leetCount(word)))
+	}
+	if rank, ok := dictionary[reverse(word)]; ok {
+		bits = math.Min(bits, math.Log2(float64(rank))+uppercaseEntropy(token)+1)
+	}
+
+	if isRepeat(token) {
+		bits = math.Min(bits, math.Log2(float64(bruteforceCardinality(token[:1])))+math.Log2(float64(len(token))))
+	}
+
+	if descending, ok := sequence(token); ok {
+		base := float64(bruteforceCardinality(token[:1]))
+		if strings.ContainsRune("aA01", token[0]) {
+			base = 2
+		}
+		seqBits := math.Log2(base) + math.Log2(float64(len(token)))
+		if descending {
+			seqBits++
+		}
+		bits = math.Min(bits, seqBits)
+	}
+
+	if isKeyboardRun(word) {
+		bits = math.Min(bits, math.Log2(float64(len(keyboardRows)*10))+math.Log2(float64(len(token))))
+	}
+
+	if isYear(word) {
+		bits = math.Min(bits, math.Log2(200))
+	}
+
+	return bits, !math.IsInf(bits, 1)
+}
+
+func bruteforceCardinality(runes []rune) int {
+	var lower, upper, digit, symbol, other bool
+	for _, r := range runes {
+		switch {
+		case r >= 'a' && r <= 'z':
+			lower = true
+		case r >= 'A' && r <= 'Z':
+			upper = true
+		case r >= '0' && r <= '9':
+			digit = true
+		case r < unicode.MaxASCII:
+			symbol = true
+		default:
+			other = true
+		}
+	}
+
+	cardinality := 0
+	if lower {
+		cardinality += 26
+	}
+	if upper {
+		cardinality += 26
+	}
+	if digit {
+		cardinality += 10
+	}
+	if symbol {
+		cardinality += 33
+	}
+	if other {
+		cardinality += 100
+	}
+	return cardinality
+}
+
+// uppercaseEntropy is the entropy added by capitalizing a word. Capitalizing
+// the first (or every) letter adds a single bit.
+func uppercaseEntropy(token []rune) float64 {
+	var upper, lower int
+	for _, r := range token {
+		if unicode.IsUpper(r) {
+			upper++
+		} else if unicode.IsLower(r) {
+			lower++
+		}
+	}
+
+	switch {
+	case upper == 0:
+		return 0
+	case lower == 0 || (upper == 1 && unicode.IsUpper(token[0])):
+		return 1
+	}
+
+	// the number of ways to capitalize this many letters
+	combinations := 0.0
+	for i := 1; i <= upper; i++ {
+		combinations += binomial(upper+lower, i)
+	}
+	return math.Log2(combinations)
+}
+
+func binomial(n, k int) float64 {
+	result := 1.0
+	for i := 1; i <= k; i++ {
+		result = result * float64(n-k+i) / float64(i)
+	}
+	return result
+}
+
+var leetSubstitutions = map[rune]rune{
+	'4': 'a',
+	'@': 'a',
+	'8': 'b',
+	'3': 'e',
+	'6': 'g',
+	'1': 'i',
+	'!': 'i',
+	'0': 'o',
+	'5': 's',
+	'$': 's',
+	'7': 't',
+	'+': 't',
+	'2': 'z',
+}
+
+func unleet(word string) string {
+	return strings.Map(func(r rune) rune {
+		if sub, ok := leetSubstitutions[r]; ok {
+			return sub
+		}
+		return r
+	}, word)
+}
+
+func leetCount(word string) int {
+	count := 0
+	for _, r := range word {
+		if _, ok := leetSubstitutions[r]; ok {
+			count++
+		}
+	}
+	return count
+}
+
+func reverse(word string) string {
+	runes := []rune(word)
+	for i, j := 0, len(runes)-1; i < j; i, j = i+1, j-1 {
+		runes[i], runes[j] = runes[j], runes[i]
+	}
+	return string(runes)
+}
+
+func isRepeat(token []rune) bool {
+	for _, r := range token {
+		if r != token[0] {
+			return false
+		}
+	}
+	return true
+}
+
+// sequence reports whether token is a sequence of consecutive characters
+// (e.g. "abc" or "987"), and whether it's descending.
+func sequence(token []rune) (descending bool, ok bool) {
+	delta := token[1] - token[0]
+	if delta != 1 && delta != -1 {
+		return false, false
+	}
+	for i := 2; i < len(token); i++ {
+		if token[i]-token[i-1] != delta {
+			return false, false
+		}
+	}
+	return delta == -1, true
+}
+
+var keyboardRows = []string{
+	"1234567890",
+	"qwertyuiop",
+	"asdfghjkl",
+	"zxcvbnm",
+	"qwertzuiop",
+	"azertyuiop",
+}
+
+func isKeyboardRun(word string) bool {
+	for _, row := range keyboardRows {
+		if strings.Contains(row, word) || strings.Contains(row, reverse(word)) {
+			return true
+		}
+	}
+	return false
+}
+
+func isYear(word string) bool {
+	if len(word) != 4 || !(strings.HasPrefix(word, "19") || strings.HasPrefix(word, "20")) {
+		return false
+	}
+	for _, r := range word {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+func isWordSeparator(r rune) bool {
+	return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+}
+
+// commonPasswords are the most commonly used passwords (and words commonly
+// used in passwords), most common first.
+var commonPasswords = []string{
+	"123456", "password", "12345678", "qwerty", "123456789", "12345",
+	"1234", "111111", "1234567", "dragon", "123123", "baseball", "abc123",
+	"football", "monkey", "letmein", "696969", "shadow", "master", "666666",
+	"qwertyuiop", "123321", "mustang", "1234567890", "michael", "654321",
+	"superman", "1qaz2wsx", "7777777", "121212", "000000", "qazwsx",
+	"123qwe", "killer", "trustno1", "jordan", "jennifer", "zxcvbnm",
+	"asdfgh", "hunter", "buster", "soccer", "harley", "batman", "andrew",
+	"tigger", "sunshine", "iloveyou", "2000", "charlie", "robert", "thomas",
+	"hockey", "ranger", "daniel", "starwars", "klaster", "112233", "george",
+	"computer", "michelle", "jessica", "pepper", "1111", "zxcvbn", "555555",
+	"11111111", "131313", "freedom", "777777", "pass", "maggie", "159753",
+	"aaaaaa", "ginger", "princess", "joshua", "cheese", "amanda", "summer",
+	"love", "ashley", "nicole", "chelsea", "biteme", "matthew", "access",
+	"yankees", "987654321", "dallas", "austin", "thunder", "taylor",
+	"matrix", "admin", "welcome", "login", "passw0rd", "password1",
+	"password123", "qwerty123", "secret", "winter", "spring", "autumn",
+	"vaulted", "vault", "changeme", "default", "root", "toor", "test",
+	"guest", "hello", "whatever", "money", "orange", "apple", "banana",
+	"flower", "secure", "private", "company", "server", "production",
+	"staging", "development", "aws", "amazon", "google", "github",
+}
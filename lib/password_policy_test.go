@@ -0,0 +1,98 @@
+package vaulted_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/miquella/vaulted/lib"
+)
+
+func TestEstimateEntropy(t *testing.T) {
+	weak := []string{
+		"password",
+		"P@ssw0rd",
+		"Password123!",
+		"qwertyuiop",
+		"aaaaaaaaaaaa",
+		"abcdefghijkl",
+		"9876543210",
+		"Summer2024!",
+		"prod-payments",
+	}
+	for _, password := range weak {
+		if bits := vaulted.EstimateEntropy(password, "prod/payments"); bits >= vaulted.DefaultMinPasswordEntropy {
+			t.Errorf("expected %q to be weak, got %.1f bits", password, bits)
+		}
+	}
+
+	strong := []string{
+		"xK9#mQ2v",
+		"tr0ub4dor&3",
+		"correcthorsebatterystaple",
+	}
+	for _, password := range strong {
+		if bits := vaulted.EstimateEntropy(password, "prod/payments"); bits < vaulted.DefaultMinPasswordEntropy {
+			t.Errorf("expected %q to be strong, got %.1f bits", password, bits)
+		}
+	}
+
+	if vaulted.EstimateEntropy("") != 0 {
+		t.Error("expected an empty password to have no entropy")
+	}
+}
+
+func TestStrengthPolicy(t *testing.T) {
+	policy := vaulted.NewStrengthPolicy()
+	policy.DenyList = append(policy.DenyList, "acmecorp-vaults")
+
+	cases := []struct {
+		Name     string
+		Password string
+		Err      error
+	}{
+		{Name: "one", Password: "", Err: vaulted.ErrPasswordTooShort},
+		{Name: "one", Password: "xK9#mQ2", Err: vaulted.ErrPasswordTooShort},
+		{Name: "one", Password: "Password", Err: vaulted.ErrPasswordDenied},
+		{Name: "one", Password: "ACMECORP-VAULTS", Err: vaulted.ErrPasswordDenied},
+		{Name: "prod/payments", Password: "prod/payments", Err: vaulted.ErrPasswordDenied},
+		{Name: "prod/payments", Password: "payments2024", Err: vaulted.ErrPasswordTooWeak},
+		{Name: "one", Password: "xK9#mQ2v", Err: nil},
+	}
+
+	for _, c := range cases {
+		err := policy.CheckPassword(c.Name, c.Password)
+		if err != c.Err {
+			t.Errorf("expected %v for %q, got %v", c.Err, c.Password, err)
+		}
+	}
+
+	policy.MinLength = 0
+	policy.MinEntropy = 0
+	if err := policy.CheckPassword("one", "abc"); err != nil {
+		t.Errorf("expected the minimums to be configurable, got %v", err)
+	}
+}
+
+func TestReadDenyList(t *testing.T) {
+	dir, err := ioutil.TempDir("", "vaulted")
+	if err != nil {
+		t.Fatalf("failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	filename := filepath.Join(dir, "deny-list")
+	err = ioutil.WriteFile(filename, []byte("# company words\nacmecorp\n\n  hunter2  \n"), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	denyList, err := vaulted.ReadDenyList(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(denyList) != 2 || denyList[0] != "acmecorp" || denyList[1] != "hunter2" {
+		t.Fatalf("unexpected deny list: %#v", denyList)
+	}
+}
@@ -0,0 +1,168 @@
+package vaulted
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"io"
+
+	"golang.org/x/crypto/chacha20poly1305"
+	"golang.org/x/crypto/curve25519"
+	"golang.org/x/crypto/hkdf"
+)
+
+const (
+	// RecipientKeyMethod is the key method of vaults sealed for recipients
+	// (instead of a password)
+	RecipientKeyMethod = "x25519"
+
+	recipientKeyInfo = "vaulted-x25519"
+)
+
+var (
+	ErrNoRecipients     = errors.New("A vault must be sealed for at least one recipient")
+	ErrNotARecipient    = errors.New("Identity is not a recipient of the vault")
+	ErrInvalidPublicKey = errors.New("Invalid public key")
+)
+
+// Recipient is an X25519 public key that a vault is sealed for.
+type Recipient struct {
+	Name      string `json:"name,omitempty"`
+	PublicKey []byte `json:"public_key"`
+}
+
+// ParsePublicKey parses a public key in its text form (base64 encoded).
+func ParsePublicKey(text string) ([]byte, error) {
+	publicKey, err := base64.StdEncoding.DecodeString(text)
+	if err != nil || len(publicKey) != 32 {
+		return nil, ErrInvalidPublicKey
+	}
+
+	return publicKey, nil
+}
+
+// FormatPublicKey formats a public key in its text form (base64 encoded).
+func FormatPublicKey(publicKey []byte) string {
+	return base64.StdEncoding.EncodeToString(publicKey)
+}
+
+// RecipientKey is a copy of a vault's data key wrapped for a recipient.
+//
+// The data key is wrapped using a key derived (via HKDF-SHA256) from the
+// X25519 shared secret between an ephemeral key pair and the recipient.
+type RecipientKey struct {
+	Recipient
+	EphemeralKey []byte `json:"ephemeral_key"`
+	WrappedKey   []byte `json:"wrapped_key"`
+}
+
+func wrapRecipientKeys(dataKey []byte, recipients []Recipient) ([]*RecipientKey, error) {
+	if len(recipients) == 0 {
+		return nil, ErrNoRecipients
+	}
+
+	var recipientKeys []*RecipientKey
+	for _, recipient := range recipients {
+		if len(recipient.PublicKey) != 32 {
+			return nil, ErrInvalidPublicKey
+		}
+
+		var ephemeralPrivate, ephemeralPublic [32]byte
+		_, err := rand.Read(ephemeralPrivate[:])
+		if err != nil {
+			return nil, err
+		}
+		curve25519.ScalarBaseMult(&ephemeralPublic, &ephemeralPrivate)
+
+		wrappingKey, err := recipientWrappingKey(ephemeralPrivate[:], recipient.PublicKey, ephemeralPublic[:], recipient.PublicKey)
+		zero(ephemeralPrivate[:])
+		if err != nil {
+			return nil, err
+		}
+
+		aead, err := chacha20poly1305.New(wrappingKey)
+		zero(wrappingKey)
+		if err != nil {
+			return nil, err
+		}
+
+		// the wrapping key is unique, so a zero nonce is safe
+		nonce := make([]byte, aead.NonceSize())
+		recipientKeys = append(recipientKeys, &RecipientKey{
+			Recipient:    recipient,
+			EphemeralKey: ephemeralPublic[:],
+			WrappedKey:   aead.Seal(nil, nonce, dataKey, nil),
+		})
+	}
+
+	return recipientKeys, nil
+}
+
+func unwrapRecipientKey(recipientKeys []*RecipientKey, identity *Identity) ([]byte, error) {
+	for _, recipientKey := range recipientKeys {
+		if !bytes.Equal(recipientKey.PublicKey, identity.PublicKey) {
+			continue
+		}
+
+		wrappingKey, err := recipientWrappingKey(identity.PrivateKey, recipientKey.EphemeralKey, recipientKey.EphemeralKey, identity.PublicKey)
+		if err != nil {
+			return nil, err
+		}
+
+		aead, err := chacha20poly1305.New(wrappingKey)
+		zero(wrappingKey)
+		if err != nil {
+			return nil, err
+		}
+
+		nonce := make([]byte, aead.NonceSize())
+		dataKey, err := aead.Open(nil, nonce, recipientKey.WrappedKey, nil)
+		if err != nil {
+			return nil, ErrNotARecipient
+		}
+
+		return dataKey, nil
+	}
+
+	return nil, ErrNotARecipient
+}
+
+// recipientWrappingKey derives the key used to wrap a data key from the
+// shared secret between privateKey and peerKey. The ephemeral and recipient
+// public keys are bound to the derived key as well.
+func recipientWrappingKey(privateKey, peerKey, ephemeralKey, recipientKey []byte) ([]byte, error) {
+	if len(privateKey) != 32 || len(peerKey) != 32 || len(ephemeralKey) != 32 || len(recipientKey) != 32 {
+		return nil, ErrInvalidPublicKey
+	}
+
+	var private, peer, shared [32]byte
+	copy(private[:], privateKey)
+	copy(peer[:], peerKey)
+	curve25519.ScalarMult(&shared, &private, &peer)
+	zero(private[:])
+	defer zero(shared[:])
+
+	// reject low order points (which result in an all-zero shared secret)
+	if shared == [32]byte{} {
+		return nil, ErrInvalidPublicKey
+	}
+
+	wrappingKey := make([]byte, chacha20poly1305.KeySize)
+	salt := append(append([]byte{}, ephemeralKey...), recipientKey...)
+	_, err := io.ReadFull(hkdf.New(sha256.New, shared[:], salt, []byte(recipientKeyInfo)), wrappingKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return wrappingKey, nil
+}
+
+func recipientsOf(recipientKeys []*RecipientKey) []Recipient {
+	var recipients []Recipient
+	for _, recipientKey := range recipientKeys {
+		recipients = append(recipients, recipientKey.Recipient)
+	}
+	return recipients
+}
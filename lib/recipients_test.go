@@ -0,0 +1,196 @@
+package vaulted_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/miquella/vaulted/lib"
+)
+
+func TestSealVaultForRecipients(t *testing.T) {
+	alice := generateTestIdentity(t)
+	bob := generateTestIdentity(t)
+	eve := generateTestIdentity(t)
+
+	backend := vaulted.NewMemoryBackend()
+	aliceStore := vaulted.New(&vaulted.StaticSteward{Identity: alice}, backend)
+	bobStore := vaulted.New(&vaulted.StaticSteward{Identity: bob}, backend)
+	eveStore := vaulted.New(&vaulted.StaticSteward{Identity: eve}, backend)
+
+	vault := &vaulted.Vault{
+		Vars: map[string]string{"TEST": "SHARED"},
+	}
+	err := aliceStore.SealVaultWithOptions(vault, "team", "", vaulted.SealOptions{
+		Recipients: []vaulted.Recipient{alice.Recipient("alice"), bob.Recipient("bob")},
+	})
+	if err != nil {
+		t.Fatalf("failed to seal vault: %v", err)
+	}
+
+	for _, store := range []vaulted.Store{aliceStore, bobStore} {
+		opened, _, err := store.OpenVault("team")
+		if err != nil {
+			t.Fatalf("failed to open vault: %v", err)
+		}
+		if !reflect.DeepEqual(vault, opened) {
+			t.Fatalf("expected %#v, got %#v", vault, opened)
+		}
+	}
+
+	_, _, err = eveStore.OpenVault("team")
+	if err != vaulted.ErrNotARecipient {
+		t.Fatalf("expected %v, got %v", vaulted.ErrNotARecipient, err)
+	}
+
+	_, _, err = vaulted.New(vaulted.NewStaticSteward("password"), backend).OpenVault("team")
+	if err != vaulted.ErrNoIdentity {
+		t.Fatalf("expected %v, got %v", vaulted.ErrNoIdentity, err)
+	}
+
+	// resealing keeps the recipients
+	err = bobStore.SealVaultWithPassword(vault, "team", "")
+	if err != nil {
+		t.Fatalf("failed to reseal vault: %v", err)
+	}
+
+	recipients, err := aliceStore.VaultRecipients("team")
+	if err != nil {
+		t.Fatalf("failed to list recipients: %v", err)
+	}
+	expected := []vaulted.Recipient{alice.Recipient("alice"), bob.Recipient("bob")}
+	if !reflect.DeepEqual(expected, recipients) {
+		t.Fatalf("expected %#v, got %#v", expected, recipients)
+	}
+
+	// switching back to a password
+	err = aliceStore.SealVaultWithOptions(vault, "team", "password", vaulted.SealOptions{
+		KeyMethod: "pbkdf2-sha512",
+	})
+	if err != nil {
+		t.Fatalf("failed to seal vault: %v", err)
+	}
+
+	recipients, err = aliceStore.VaultRecipients("team")
+	if err != nil {
+		t.Fatalf("failed to list recipients: %v", err)
+	}
+	if recipients != nil {
+		t.Fatalf("expected no recipients, got %#v", recipients)
+	}
+
+	_, _, err = vaulted.New(vaulted.NewStaticSteward("password"), backend).OpenVault("team")
+	if err != nil {
+		t.Fatalf("failed to open vault with password: %v", err)
+	}
+}
+
+func TestSealVaultRefusesPlantedRecipient(t *testing.T) {
+	setupVaults(t)
+	defer teardownVaults(t)
+
+	alice := generateTestIdentity(t)
+	eve := generateTestIdentity(t)
+
+	backend := vaulted.NewXDGBackend()
+	aliceStore := vaulted.New(&vaulted.StaticSteward{Identity: alice}, backend)
+	eveStore := vaulted.New(&vaulted.StaticSteward{Identity: eve}, backend)
+
+	vault := &vaulted.Vault{
+		Vars: map[string]string{"TEST": "SHARED"},
+	}
+	err := aliceStore.SealVaultWithOptions(vault, "team", "", vaulted.SealOptions{
+		Recipients: []vaulted.Recipient{alice.Recipient("alice")},
+	})
+	if err != nil {
+		t.Fatalf("failed to seal vault: %v", err)
+	}
+	err = eveStore.SealVaultWithOptions(&vaulted.Vault{}, "eve", "", vaulted.SealOptions{
+		Recipients: []vaulted.Recipient{eve.Recipient("alice")},
+	})
+	if err != nil {
+		t.Fatalf("failed to seal vault: %v", err)
+	}
+
+	// eve is planted in the team vault's recipients
+	team := readTestVaultFile(t, "team")
+	team.Recipients = append(team.Recipients, readTestVaultFile(t, "eve").Recipients...)
+	writeTestVaultFile(t, "team", team)
+
+	for _, store := range []vaulted.Store{aliceStore, vaulted.New(&vaulted.StaticSteward{Identity: alice}, backend)} {
+		err = store.SealVaultWithPassword(vault, "team", "")
+		if err != vaulted.ErrVaultNotAuthenticated {
+			t.Fatalf("expected %v, got %v", vaulted.ErrVaultNotAuthenticated, err)
+		}
+	}
+
+	_, _, err = eveStore.OpenVault("team")
+	if err == nil {
+		t.Fatal("expected the planted recipient not to open the vault")
+	}
+}
+
+func TestSealVaultWithoutRecipients(t *testing.T) {
+	store := vaulted.New(vaulted.NewStaticSteward("password"), vaulted.NewMemoryBackend())
+	err := store.SealVaultWithOptions(&vaulted.Vault{}, "empty", "", vaulted.SealOptions{
+		Recipients: []vaulted.Recipient{},
+	})
+	if err != vaulted.ErrNoRecipients {
+		t.Fatalf("expected %v, got %v", vaulted.ErrNoRecipients, err)
+	}
+}
+
+func TestIdentityFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "vaulted")
+	if err != nil {
+		t.Fatalf("failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	identity := generateTestIdentity(t)
+	filename := filepath.Join(dir, "vaulted", "identity")
+	err = vaulted.WriteIdentityFile(filename, identity)
+	if err != nil {
+		t.Fatalf("failed to write identity: %v", err)
+	}
+
+	info, err := os.Stat(filename)
+	if err != nil {
+		t.Fatalf("failed to stat identity: %v", err)
+	}
+	if info.Mode().Perm() != 0600 {
+		t.Errorf("expected: %v, got: %v", os.FileMode(0600), info.Mode().Perm())
+	}
+
+	read, err := vaulted.ReadIdentityFile(filename)
+	if err != nil {
+		t.Fatalf("failed to read identity: %v", err)
+	}
+	if !bytes.Equal(identity.PrivateKey, read.PrivateKey) || !bytes.Equal(identity.PublicKey, read.PublicKey) {
+		t.Fatal("identity did not round trip")
+	}
+
+	publicKey, err := vaulted.ParsePublicKey(vaulted.FormatPublicKey(identity.PublicKey))
+	if err != nil {
+		t.Fatalf("failed to parse public key: %v", err)
+	}
+	if !bytes.Equal(identity.PublicKey, publicKey) {
+		t.Fatal("public key did not round trip")
+	}
+
+	_, err = vaulted.ParsePublicKey("dG9vIHNob3J0")
+	if err != vaulted.ErrInvalidPublicKey {
+		t.Fatalf("expected %v, got %v", vaulted.ErrInvalidPublicKey, err)
+	}
+}
+
+func generateTestIdentity(t *testing.T) *vaulted.Identity {
+	identity, err := vaulted.GenerateIdentity()
+	if err != nil {
+		t.Fatalf("failed to generate identity: %v", err)
+	}
+	return identity
+}
@@ -0,0 +1,257 @@
+package vaulted
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base32"
+	"errors"
+	"strings"
+
+	"golang.org/x/crypto/curve25519"
+)
+
+const (
+	recoveryShareVersion = 1
+
+	recoveryIDSize       = 4
+	recoveryChecksumSize = 4
+)
+
+var (
+	ErrNoRecovery              = errors.New("Vault has no recovery key (see `vaulted recovery split`)")
+	ErrInvalidRecoveryShares   = errors.New("Recovery keys must be split into 2-255 shares, with a threshold between 2 and the number of shares")
+	ErrInvalidRecoveryShare    = errors.New("Invalid recovery share")
+	ErrRecoveryShareMismatch   = errors.New("Recovery share is for another recovery key")
+	ErrNotEnoughRecoveryShares = errors.New("Not enough recovery shares to open the vault")
+
+	recoveryShareEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+)
+
+// Recovery allows a vault to be opened without its password by combining a
+// threshold of the shares its recovery key was split into (see
+// NewRecoveryKey and Store.OpenVaultWithRecovery).
+//
+// The recovery key is an X25519 key pair. Each time the vault is sealed, its
+// master key is wrapped for the recovery public key (as it would be for a
+// recipient), so the shares keep opening the vault when its password changes.
+type Recovery struct {
+	Shares    int           `json:"shares"`
+	Threshold int           `json:"threshold"`
+	Key       *RecipientKey `json:"key"`
+}
+
+// RecoveryShare is one of the shares a recovery key is split into. Shares
+// are identified by their recovery key's ID, so shares of different recovery
+// keys aren't combined.
+type RecoveryShare struct {
+	ID        []byte
+	Threshold int
+	X         byte
+	Y         []byte
+}
+
+// NewRecoveryKey generates a recovery key for a vault (to be set with
+// SealOptions.Recovery), split into shares (any threshold of which open the
+// vault).
+func NewRecoveryKey(shares, threshold int) (*Recovery, []*RecoveryShare, error) {
+	if shares < 2 || shares > 255 || threshold < 2 || threshold > shares {
+		return nil, nil, ErrInvalidRecoveryShares
+	}
+
+	identity, err := GenerateIdentity()
+	if err != nil {
+		return nil, nil, err
+	}
+	defer zero(identity.PrivateKey)
+
+	split, err := shamirSplit(identity.PrivateKey, shares, threshold)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	id := recoveryID(identity.PublicKey)
+	var recoveryShares []*RecoveryShare
+	for _, share := range split {
+		recoveryShares = append(recoveryShares, &RecoveryShare{
+			ID:        id,
+			Threshold: threshold,
+			X:         share.X,
+			Y:         share.Y,
+		})
+	}
+
+	recovery := &Recovery{
+		Shares:    shares,
+		Threshold: threshold,
+		Key: &RecipientKey{
+			Recipient: Recipient{
+				Name:      "recovery",
+				PublicKey: identity.PublicKey,
+			},
+		},
+	}
+
+	return recovery, recoveryShares, nil
+}
+
+// ID returns the ID of the recovery key (see RecoveryShare).
+func (r *Recovery) ID() []byte {
+	if r.Key == nil {
+		return nil
+	}
+	return recoveryID(r.Key.PublicKey)
+}
+
+// wrap returns a copy of the recovery with masterKey wrapped for its public
+// key.
+func (r *Recovery) wrap(masterKey []byte) (*Recovery, error) {
+	if r.Key == nil {
+		return nil, ErrInvalidKeyConfig
+	}
+
+	recipientKeys, err := wrapRecipientKeys(masterKey, []Recipient{r.Key.Recipient})
+	if err != nil {
+		return nil, err
+	}
+
+	return &Recovery{
+		Shares:    r.Shares,
+		Threshold: r.Threshold,
+		Key:       recipientKeys[0],
+	}, nil
+}
+
+// open combines the shares of the recovery key to unwrap the master key.
+func (r *Recovery) open(shares []*RecoveryShare) ([]byte, error) {
+	if r.Key == nil {
+		return nil, ErrInvalidKeyConfig
+	}
+
+	id := r.ID()
+	seen := make(map[byte]bool)
+	var split []*shamirShare
+	for _, share := range shares {
+		if !bytes.Equal(share.ID, id) {
+			return nil, ErrRecoveryShareMismatch
+		}
+		if seen[share.X] {
+			continue
+		}
+		seen[share.X] = true
+
+		split = append(split, &shamirShare{X: share.X, Y: share.Y})
+	}
+	if len(split) < r.Threshold {
+		return nil, ErrNotEnoughRecoveryShares
+	}
+
+	privateKey, err := shamirCombine(split)
+	if err != nil {
+		return nil, ErrInvalidRecoveryShare
+	}
+	defer zero(privateKey)
+
+	var private, public [32]byte
+	copy(private[:], privateKey)
+	curve25519.ScalarBaseMult(&public, &private)
+	defer zero(private[:])
+	if !bytes.Equal(public[:], r.Key.PublicKey) {
+		return nil, ErrInvalidRecoveryShare
+	}
+
+	return unwrapRecipientKey([]*RecipientKey{r.Key}, &Identity{
+		PrivateKey: privateKey,
+		PublicKey:  public[:],
+	})
+}
+
+func recoveryID(publicKey []byte) []byte {
+	sum := sha256.Sum256(publicKey)
+	return sum[:recoveryIDSize]
+}
+
+// String returns the share's text form: base32 (using only characters that
+// QR codes are able to encode compactly) including a checksum, so mistyped
+// shares are detected.
+func (rs *RecoveryShare) String() string {
+	data := []byte{recoveryShareVersion}
+	data = append(data, rs.ID...)
+	data = append(data, byte(rs.Threshold), rs.X)
+	data = append(data, rs.Y...)
+
+	sum := sha256.Sum256(data)
+	data = append(data, sum[:recoveryChecksumSize]...)
+
+	return recoveryShareEncoding.EncodeToString(data)
+}
+
+// ParseRecoveryShare parses the text form of a share (see
+// RecoveryShare.String). Whitespace and dashes (used to group the text) are
+// ignored, as is case.
+func ParseRecoveryShare(text string) (*RecoveryShare, error) {
+	text = strings.Map(func(r rune) rune {
+		switch r {
+		case ' ', '\t', '\r', '\n', '-':
+			return -1
+		}
+		return r
+	}, strings.ToUpper(text))
+
+	data, err := recoveryShareEncoding.DecodeString(text)
+	headerSize := 1 + recoveryIDSize + 2
+	if err != nil || len(data) <= headerSize+recoveryChecksumSize {
+		return nil, ErrInvalidRecoveryShare
+	}
+
+	checksum := data[len(data)-recoveryChecksumSize:]
+	data = data[:len(data)-recoveryChecksumSize]
+	sum := sha256.Sum256(data)
+	if !bytes.Equal(checksum, sum[:recoveryChecksumSize]) || data[0] != recoveryShareVersion {
+		return nil, ErrInvalidRecoveryShare
+	}
+
+	return &RecoveryShare{
+		ID:        data[1 : 1+recoveryIDSize],
+		Threshold: int(data[1+recoveryIDSize]),
+		X:         data[2+recoveryIDSize],
+		Y:         data[headerSize:],
+	}, nil
+}
+
+// OpenVaultWithRecovery opens a vault using shares of its recovery key
+// instead of its password. ErrNotEnoughRecoveryShares is returned when fewer
+// shares than the recovery key's threshold are given.
+//
+// The vault should be sealed with a new password and without its recovery key
+// once it has been opened (see SealOptions.RemoveRecovery), since its recovery
+// shares have been revealed.
+func (s *store) OpenVaultWithRecovery(name string, shares []*RecoveryShare) (*Vault, error) {
+	vf, err := readVaultFile(s.backend, name)
+	if err != nil {
+		return nil, err
+	}
+
+	if vf.Recovery == nil {
+		return nil, ErrNoRecovery
+	}
+
+	masterKey, err := vf.Recovery.open(shares)
+	if err != nil {
+		s.auditVaultFile(name, vf, AuditOpen, "", err)
+		return nil, err
+	}
+	defer zero(masterKey)
+
+	v, err := openVaultFileWithKey(vf, masterKey)
+	if err != nil {
+		s.auditVaultFile(name, vf, AuditOpen, "", err)
+		return nil, err
+	}
+
+	// keep the master key, so the vault's audit key is kept when it is sealed
+	// with a new password
+	s.rememberKey(name, vf, masterKey)
+	s.auditVaultFile(name, vf, AuditOpen, "", nil)
+
+	return v, nil
+}
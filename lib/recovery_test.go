@@ -0,0 +1,220 @@
+package vaulted_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/miquella/vaulted/lib"
+)
+
+func TestOpenVaultWithRecovery(t *testing.T) {
+	store := vaulted.New(vaulted.NewStaticSteward("password"), vaulted.NewMemoryBackend())
+
+	vault := &vaulted.Vault{Vars: map[string]string{"TEST": "RECOVERED"}}
+	err := store.SealVaultWithPassword(vault, "one", "password")
+	if err != nil {
+		t.Fatalf("failed to seal vault: %v", err)
+	}
+
+	_, err = store.OpenVaultWithRecovery("one", nil)
+	if err != vaulted.ErrNoRecovery {
+		t.Fatalf("expected %v, got %v", vaulted.ErrNoRecovery, err)
+	}
+
+	recovery, shares, err := vaulted.NewRecoveryKey(5, 3)
+	if err != nil {
+		t.Fatalf("failed to create recovery key: %v", err)
+	}
+	err = store.SealVaultWithOptions(vault, "one", "password", vaulted.SealOptions{Recovery: recovery})
+	if err != nil {
+		t.Fatalf("failed to seal vault: %v", err)
+	}
+
+	// the shares keep working when the password changes
+	err = store.SealVaultWithOptions(vault, "one", "new password", vaulted.SealOptions{KeyMethod: "pbkdf2-sha512"})
+	if err != nil {
+		t.Fatalf("failed to seal vault: %v", err)
+	}
+
+	recovered, err := store.OpenVaultWithRecovery("one", []*vaulted.RecoveryShare{shares[4], shares[1], shares[2]})
+	if err != nil {
+		t.Fatalf("failed to open vault with recovery shares: %v", err)
+	}
+	if recovered.Vars["TEST"] != "RECOVERED" {
+		t.Fatalf("expected: RECOVERED, got: %s", recovered.Vars["TEST"])
+	}
+
+	_, err = store.OpenVaultWithRecovery("one", []*vaulted.RecoveryShare{shares[0], shares[1], shares[1]})
+	if err != vaulted.ErrNotEnoughRecoveryShares {
+		t.Fatalf("expected %v, got %v", vaulted.ErrNotEnoughRecoveryShares, err)
+	}
+
+	_, otherShares, err := vaulted.NewRecoveryKey(3, 2)
+	if err != nil {
+		t.Fatalf("failed to create recovery key: %v", err)
+	}
+	_, err = store.OpenVaultWithRecovery("one", []*vaulted.RecoveryShare{shares[0], otherShares[1], shares[2]})
+	if err != vaulted.ErrRecoveryShareMismatch {
+		t.Fatalf("expected %v, got %v", vaulted.ErrRecoveryShareMismatch, err)
+	}
+}
+
+func TestOpenVaultWithRecoveryKeySlots(t *testing.T) {
+	store := vaulted.New(vaulted.NewStaticSteward("password"), vaulted.NewMemoryBackend())
+
+	recovery, shares, err := vaulted.NewRecoveryKey(3, 2)
+	if err != nil {
+		t.Fatalf("failed to create recovery key: %v", err)
+	}
+	err = store.SealVaultWithOptions(&vaulted.Vault{}, "one", "password", vaulted.SealOptions{Recovery: recovery})
+	if err != nil {
+		t.Fatalf("failed to seal vault: %v", err)
+	}
+
+	_, err = store.AddKeySlot("one", "password", "another password", "")
+	if err != nil {
+		t.Fatalf("failed to add key slot: %v", err)
+	}
+
+	_, err = store.OpenVaultWithRecovery("one", shares[1:])
+	if err != nil {
+		t.Fatalf("failed to open vault with recovery shares: %v", err)
+	}
+}
+
+func TestSealVaultRemoveRecovery(t *testing.T) {
+	store := vaulted.New(vaulted.NewStaticSteward("password"), vaulted.NewMemoryBackend())
+
+	recovery, shares, err := vaulted.NewRecoveryKey(3, 2)
+	if err != nil {
+		t.Fatalf("failed to create recovery key: %v", err)
+	}
+	err = store.SealVaultWithOptions(&vaulted.Vault{}, "one", "password", vaulted.SealOptions{Recovery: recovery})
+	if err != nil {
+		t.Fatalf("failed to seal vault: %v", err)
+	}
+
+	vault, err := store.OpenVaultWithRecovery("one", shares[:2])
+	if err != nil {
+		t.Fatalf("failed to open vault with recovery shares: %v", err)
+	}
+	err = store.SealVaultWithOptions(vault, "one", "new password", vaulted.SealOptions{RemoveRecovery: true})
+	if err != nil {
+		t.Fatalf("failed to seal vault: %v", err)
+	}
+
+	_, err = store.OpenVaultWithRecovery("one", shares[:2])
+	if err != vaulted.ErrNoRecovery {
+		t.Fatalf("expected %v, got %v", vaulted.ErrNoRecovery, err)
+	}
+}
+
+func TestSealVaultRefusesPlantedRecovery(t *testing.T) {
+	setupVaults(t)
+	defer teardownVaults(t)
+
+	for _, method := range []string{vaulted.DefaultEncryptionMethod, "secretbox"} {
+		store := testStore()
+
+		vault := &vaulted.Vault{Vars: map[string]string{"TEST": "SECRET"}}
+		err := store.SealVaultWithOptions(vault, method, "password", vaulted.SealOptions{Method: method})
+		if err != nil {
+			t.Fatalf("failed to seal vault: %v", err)
+		}
+		recovery, shares, err := vaulted.NewRecoveryKey(3, 2)
+		if err != nil {
+			t.Fatalf("failed to create recovery key: %v", err)
+		}
+		err = store.SealVaultWithOptions(&vaulted.Vault{}, method+"-planted", "password", vaulted.SealOptions{Recovery: recovery})
+		if err != nil {
+			t.Fatalf("failed to seal vault: %v", err)
+		}
+
+		// the recovery key of another vault is planted in the vault file
+		vf := readTestVaultFile(t, method)
+		vf.Recovery = readTestVaultFile(t, method+"-planted").Recovery
+		writeTestVaultFile(t, method, vf)
+
+		for _, store := range []vaulted.Store{store, testStore()} {
+			// secretbox doesn't detect the planted recovery key
+			_, _, err = store.OpenVault(method)
+			if method == "secretbox" && err != nil {
+				t.Fatalf("failed to open %s vault: %v", method, err)
+			}
+			err = store.SealVaultWithPassword(vault, method, "password")
+			if err != vaulted.ErrVaultNotAuthenticated {
+				t.Fatalf("expected %v for %s vault, got %v", vaulted.ErrVaultNotAuthenticated, method, err)
+			}
+		}
+
+		_, err = store.OpenVaultWithRecovery(method, shares[:2])
+		if err == nil {
+			t.Fatalf("expected the planted recovery key not to open the %s vault", method)
+		}
+	}
+}
+
+func TestSealVaultRecoveryAuthenticated(t *testing.T) {
+	backend := vaulted.NewMemoryBackend()
+	store := vaulted.New(vaulted.NewStaticSteward("password"), backend)
+
+	err := store.SealVaultWithOptions(&vaulted.Vault{}, "one", "password", vaulted.SealOptions{Method: "secretbox"})
+	if err != nil {
+		t.Fatalf("failed to seal vault: %v", err)
+	}
+	recovery, _, err := vaulted.NewRecoveryKey(3, 2)
+	if err != nil {
+		t.Fatalf("failed to create recovery key: %v", err)
+	}
+	err = store.SealVaultWithOptions(&vaulted.Vault{}, "one", "password", vaulted.SealOptions{Recovery: recovery})
+	if err != nil {
+		t.Fatalf("failed to seal vault: %v", err)
+	}
+
+	// secretbox doesn't authenticate the recovery key
+	vf := readVaultFileFromBackend(t, backend, "one")
+	if vf.Method != vaulted.DefaultEncryptionMethod {
+		t.Fatalf("expected the vault to be sealed with %s, got %s", vaulted.DefaultEncryptionMethod, vf.Method)
+	}
+}
+
+func TestRecoveryShareText(t *testing.T) {
+	_, shares, err := vaulted.NewRecoveryKey(2, 2)
+	if err != nil {
+		t.Fatalf("failed to create recovery key: %v", err)
+	}
+
+	text := shares[0].String()
+	if strings.ToUpper(text) != text || strings.ContainsAny(text, "=") {
+		t.Fatalf("expected QR friendly base32, got %q", text)
+	}
+
+	// grouped and lowercased text is accepted
+	var grouped []string
+	for i := 0; i < len(text); i += 5 {
+		end := i + 5
+		if end > len(text) {
+			end = len(text)
+		}
+		grouped = append(grouped, text[i:end])
+	}
+	parsed, err := vaulted.ParseRecoveryShare(strings.ToLower(strings.Join(grouped, "-")))
+	if err != nil {
+		t.Fatalf("failed to parse share: %v", err)
+	}
+	if parsed.String() != text {
+		t.Fatalf("expected %q, got %q", text, parsed.String())
+	}
+
+	// mistyped shares are detected
+	mistyped := []byte(text)
+	if mistyped[10] == 'A' {
+		mistyped[10] = 'B'
+	} else {
+		mistyped[10] = 'A'
+	}
+	_, err = vaulted.ParseRecoveryShare(string(mistyped))
+	if err != vaulted.ErrInvalidRecoveryShare {
+		t.Fatalf("expected %v, got %v", vaulted.ErrInvalidRecoveryShare, err)
+	}
+}
@@ -0,0 +1,95 @@
+package vaulted
+
+import (
+	"sync"
+)
+
+// SecureBuffer holds a secret (such as a vault's master key) for as long as
+// it is needed. Its memory is locked, so it is never written to swap (when the
+// platform and the process's limits allow it), and it is wiped when the
+// buffer is destroyed.
+//
+// Short lived copies of secrets (derived keys, decrypted content and the
+// bytes of passwords) are wiped as soon as they have been used instead. Note
+// that passwords are handed around as strings, which can't be wiped, so the
+// password itself may remain in memory until it is garbage collected.
+type SecureBuffer struct {
+	mu     sync.Mutex
+	data   []byte
+	mapped bool
+}
+
+// NewSecureBuffer returns a SecureBuffer holding a copy of secret.
+func NewSecureBuffer(secret []byte) *SecureBuffer {
+	b := &SecureBuffer{}
+	if len(secret) == 0 {
+		return b
+	}
+
+	b.data, b.mapped = allocLocked(len(secret))
+	copy(b.data, secret)
+	return b
+}
+
+// Bytes returns the secret held by the buffer (nil once the buffer has been
+// destroyed). The returned slice must not be used after calling Destroy.
+func (b *SecureBuffer) Bytes() []byte {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.data
+}
+
+// Copy returns a copy of the secret held by the buffer, which the caller is
+// responsible for wiping.
+func (b *SecureBuffer) Copy() []byte {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.data == nil {
+		return nil
+	}
+	return append([]byte{}, b.data...)
+}
+
+// Destroy wipes the secret and releases the buffer's memory. Destroying a
+// buffer more than once has no effect.
+func (b *SecureBuffer) Destroy() {
+	if b == nil {
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.data == nil {
+		return
+	}
+
+	zero(b.data)
+	freeLocked(b.data, b.mapped)
+	b.data = nil
+}
+
+// DisableCoreDumps prevents the process from writing core dumps (which would
+// contain any secrets held in memory) by setting its soft core file size limit
+// to zero. On Linux, the process is also marked as not dumpable, which
+// prevents other processes of the same user from attaching to it or reading
+// its memory.
+//
+// The soft limit is inherited by commands the process runs, but the hard limit
+// is kept, so they are able to raise it again.
+func DisableCoreDumps() error {
+	return disableCoreDumps()
+}
+
+// Wipe zeroes a copy of a secret once it is no longer needed.
+func Wipe(b []byte) {
+	zero(b)
+}
+
+func zero(b []byte) {
+	for i := range b {
+		b[i] = 0
+	}
+}
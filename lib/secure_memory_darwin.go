@@ -0,0 +1,7 @@
+package vaulted
+
+// setNotDumpable has no equivalent here, core dumps are disabled by the soft
+// core file size limit alone.
+func setNotDumpable() error {
+	return nil
+}
@@ -0,0 +1,17 @@
+package vaulted
+
+import (
+	"syscall"
+)
+
+// setNotDumpable clears the process's dumpable flag, which also prevents
+// processes of the same user from attaching to it with ptrace (or reading
+// /proc/<pid>/mem). The flag is reset when a command is executed.
+func setNotDumpable() error {
+	_, _, errno := syscall.RawSyscall(syscall.SYS_PRCTL, syscall.PR_SET_DUMPABLE, 0, 0)
+	if errno != 0 {
+		return errno
+	}
+
+	return nil
+}
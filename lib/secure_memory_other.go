@@ -0,0 +1,16 @@
+//go:build !darwin && !linux
+
+package vaulted
+
+// allocLocked returns memory from the Go heap, which can't be locked here.
+// Secrets are still wiped when they are released.
+func allocLocked(size int) ([]byte, bool) {
+	return make([]byte, size), false
+}
+
+func freeLocked(data []byte, mapped bool) {
+}
+
+func disableCoreDumps() error {
+	return nil
+}
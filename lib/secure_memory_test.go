@@ -0,0 +1,62 @@
+package vaulted_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/miquella/vaulted/lib"
+)
+
+func TestSecureBuffer(t *testing.T) {
+	secret := []byte("a secret key")
+	buffer := vaulted.NewSecureBuffer(secret)
+
+	if !bytes.Equal(buffer.Bytes(), secret) {
+		t.Fatalf("expected %q, got %q", secret, buffer.Bytes())
+	}
+
+	// the buffer holds a copy
+	secret[0] = 'A'
+	if buffer.Bytes()[0] != 'a' {
+		t.Fatal("the buffer should hold a copy of the secret")
+	}
+
+	copied := buffer.Copy()
+	copied[0] = 'A'
+	if buffer.Bytes()[0] != 'a' {
+		t.Fatal("Copy should return a copy of the secret")
+	}
+
+	buffer.Destroy()
+	if buffer.Bytes() != nil || buffer.Copy() != nil {
+		t.Fatal("a destroyed buffer should not hold a secret")
+	}
+
+	// destroying a buffer again (or a nil buffer) has no effect
+	buffer.Destroy()
+
+	var nilBuffer *vaulted.SecureBuffer
+	nilBuffer.Destroy()
+
+	empty := vaulted.NewSecureBuffer(nil)
+	if len(empty.Bytes()) != 0 {
+		t.Fatal("expected an empty buffer")
+	}
+	empty.Destroy()
+}
+
+func TestWipe(t *testing.T) {
+	secret := []byte("a secret key")
+	vaulted.Wipe(secret)
+
+	if !bytes.Equal(secret, make([]byte, len(secret))) {
+		t.Fatalf("expected the secret to be wiped, got %q", secret)
+	}
+}
+
+func TestDisableCoreDumps(t *testing.T) {
+	err := vaulted.DisableCoreDumps()
+	if err != nil {
+		t.Fatalf("failed to disable core dumps: %v", err)
+	}
+}
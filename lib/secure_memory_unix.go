@@ -0,0 +1,49 @@
+//go:build darwin || linux
+
+package vaulted
+
+import (
+	"syscall"
+)
+
+// allocLocked returns size bytes of memory, mapped separately from the Go heap
+// (so the garbage collector never copies it) and locked when possible. Memory
+// from the Go heap is returned when it can't be mapped.
+func allocLocked(size int) ([]byte, bool) {
+	data, err := syscall.Mmap(-1, 0, size, syscall.PROT_READ|syscall.PROT_WRITE, syscall.MAP_ANON|syscall.MAP_PRIVATE)
+	if err != nil {
+		return make([]byte, size), false
+	}
+
+	// locking fails when RLIMIT_MEMLOCK is exhausted, the memory is still
+	// wiped when it is released
+	syscall.Mlock(data)
+	return data, true
+}
+
+func freeLocked(data []byte, mapped bool) {
+	if !mapped {
+		return
+	}
+
+	syscall.Munlock(data)
+	syscall.Munmap(data)
+}
+
+func disableCoreDumps() error {
+	// only the soft limit is lowered, so the commands vaulted runs are able
+	// to raise it again
+	var limit syscall.Rlimit
+	err := syscall.Getrlimit(syscall.RLIMIT_CORE, &limit)
+	if err != nil {
+		return err
+	}
+
+	limit.Cur = 0
+	err = syscall.Setrlimit(syscall.RLIMIT_CORE, &limit)
+	if err != nil {
+		return err
+	}
+
+	return setNotDumpable()
+}
@@ -0,0 +1,35 @@
+//go:build darwin || linux
+
+package vaulted_test
+
+import (
+	"syscall"
+	"testing"
+
+	"github.com/miquella/vaulted/lib"
+)
+
+func TestDisableCoreDumpsKeepsHardLimit(t *testing.T) {
+	var before syscall.Rlimit
+	err := syscall.Getrlimit(syscall.RLIMIT_CORE, &before)
+	if err != nil {
+		t.Fatalf("failed to get core file size limit: %v", err)
+	}
+
+	err = vaulted.DisableCoreDumps()
+	if err != nil {
+		t.Fatalf("failed to disable core dumps: %v", err)
+	}
+
+	var after syscall.Rlimit
+	err = syscall.Getrlimit(syscall.RLIMIT_CORE, &after)
+	if err != nil {
+		t.Fatalf("failed to get core file size limit: %v", err)
+	}
+	if after.Cur != 0 {
+		t.Fatalf("expected the soft limit to be 0, got %d", after.Cur)
+	}
+	if after.Max != before.Max {
+		t.Fatalf("expected the hard limit to stay %d, got %d", before.Max, after.Max)
+	}
+}
@@ -12,7 +12,7 @@ var (
 	//
 	// Any cache loaded that does not match this version is ignored. This
 	// causes all caches written for previous versions to be invalidated.
-	SessionCacheVersion = "3"
+	SessionCacheVersion = "4"
 )
 
 var (
@@ -66,6 +66,8 @@ func (sc *SessionCache) RemoveExpiredSessions() {
 // VaultSessionCacheKey computes a stable key based on the contents of a vault.
 //
 // The computed key is intended to be used for things such as a session cache.
+// Vaults with includes should be resolved first (see Store.ResolveIncludes),
+// so the key changes when the content of an included vault does.
 func VaultSessionCacheKey(vault *Vault) string {
 	// gather all of the key attributes
 	keyAttributes := map[string]string{}
@@ -2,10 +2,6 @@ package vaulted
 
 import (
 	"encoding/json"
-	"os"
-	"path/filepath"
-
-	"github.com/miquella/xdg"
 )
 
 type SessionFile struct {
@@ -14,21 +10,23 @@ type SessionFile struct {
 	Ciphertext []byte  `json:"ciphertext"`
 }
 
-func readSessionFile(name string) (*SessionFile, error) {
-	existing := xdg.CACHE_HOME.Find(filepath.Join("vaulted", name))
-	if existing == "" {
-		return nil, os.ErrNotExist
-	}
+// associatedData returns the unencrypted header fields of the session file.
+func (sf *SessionFile) associatedData() ([]byte, error) {
+	return json.Marshal(struct {
+		Method string `json:"method"`
+	}{
+		Method: sf.Method,
+	})
+}
 
-	f, err := os.Open(existing)
+func readSessionFile(backend Backend, name string) (*SessionFile, error) {
+	data, err := backend.Get(SessionCacheBlob, name)
 	if err != nil {
 		return nil, err
 	}
-	defer f.Close()
 
-	d := json.NewDecoder(f)
 	ef := SessionFile{}
-	err = d.Decode(&ef)
+	err = json.Unmarshal(data, &ef)
 	if err != nil {
 		return nil, err
 	}
@@ -36,34 +34,15 @@ func readSessionFile(name string) (*SessionFile, error) {
 	return &ef, nil
 }
 
-func writeSessionFile(name string, sessionFile *SessionFile) error {
-	pathname := xdg.CACHE_HOME.Join("vaulted")
-	err := os.MkdirAll(pathname, 0700)
-	if err != nil {
-		return err
-	}
-
-	filename := xdg.CACHE_HOME.Join(filepath.Join("vaulted", name))
-	f, err := os.OpenFile(filename, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+func writeSessionFile(backend Backend, name string, sessionFile *SessionFile) error {
+	data, err := json.Marshal(sessionFile)
 	if err != nil {
 		return err
 	}
-	defer f.Close()
 
-	e := json.NewEncoder(f)
-	err = e.Encode(sessionFile)
-	if err != nil {
-		return err
-	}
-
-	return nil
+	return backend.Put(SessionCacheBlob, name, data)
 }
 
-func removeSessionCache(name string) error {
-	existing := xdg.CACHE_HOME.Find(filepath.Join("vaulted", name))
-	if existing == "" {
-		return os.ErrNotExist
-	}
-
-	return os.Remove(existing)
+func removeSessionCache(backend Backend, name string) error {
+	return backend.Delete(SessionCacheBlob, name)
 }
@@ -0,0 +1,131 @@
+package vaulted
+
+import (
+	"crypto/rand"
+	"errors"
+)
+
+var (
+	errShamirParameters = errors.New("Invalid secret sharing parameters")
+	errShamirShares     = errors.New("Invalid secret shares")
+)
+
+// shamirShare is a share of a secret split by shamirSplit. Each byte of the
+// secret is shared separately, so Y is as long as the secret.
+type shamirShare struct {
+	X byte
+	Y []byte
+}
+
+// gfExp and gfLog are the exponent and logarithm tables of GF(2^8) (using
+// the AES polynomial x^8 + x^4 + x^3 + x + 1, with 3 as the generator).
+var (
+	gfExp [510]byte
+	gfLog [256]byte
+)
+
+func init() {
+	x := byte(1)
+	for i := 0; i < 255; i++ {
+		gfExp[i] = x
+		gfExp[i+255] = x
+		gfLog[x] = byte(i)
+
+		// multiply by the generator (x + 1)
+		doubled := x << 1
+		if x&0x80 != 0 {
+			doubled ^= 0x1b
+		}
+		x ^= doubled
+	}
+}
+
+func gfMul(a, b byte) byte {
+	if a == 0 || b == 0 {
+		return 0
+	}
+	return gfExp[int(gfLog[a])+int(gfLog[b])]
+}
+
+func gfDiv(a, b byte) byte {
+	if a == 0 {
+		return 0
+	}
+	return gfExp[int(gfLog[a])+255-int(gfLog[b])]
+}
+
+// shamirSplit splits secret into n shares, any threshold of which recover the
+// secret (while fewer reveal nothing about it).
+func shamirSplit(secret []byte, n, threshold int) ([]*shamirShare, error) {
+	if threshold < 2 || threshold > n || n > 255 {
+		return nil, errShamirParameters
+	}
+
+	shares := make([]*shamirShare, n)
+	for i := range shares {
+		shares[i] = &shamirShare{
+			X: byte(i + 1),
+			Y: make([]byte, len(secret)),
+		}
+	}
+
+	coefficients := make([]byte, threshold)
+	for b, secretByte := range secret {
+		// a random polynomial of degree threshold-1 whose constant term is
+		// the secret byte
+		_, err := rand.Read(coefficients[1:])
+		if err != nil {
+			return nil, err
+		}
+		coefficients[0] = secretByte
+
+		for _, share := range shares {
+			// evaluate the polynomial at X (using Horner's method)
+			var y byte
+			for c := threshold - 1; c >= 0; c-- {
+				y = gfMul(y, share.X) ^ coefficients[c]
+			}
+			share.Y[b] = y
+		}
+	}
+
+	for c := range coefficients {
+		coefficients[c] = 0
+	}
+
+	return shares, nil
+}
+
+// shamirCombine recovers a secret from its shares (there must be at least as
+// many as the threshold the secret was split with, or the result is garbage).
+func shamirCombine(shares []*shamirShare) ([]byte, error) {
+	if len(shares) == 0 {
+		return nil, errShamirShares
+	}
+
+	size := len(shares[0].Y)
+	seen := make(map[byte]bool)
+	for _, share := range shares {
+		if share.X == 0 || seen[share.X] || len(share.Y) != size {
+			return nil, errShamirShares
+		}
+		seen[share.X] = true
+	}
+
+	// interpolate the polynomial at 0 (using Lagrange basis polynomials)
+	secret := make([]byte, size)
+	for i, share := range shares {
+		basis := byte(1)
+		for j, other := range shares {
+			if i != j {
+				basis = gfMul(basis, gfDiv(other.X, other.X^share.X))
+			}
+		}
+
+		for b := range secret {
+			secret[b] ^= gfMul(share.Y[b], basis)
+		}
+	}
+
+	return secret, nil
+}
@@ -0,0 +1,70 @@
+package vaulted
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestShamir(t *testing.T) {
+	secret := []byte("a secret that is split into shares")
+
+	shares, err := shamirSplit(secret, 5, 3)
+	if err != nil {
+		t.Fatalf("failed to split secret: %v", err)
+	}
+	if len(shares) != 5 {
+		t.Fatalf("expected 5 shares, got %d", len(shares))
+	}
+
+	// any 3 shares recover the secret
+	for _, combination := range [][]int{{0, 1, 2}, {4, 2, 0}, {1, 3, 4}, {0, 1, 2, 3, 4}} {
+		var subset []*shamirShare
+		for _, i := range combination {
+			subset = append(subset, shares[i])
+		}
+
+		combined, err := shamirCombine(subset)
+		if err != nil {
+			t.Fatalf("failed to combine shares %v: %v", combination, err)
+		}
+		if !bytes.Equal(secret, combined) {
+			t.Fatalf("shares %v: expected %q, got %q", combination, secret, combined)
+		}
+	}
+
+	// fewer don't
+	combined, err := shamirCombine(shares[:2])
+	if err != nil {
+		t.Fatalf("failed to combine shares: %v", err)
+	}
+	if bytes.Equal(secret, combined) {
+		t.Fatal("expected 2 shares not to recover the secret")
+	}
+
+	_, err = shamirCombine([]*shamirShare{shares[0], shares[0]})
+	if err != errShamirShares {
+		t.Fatalf("expected %v, got %v", errShamirShares, err)
+	}
+
+	for _, parameters := range [][2]int{{5, 1}, {3, 4}, {256, 3}} {
+		_, err = shamirSplit(secret, parameters[0], parameters[1])
+		if err != errShamirParameters {
+			t.Fatalf("%v: expected %v, got %v", parameters, errShamirParameters, err)
+		}
+	}
+}
+
+func TestGF256(t *testing.T) {
+	for a := 1; a < 256; a++ {
+		for b := 1; b < 256; b++ {
+			if gfDiv(gfMul(byte(a), byte(b)), byte(b)) != byte(a) {
+				t.Fatalf("expected (%d * %d) / %d = %d", a, b, b, a)
+			}
+		}
+	}
+
+	// 0x53 and 0xca are inverses in the AES field
+	if gfMul(0x53, 0xca) != 1 {
+		t.Fatalf("expected 0x53 * 0xca = 1, got %#x", gfMul(0x53, 0xca))
+	}
+}
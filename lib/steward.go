@@ -20,9 +20,31 @@ type StewardMaxTries interface {
 	GetMaxOpenTries() int
 }
 
+// StewardIdentity is implemented by stewards that provide an identity to open
+// vaults sealed for recipients.
+type StewardIdentity interface {
+	GetIdentity(name string) (*Identity, error)
+}
+
+// StewardKeyCache is implemented by stewards able to hold the keys derived
+// from vault passwords (e.g. the vaulted agent). A cached key allows a vault to
+// be unlocked without requesting its password or deriving its key again.
+//
+// Keys are identified by the vault's name and a fingerprint of its key
+// configuration, so a cached key is only used while the vault remains sealed
+// with that key. GetKey returns ErrKeyNotCached when no key is held.
+//
+// The store wipes keys once it has used them, so PutKey must keep a copy of
+// the key, and GetKey must return a copy of the key it holds.
+type StewardKeyCache interface {
+	GetKey(name string, fingerprint []byte) ([]byte, error)
+	PutKey(name string, fingerprint, key []byte) error
+}
+
 type StaticSteward struct {
 	Password string
 	MFAToken *string
+	Identity *Identity
 }
 
 func NewStaticSteward(password string) *StaticSteward {
@@ -42,6 +64,14 @@ func (s *StaticSteward) GetPassword(operation Operation, name string) (string, e
 	return s.Password, nil
 }
 
+func (s *StaticSteward) GetIdentity(name string) (*Identity, error) {
+	if s.Identity == nil {
+		return nil, ErrNoIdentity
+	}
+
+	return s.Identity, nil
+}
+
 func (s *StaticSteward) GetMFAToken(name string) (string, error) {
 	if s.MFAToken == nil {
 		return "", errors.New("No MFA token available")
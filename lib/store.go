@@ -1,22 +1,23 @@
 package vaulted
 
 import (
+	"bytes"
 	"crypto/rand"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"os"
-	"path/filepath"
+	"sync"
 	"time"
-
-	"github.com/miquella/xdg"
-	"golang.org/x/crypto/nacl/secretbox"
 )
 
 var (
 	ErrIncorrectPassword       = errors.New("Incorrect password")
 	ErrInvalidKeyConfig        = errors.New("Invalid key configuration")
 	ErrInvalidEncryptionConfig = errors.New("Invalid encryption configuration")
+	ErrVaultModified           = errors.New("Vault was modified since it was opened")
+	ErrVaultNotAuthenticated   = errors.New("Vault's recipients and recovery key could not be authenticated (open the vault before sealing it, or replace them if its cipher is secretbox)")
+	ErrRevisionNotExist        = errors.New("Vault revision does not exist")
 )
 
 type Store interface {
@@ -25,23 +26,117 @@ type Store interface {
 	ListVaults() ([]string, error)
 
 	VaultExists(name string) bool
+	VaultSource(name string) (VaultSource, error)
+	VaultRevision(name string) (int, error)
 	OpenVault(name string) (*Vault, string, error)
 	OpenVaultWithPassword(name, password string) (*Vault, string, error)
+	UnlockVault(name string) (*Vault, string, error)
+	ResolveIncludes(vault *Vault, name string) (*Vault, error)
 	SealVault(vault *Vault, name string) error
 	SealVaultWithPassword(vault *Vault, name, password string) error
+	SealVaultWithOptions(vault *Vault, name, password string, options SealOptions) error
+	SealVaultIfUnmodified(vault *Vault, name, password string, revision int, options SealOptions) error
 	RemoveVault(name string) error
+	UpgradeVault(name string) (int, error)
+
+	VaultMetadata(name string) (*VaultMetadata, error)
+	SetVaultMetadata(name string, metadata *VaultMetadata) error
+
+	VaultRecipients(name string) ([]Recipient, error)
+
+	OpenVaultWithRecovery(name string, shares []*RecoveryShare) (*Vault, error)
+
+	KeySlots(name string) ([]*KeySlot, error)
+	AddKeySlot(name, password, newPassword, keyMethod string) (int, error)
+	RemoveKeySlot(name, password string, id int) error
+
+	VerifyVault(name string) (*VaultReport, error)
+	VerifyVaultWithPassword(name, password string) (*VaultReport, error)
+
+	ExportVaults(names []string, passphrase string) ([]byte, error)
+	ImportVault(vault *BundledVault, name string) error
+
+	VaultHistory(name string) ([]*HistoryEntry, error)
+	OpenVaultRevision(name string, revision int, password string) (*Vault, error)
 
 	CreateSession(vault *Vault, name, password string) (*Session, error)
 	GetSession(vault *Vault, name, password string) (*Session, error)
+	AssumeRole(session *Session, name, roleArn string) (*Session, error)
+
+	VaultAuditLog(name string) (*AuditLog, error)
+}
+
+// SealOptions controls how a vault is sealed.
+//
+// The zero value keeps the existing vault's key derivation and encryption
+// methods (or uses the defaults for a new vault).
+type SealOptions struct {
+	// KeyMethod replaces the key derivation method of the vault (e.g.
+	// "argon2id" or "pbkdf2-sha512").
+	KeyMethod string
+
+	// Method replaces the encryption method of the vault (e.g. "secretbox",
+	// "xchacha20poly1305" or "aes-256-gcm").
+	Method string
+
+	// Recipients seals the vault for these recipients instead of a password.
+	// Switching the key derivation method (KeyMethod) seals the vault with a
+	// password again.
+	Recipients []Recipient
+
+	// Recovery replaces the recovery key of the vault (see NewRecoveryKey).
+	Recovery *Recovery
+
+	// Metadata replaces the description, tags and team of the vault (the
+	// timestamps are maintained by the store). Changes made without opening
+	// the vault (see SetVaultMetadata) are discarded, unless they are passed
+	// as Metadata.
+	Metadata *VaultMetadata
+
+	// Recalibrate raises the cost of the vault's key derivation method to
+	// take KeyDerivationTarget on this machine (the cost is never lowered).
+	// Otherwise, the cost is only calibrated when the vault is created or its
+	// key derivation method is switched. Vaults sealed for recipients or using
+	// key slots are not affected.
+	Recalibrate bool
+
+	// RemoveRecovery removes the recovery key of the vault (e.g. once its
+	// shares have been revealed to recover the vault).
+	RemoveRecovery bool
+
+	// Replace seals the vault as a new vault, replacing any existing vault
+	// (e.g. when another vault is copied over it) instead of keeping its key
+	// derivation and encryption methods, key slots, recipients and recovery
+	// key. The existing vault is kept in the history.
+	Replace bool
+
+	// Fork allows a system vault to be sealed, saving a copy to the vault
+	// directory (which shadows the system vault). Otherwise, sealing a
+	// system vault fails with ErrReadOnlyVault.
+	Fork bool
+
+	// Operation is recorded in the vault's history to describe what created
+	// the revision (e.g. "edit", "load", "passwd", "cp" or "rollback").
+	Operation string
 }
 
 type store struct {
 	steward Steward
+	backend Backend
+
+	// keys holds the master keys of the vaults opened by the store, so they
+	// are only derived once (see cachedKey)
+	keys     map[string]*openedKey
+	keysLock sync.Mutex
 }
 
-func New(steward Steward) Store {
+// New creates a store that keeps its vaults and session caches in backend
+// (e.g. NewXDGBackend(), NewFileBackend(root) or NewMemoryBackend()).
+func New(steward Steward, backend Backend) Store {
 	return &store{
 		steward: steward,
+		backend: backend,
+		keys:    make(map[string]*openedKey),
 	}
 }
 
@@ -50,34 +145,25 @@ func (s *store) Steward() Steward {
 }
 
 func (s *store) ListVaults() ([]string, error) {
-	vaults, err := xdg.DATA.Glob(filepath.Join("vaulted", "*"))
-	if err != nil {
-		return nil, err
-	}
+	return s.backend.List(VaultBlob)
+}
 
-	var found []string
-	emitted := map[string]bool{}
-	for _, vault := range vaults {
-		info, err := os.Stat(vault)
-		if err != nil {
-			return nil, err
-		}
-		if !info.Mode().IsRegular() {
-			continue
-		}
+func (s *store) VaultExists(name string) bool {
+	_, err := s.backend.Get(VaultBlob, name)
+	return err == nil
+}
 
-		if !emitted[info.Name()] {
-			emitted[info.Name()] = true
-			found = append(found, info.Name())
-		}
+// VaultRevision returns the current revision of a vault.
+//
+// The revision should be retrieved before the vault is opened and passed to
+// SealVaultIfUnmodified when the vault is sealed again.
+func (s *store) VaultRevision(name string) (int, error) {
+	vf, err := readVaultFile(s.backend, name)
+	if err != nil {
+		return 0, err
 	}
 
-	return found, nil
-}
-
-func (s *store) VaultExists(name string) bool {
-	existing := xdg.DATA.Find(filepath.Join("vaulted", name))
-	return len(existing) != 0
+	return vf.Revision, nil
 }
 
 func (s *store) OpenVault(name string) (*Vault, string, error) {
@@ -85,6 +171,12 @@ func (s *store) OpenVault(name string) (*Vault, string, error) {
 		return nil, "", os.ErrNotExist
 	}
 
+	// vaults sealed for recipients are opened with an identity instead
+	vf, err := readVaultFile(s.backend, name)
+	if err == nil && vf.Key != nil && vf.Key.Method == RecipientKeyMethod {
+		return s.OpenVaultWithPassword(name, "")
+	}
+
 	maxTries := 1
 	if getMax, ok := s.steward.(StewardMaxTries); ok {
 		maxTries = getMax.GetMaxOpenTries()
@@ -108,48 +200,114 @@ func (s *store) OpenVaultWithPassword(name, password string) (*Vault, string, er
 		return nil, "", os.ErrNotExist
 	}
 
-	vf, err := readVaultFile(name)
+	vf, err := readVaultFile(s.backend, name)
 	if err != nil {
 		return nil, "", err
 	}
 
-	v := Vault{}
+	key, err := s.vaultKey(name, vf, password)
+	if err != nil {
+		s.auditVaultFile(name, vf, AuditOpen, "", err)
+		return nil, "", err
+	}
+	defer zero(key)
 
-	switch vf.Method {
-	case "secretbox":
-		if vf.Key == nil {
-			return nil, "", ErrInvalidKeyConfig
-		}
+	v, err := openVaultFileWithKey(vf, key)
+	if err != nil {
+		s.auditVaultFile(name, vf, AuditOpen, "", err)
+		return nil, "", err
+	}
 
-		nonce := vf.Details.Bytes("nonce")
-		if len(nonce) == 0 {
-			return nil, "", ErrInvalidEncryptionConfig
-		}
-		boxNonce := [24]byte{}
-		copy(boxNonce[:], nonce)
+	s.cacheKey(name, vf, key)
+	s.auditVaultFile(name, vf, AuditOpen, "", nil)
 
-		boxKey := [32]byte{}
-		derivedKey, err := vf.Key.key(password, len(boxKey))
-		if err != nil {
-			return nil, "", err
-		}
-		copy(boxKey[:], derivedKey[:])
+	// vaults in an older format are upgraded now that their key is known
+	// (errors are ignored, the vault is upgraded when it is opened again)
+	s.upgradeVaultFile(name, vf, key)
 
-		plaintext, ok := secretbox.Open(nil, vf.Ciphertext, &boxNonce, &boxKey)
-		if !ok {
-			return nil, "", ErrIncorrectPassword
-		}
+	return v, password, nil
+}
 
-		err = json.Unmarshal(plaintext, &v)
-		if err != nil {
-			return nil, "", err
+// UnlockVault opens a vault like OpenVault, but uses the key held by the
+// steward's key cache (see StewardKeyCache) instead of requesting the password
+// when possible.
+//
+// The returned password is empty when the cached key was used, so it is only
+// suitable for the vault's sessions (GetSession and CreateSession). Vaults
+// that are going to be sealed again must be opened with OpenVault instead.
+//
+// The time the vault was unlocked is recorded as its LastUsed metadata.
+func (s *store) UnlockVault(name string) (*Vault, string, error) {
+	vf, err := readVaultFile(s.backend, name)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, "", err
+	}
+
+	if vf != nil {
+		if key := s.cachedKey(name, vf); key != nil {
+			v, err := openVaultFileWithKey(vf, key)
+			if err == nil {
+				s.upgradeVaultFile(name, vf, key)
+			}
+			zero(key)
+			if err == nil {
+				s.auditVaultFile(name, vf, AuditOpen, "", nil)
+				s.recordLastUsed(name, time.Now())
+				return v, "", nil
+			}
 		}
+	}
 
-	default:
-		return nil, "", fmt.Errorf("Invalid encryption method: %s", vf.Method)
+	v, password, err := s.OpenVault(name)
+	if err != nil {
+		return nil, "", err
 	}
 
-	return &v, password, nil
+	s.recordLastUsed(name, time.Now())
+	return v, password, nil
+}
+
+func (s *store) openVaultFile(name string, vf *VaultFile, password string) (*Vault, error) {
+	key, err := s.vaultKey(name, vf, password)
+	if err != nil {
+		return nil, err
+	}
+	defer zero(key)
+
+	return openVaultFileWithKey(vf, key)
+}
+
+// openVaultFileWithKey opens a vault file using its master key (see vaultKey).
+func openVaultFileWithKey(vf *VaultFile, masterKey []byte) (*Vault, error) {
+	em, err := lookupEncryptionMethod(vf.Method)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := vf.contentKey(masterKey)
+	if err != nil {
+		return nil, err
+	}
+	defer zero(key)
+
+	additionalData, err := vf.associatedData()
+	if err != nil {
+		return nil, err
+	}
+
+	plaintext, err := openContent(em, key, vf.Ciphertext, additionalData, vf.Details)
+	if err != nil {
+		return nil, err
+	}
+	defer zero(plaintext)
+
+	v := Vault{}
+	err = json.Unmarshal(plaintext, &v)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v, nil
 }
 
 func (s *store) SealVault(vault *Vault, name string) error {
@@ -162,80 +320,624 @@ func (s *store) SealVault(vault *Vault, name string) error {
 }
 
 func (s *store) SealVaultWithPassword(vault *Vault, name, password string) error {
+	return s.SealVaultWithOptions(vault, name, password, SealOptions{})
+}
+
+func (s *store) SealVaultWithOptions(vault *Vault, name, password string, options SealOptions) error {
+	return s.sealVault(vault, name, password, options, nil)
+}
+
+// sealVault seals a vault (see SealVaultWithOptions). When revision is set,
+// the vault is only sealed if it is still at that revision (see
+// SealVaultIfUnmodified).
+func (s *store) sealVault(vault *Vault, name, password string, options SealOptions, revision *int) (err error) {
+	defer func() {
+		s.audit(name, AuditSeal, "", err)
+	}()
+
+	err = ValidateVaultName(name)
+	if err != nil {
+		return err
+	}
+
+	if !options.Fork {
+		err = s.checkWritable(name)
+		if err != nil {
+			return err
+		}
+	}
+
 	vf := &VaultFile{
-		Method:  "secretbox",
-		Details: make(Details),
+		Method:   DefaultEncryptionMethod,
+		Details:  make(Details),
+		Metadata: options.Metadata,
 	}
 
 	// generate a new key (while trying to keeping the existing key derivation and encryption methods)
-	existingVaultFile, err := readVaultFile(name)
+	existingVaultFile, err := readVaultFile(s.backend, name)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	if revision != nil && (err != nil || existingVaultFile.Revision != *revision) {
+		return ErrVaultModified
+	}
 	if err == nil {
+		vf.Revision = existingVaultFile.Revision
+	}
+	if err == nil && !options.Replace {
 		vf.Method = existingVaultFile.Method
 		vf.Key = existingVaultFile.Key
+		vf.Slots = existingVaultFile.Slots
+		vf.Recovery = existingVaultFile.Recovery
 	}
+	vf.Revision++
 
-	vf.Key = newVaultKey(vf.Key)
+	if options.Recovery != nil {
+		vf.Recovery = options.Recovery
+	}
+	if options.RemoveRecovery {
+		vf.Recovery = nil
+	}
 
-	// marshal the vault content
-	content, err := json.Marshal(vault)
-	if err != nil {
-		return err
+	// keep sealing for the existing recipients
+	var recipients []Recipient
+	if vf.Key != nil && vf.Key.Method == RecipientKeyMethod {
+		recipients = recipientsOf(existingVaultFile.Recipients)
 	}
 
-	// encrypt the vault
+	// switch key derivation methods (when requested)
+	if options.KeyMethod != "" && (vf.Key == nil || vf.Key.Method != options.KeyMethod) {
+		vf.Key, err = calibratedVaultKey(options.KeyMethod, nil)
+		if err != nil {
+			return err
+		}
+		recipients = nil
+		vf.Slots = nil
+	}
+
+	// raise the cost of the key derivation method to the current calibration
+	// (when requested)
+	if options.Recalibrate && vf.Key != nil && vf.Key.Method != RecipientKeyMethod && vf.Key.Method != KeySlotsKeyMethod {
+		vf.Key, err = calibratedVaultKey(vf.Key.Method, vf.Key.Details)
+		if err != nil {
+			return err
+		}
+	}
+
+	// switch to sealing for recipients (when requested)
+	if options.Recipients != nil {
+		vf.Key = &VaultKey{
+			Method:  RecipientKeyMethod,
+			Details: make(Details),
+		}
+		recipients = options.Recipients
+		vf.Slots = nil
+	}
+
+	// the recipients and recovery key carried over from the existing vault
+	// file must be authenticated by it, so ones planted in the file are not
+	// able to open the vault once it is sealed
+	keepsRecipients := recipients != nil && options.Recipients == nil
+	keepsRecovery := existingVaultFile != nil && vf.Recovery != nil && vf.Recovery == existingVaultFile.Recovery
+	if keepsRecipients || keepsRecovery {
+		err = s.authenticateVaultFile(name, existingVaultFile, password)
+		if err != nil {
+			return err
+		}
+	}
+
+	vf.Key = newVaultKey(vf.Key)
+
+	// switch encryption methods (when requested)
+	if options.Method != "" {
+		vf.Method = options.Method
+	}
 	if vf.Method == "" {
-		vf.Method = "secretbox"
+		vf.Method = DefaultEncryptionMethod
 	}
 
-	switch vf.Method {
-	case "secretbox":
-		nonce := [24]byte{}
-		_, err = rand.Read(nonce[:])
+	// recipients and recovery keys are only kept by encryption methods that
+	// authenticate them
+	if vf.Key.Method == RecipientKeyMethod || vf.Recovery != nil {
+		em, err := lookupEncryptionMethod(vf.Method)
 		if err != nil {
 			return err
 		}
-		vf.Details.SetBytes("nonce", nonce[:])
+		if !em.authenticatesAdditionalData() {
+			vf.Method = DefaultEncryptionMethod
+		}
+	}
 
-		key := [32]byte{}
-		derivedKey, err := vf.Key.key(password, len(key))
+	var key []byte
+	switch vf.Key.Method {
+	case RecipientKeyMethod:
+		// a new data key is generated each time the vault is sealed
+		key = make([]byte, encryptionKeySize)
+		_, err = rand.Read(key)
 		if err != nil {
 			return err
 		}
-		copy(key[:], derivedKey[:])
 
-		vf.Ciphertext = secretbox.Seal(nil, content, &nonce, &key)
+		vf.Recipients, err = wrapRecipientKeys(key, recipients)
+
+	case KeySlotsKeyMethod:
+		// the master key is kept, so each slot continues to open the vault
+		key, err = openKeySlots(vf.Slots, password)
 
 	default:
-		return fmt.Errorf("Invalid encryption method: %s", vf.Method)
+		key, err = vf.Key.key(password, encryptionKeySize)
+	}
+	if err != nil {
+		return err
+	}
+	defer zero(key)
+
+	return s.sealVaultFile(vault, name, existingVaultFile, vf, key, options.Operation)
+}
+
+// sealVaultFile encrypts the vault's content into vf (whose key and
+// encryption methods are already set up) using a key derived from masterKey,
+// then writes it and records it in the vault's history. The metadata of the
+// existing vault is kept, unless vf has replacement metadata.
+func (s *store) sealVaultFile(vault *Vault, name string, existingVaultFile, vf *VaultFile, masterKey []byte, operation string) error {
+	now := time.Now()
+	vf.Metadata = sealedMetadata(existingVaultFile, vf.Metadata, now)
+
+	// the time the vault was last used is no longer kept in the vault file
+	if vf.Metadata.LastUsed != nil {
+		s.recordLastUsed(name, *vf.Metadata.LastUsed)
+		vf.Metadata.LastUsed = nil
+	}
+	vf.PendingMetadata = nil
+	vf.KeySchedule = HKDFKeySchedule
+	vf.FormatVersion = CurrentFormatVersion
+
+	_, err := lookupEncryptionMethod(vf.Method)
+	if err != nil {
+		return err
+	}
+
+	// the audit key is kept when the vault was opened before being sealed
+	var existingAuditKey *AuditKey
+	var existingMasterKey []byte
+	if existingVaultFile != nil {
+		existingAuditKey = existingVaultFile.Audit
+		existingMasterKey = s.cachedKey(name, existingVaultFile)
+		defer zero(existingMasterKey)
+	}
+	vf.Audit, err = newAuditKey(existingAuditKey, existingMasterKey, masterKey)
+	if err != nil {
+		return err
+	}
+
+	// the recovery key has to open the new master key
+	if vf.Recovery != nil {
+		vf.Recovery, err = vf.Recovery.wrap(masterKey)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = vf.encrypt(vault, masterKey)
+	if err != nil {
+		return err
+	}
+
+	// the vault is only replaced if it hasn't changed since it was read (e.g.
+	// by another process, while the key was being derived)
+	unlock, err := lockBlob(s.backend, VaultBlob, name)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	err = checkUnmodified(s.backend, name, existingVaultFile)
+	if err != nil {
+		return err
+	}
+
+	err = writeVaultFile(s.backend, name, vf)
+	if err != nil {
+		return err
+	}
+
+	s.cacheKey(name, vf, masterKey)
+
+	// a new audit key pair starts a new head for the audit log (errors are
+	// ignored, as they are for the entries of the log)
+	if existingAuditKey == nil || !bytes.Equal(existingAuditKey.PublicKey, vf.Audit.PublicKey) {
+		if chainKey, err := vf.Audit.chainKey(masterKey); err == nil {
+			resetAuditHead(s.backend, name, chainKey)
+			zero(chainKey)
+		}
+	}
+
+	return recordHistory(s.backend, name, existingVaultFile, &HistoryEntry{
+		Revision:  vf.Revision,
+		Operation: operation,
+		Timestamp: now,
+		VaultFile: vf,
+	})
+}
+
+// SealVaultIfUnmodified seals a vault, but only if it hasn't been modified
+// since the given revision was retrieved. ErrVaultModified is returned if the
+// vault has changed (or has been removed).
+func (s *store) SealVaultIfUnmodified(vault *Vault, name, password string, revision int, options SealOptions) error {
+	return s.sealVault(vault, name, password, options, &revision)
+}
+
+// authenticateVaultFile opens a vault file with its master key (the key it
+// was opened with, when it was opened by the store), which authenticates the
+// fields of the file carried over when the vault is sealed (see
+// VaultFile.associatedData). ErrVaultNotAuthenticated is returned if the file
+// doesn't open, or if its encryption method doesn't authenticate those fields.
+func (s *store) authenticateVaultFile(name string, vf *VaultFile, password string) error {
+	em, err := lookupEncryptionMethod(vf.Method)
+	if err != nil || !em.authenticatesAdditionalData() {
+		return ErrVaultNotAuthenticated
+	}
+
+	key := s.cachedKey(name, vf)
+	if key == nil {
+		key, err = s.vaultKey(name, vf, password)
+		if err != nil {
+			return ErrVaultNotAuthenticated
+		}
+	}
+	defer zero(key)
+
+	_, err = openVaultFileWithKey(vf, key)
+	if err != nil {
+		return ErrVaultNotAuthenticated
+	}
+	return nil
+}
+
+// checkUnmodified returns ErrVaultModified if the vault's revision, recipients
+// or recovery key differ from those of existing (the vault file read before
+// sealing it, or nil when the vault didn't exist). The vault should be locked.
+func checkUnmodified(backend Backend, name string, existing *VaultFile) error {
+	data, err := backend.Get(VaultBlob, name)
+	if os.IsNotExist(err) {
+		if existing != nil {
+			return ErrVaultModified
+		}
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	current := VaultFile{}
+	err = json.Unmarshal(data, &current)
+	if err != nil {
+		return err
+	}
+
+	if existing == nil || current.Revision != existing.Revision {
+		return ErrVaultModified
 	}
 
-	return writeVaultFile(name, vf)
+	currentHeader, err := json.Marshal([]interface{}{current.Recipients, current.Recovery})
+	if err != nil {
+		return err
+	}
+	existingHeader, err := json.Marshal([]interface{}{existing.Recipients, existing.Recovery})
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(currentHeader, existingHeader) {
+		return ErrVaultModified
+	}
+	return nil
 }
 
+// RemoveVault removes a vault, along with its history and session cache.
+// Its audit log is kept (recording the removal).
 func (s *store) RemoveVault(name string) error {
-	existing := xdg.DATA_HOME.Find(filepath.Join("vaulted", name))
-	if existing == "" {
-		untouchable := xdg.DATA_DIRS.Find(filepath.Join("vaulted", name))
-		if len(untouchable) == 0 {
-			return os.ErrNotExist
+	vf, _ := readVaultFile(s.backend, name)
+
+	err := s.backend.Delete(VaultBlob, name)
+	if vf != nil {
+		s.auditVaultFile(name, vf, AuditRemove, "", err)
+	}
+	if err != nil {
+		return err
+	}
+
+	removeHistory(s.backend, name)
+	removeSessionCache(s.backend, name)
+	s.backend.Delete(LastUsedBlob, name)
+
+	return nil
+}
+
+// VaultHistory returns the revisions kept in a vault's history, oldest first.
+func (s *store) VaultHistory(name string) ([]*HistoryEntry, error) {
+	if !s.VaultExists(name) {
+		return nil, os.ErrNotExist
+	}
+
+	return readHistory(s.backend, name)
+}
+
+// OpenVaultRevision opens a revision from a vault's history. The password is
+// the one the revision was sealed with, which may differ from the vault's
+// current password.
+func (s *store) OpenVaultRevision(name string, revision int, password string) (*Vault, error) {
+	entries, err := s.VaultHistory(name)
+	if err != nil {
+		return nil, err
+	}
+
+	entry := findHistoryEntry(entries, revision)
+	if entry == nil || entry.VaultFile == nil {
+		return nil, ErrRevisionNotExist
+	}
+
+	// revisions are kept in the format they were sealed in
+	vf := entry.VaultFile
+	err = migrateVaultFile(vf)
+	if err != nil {
+		return nil, err
+	}
+
+	if vf.Key != nil && vf.Key.Method == KeySlotsKeyMethod {
+		// slots added since the revision was sealed hold the same master key
+		// (unless the vault has been sealed with a password in between)
+		current, err := readVaultFile(s.backend, name)
+		if err == nil && current.Key != nil && current.Key.Method == KeySlotsKeyMethod {
+			copied := *vf
+			copied.Slots = append(append([]*KeySlot{}, vf.Slots...), current.Slots...)
+			vf = &copied
 		}
+	}
 
-		return fmt.Errorf("Because %s is outside the vaulted managed directory (%s), it must be removed manually", untouchable[0], xdg.DATA_HOME.Join("vaulted"))
+	return s.openVaultFile(name, vf, password)
+}
+
+// VaultRecipients returns the recipients a vault is sealed for (or nil when
+// the vault is sealed with a password).
+func (s *store) VaultRecipients(name string) ([]Recipient, error) {
+	vf, err := readVaultFile(s.backend, name)
+	if err != nil {
+		return nil, err
 	}
 
-	removeSessionCache(name)
+	if vf.Key == nil || vf.Key.Method != RecipientKeyMethod {
+		return nil, nil
+	}
 
-	return os.Remove(existing)
+	return recipientsOf(vf.Recipients), nil
+}
+
+// KeySlots returns the key slots of a vault (or nil when the vault does not
+// use key slots).
+func (s *store) KeySlots(name string) ([]*KeySlot, error) {
+	vf, err := readVaultFile(s.backend, name)
+	if err != nil {
+		return nil, err
+	}
+
+	if vf.Key == nil || vf.Key.Method != KeySlotsKeyMethod {
+		return nil, nil
+	}
+
+	return vf.Slots, nil
+}
+
+// AddKeySlot adds a slot for newPassword (derived using keyMethod, or the
+// default method when empty) to a vault. password must open the vault.
+//
+// Vaults using key slots keep their master key, so the existing slots keep
+// opening the vault once it is sealed with the new slot. Vaults sealed with a
+// single password are resealed with a new master key, keeping password in the
+// first slot. The ID of the new slot is returned. Like SealVaultIfUnmodified,
+// ErrVaultModified is returned if the vault changes while the slot is added.
+func (s *store) AddKeySlot(name, password, newPassword, keyMethod string) (int, error) {
+	vf, err := readVaultFile(s.backend, name)
+	if err != nil {
+		return 0, err
+	}
+
+	err = s.checkWritable(name)
+	if err != nil {
+		return 0, err
+	}
+
+	if vf.Key == nil {
+		return 0, ErrInvalidKeyConfig
+	}
+
+	switch vf.Key.Method {
+	case RecipientKeyMethod:
+		return 0, ErrKeySlotsNotSupported
+
+	case KeySlotsKeyMethod:
+		masterKey, err := openKeySlots(vf.Slots, password)
+		if err != nil {
+			return 0, err
+		}
+		defer zero(masterKey)
+
+		vault, err := s.openKeySlotsVaultFile(name, vf, masterKey)
+		if err != nil {
+			return 0, err
+		}
+
+		slot, err := newKeySlot(nextKeySlotID(vf.Slots), masterKey, newPassword, keyMethod)
+		if err != nil {
+			return 0, err
+		}
+
+		slots := append(append([]*KeySlot{}, vf.Slots...), slot)
+		return slot.ID, s.sealKeySlots(vault, name, vf, vf.Key, slots, masterKey)
+	}
+
+	// switch from a single password to key slots
+	key, err := s.vaultKey(name, vf, password)
+	if err != nil {
+		return 0, err
+	}
+	defer zero(key)
+
+	vault, err := s.openKeySlotsVaultFile(name, vf, key)
+	if err != nil {
+		return 0, err
+	}
+
+	masterKey := make([]byte, encryptionKeySize)
+	_, err = rand.Read(masterKey)
+	if err != nil {
+		return 0, err
+	}
+	defer zero(masterKey)
+
+	// the ID tells this master key apart from any the vault had before (so
+	// keys cached for those are not used, see keyFingerprint)
+	keyID := make([]byte, 16)
+	_, err = rand.Read(keyID)
+	if err != nil {
+		return 0, err
+	}
+	keyDetails := make(Details)
+	keyDetails.SetBytes("id", keyID)
+
+	first, err := newKeySlot(0, masterKey, password, vf.Key.Method)
+	if err != nil {
+		return 0, err
+	}
+
+	slot, err := newKeySlot(1, masterKey, newPassword, keyMethod)
+	if err != nil {
+		return 0, err
+	}
+
+	slotsKey := &VaultKey{
+		Method:  KeySlotsKeyMethod,
+		Details: keyDetails,
+	}
+	err = s.sealKeySlots(vault, name, vf, slotsKey, []*KeySlot{first, slot}, masterKey)
+	if err != nil {
+		return 0, err
+	}
+
+	return slot.ID, nil
+}
+
+// RemoveKeySlot removes a slot from a vault. password must open one of the
+// vault's slots (which may be the slot being removed). Removing the last slot
+// is refused with ErrLastKeySlot. The vault is sealed again without the slot
+// (ErrVaultModified is returned if it changes in the meantime).
+//
+// The slot is removed from the vault's history as well, since the revisions
+// sealed with key slots share the same master key.
+func (s *store) RemoveKeySlot(name, password string, id int) error {
+	vf, err := readVaultFile(s.backend, name)
+	if err != nil {
+		return err
+	}
+
+	err = s.checkWritable(name)
+	if err != nil {
+		return err
+	}
+
+	if vf.Key == nil || vf.Key.Method != KeySlotsKeyMethod {
+		return ErrKeySlotNotExist
+	}
+
+	masterKey, err := openKeySlots(vf.Slots, password)
+	if err != nil {
+		return err
+	}
+	defer zero(masterKey)
+
+	remaining, found := removeKeySlot(vf.Slots, id)
+	if !found {
+		return ErrKeySlotNotExist
+	}
+	if len(remaining) == 0 {
+		return ErrLastKeySlot
+	}
+
+	vault, err := s.openKeySlotsVaultFile(name, vf, masterKey)
+	if err != nil {
+		return err
+	}
+
+	err = s.sealKeySlots(vault, name, vf, vf.Key, remaining, masterKey)
+	if err != nil {
+		return err
+	}
+
+	entries, err := readHistory(s.backend, name)
+	if err != nil || entries == nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		if entry.VaultFile != nil {
+			entry.VaultFile.Slots, _ = removeKeySlot(entry.VaultFile.Slots, id)
+		}
+	}
+
+	return writeHistory(s.backend, name, entries)
+}
+
+// openKeySlotsVaultFile opens a vault file whose key slots are being changed,
+// remembering its key so the vault's audit key pair is kept when it is sealed
+// with the new slots. ErrVaultNotAuthenticated is returned if the vault's
+// recovery key can't be authenticated (see authenticateVaultFile).
+func (s *store) openKeySlotsVaultFile(name string, vf *VaultFile, key []byte) (*Vault, error) {
+	vault, err := openVaultFileWithKey(vf, key)
+	if err != nil {
+		return nil, err
+	}
+
+	if vf.Recovery != nil {
+		em, err := lookupEncryptionMethod(vf.Method)
+		if err != nil || !em.authenticatesAdditionalData() {
+			return nil, ErrVaultNotAuthenticated
+		}
+	}
+
+	s.rememberKey(name, vf, key)
+	return vault, nil
+}
+
+// sealKeySlots seals a new revision of a vault with the given key slots, which
+// open masterKey. The vault is only replaced if it hasn't changed since vf was
+// read (ErrVaultModified is returned otherwise).
+func (s *store) sealKeySlots(vault *Vault, name string, vf *VaultFile, key *VaultKey, slots []*KeySlot, masterKey []byte) error {
+	slotsVaultFile := &VaultFile{
+		Key:      key,
+		Revision: vf.Revision + 1,
+		Slots:    slots,
+		Recovery: vf.Recovery,
+		Method:   vf.Method,
+		Details:  make(Details),
+	}
+
+	return s.sealVaultFile(vault, name, vf, slotsVaultFile, masterKey, "passwd")
 }
 
 func (s *store) GetSession(v *Vault, name, password string) (*Session, error) {
+	// the included content has to be part of the session cache key
+	v, err := s.ResolveIncludes(v, name)
+	if err != nil {
+		return nil, err
+	}
+
 	sessionCache, err := s.openSessionCache(name, password)
 	if err != nil {
 		sessionCache = &SessionCache{}
-		removeSessionCache(name)
+		removeSessionCache(s.backend, name)
 	} else {
 		session, err := sessionCache.GetVaultSession(v)
 		if err == nil && !session.Expired(15*time.Minute) {
+			s.audit(name, AuditCachedSession, "", nil)
 			return session, nil
 		}
 	}
@@ -244,6 +946,12 @@ func (s *store) GetSession(v *Vault, name, password string) (*Session, error) {
 }
 
 func (s *store) CreateSession(v *Vault, name, password string) (*Session, error) {
+	session, err := s.createSession(v, name, password)
+	s.audit(name, AuditCreateSession, "", err)
+	return session, err
+}
+
+func (s *store) createSession(v *Vault, name, password string) (*Session, error) {
 	var session *Session
 	var err error
 
@@ -251,6 +959,11 @@ func (s *store) CreateSession(v *Vault, name, password string) (*Session, error)
 		return nil, os.ErrNotExist
 	}
 
+	v, err = s.ResolveIncludes(v, name)
+	if err != nil {
+		return nil, err
+	}
+
 	// actually create the session
 	if v.AWSKey.RequiresMFA() {
 		var mfaToken string
@@ -269,7 +982,7 @@ func (s *store) CreateSession(v *Vault, name, password string) (*Session, error)
 	sessionCache, err := s.openSessionCache(name, password)
 	if err != nil {
 		sessionCache = &SessionCache{}
-		removeSessionCache(name)
+		removeSessionCache(s.backend, name)
 	}
 
 	sessionCache.PutVaultSession(v, session)
@@ -278,9 +991,81 @@ func (s *store) CreateSession(v *Vault, name, password string) (*Session, error)
 	return session, nil
 }
 
+// AssumeRole assumes a role using a session of a vault, recording it in the
+// vault's audit log. If roleArn is empty, the session's own role is assumed
+// (see Session.AssumeSessionRole).
+func (s *store) AssumeRole(session *Session, name, roleArn string) (*Session, error) {
+	if roleArn == "" {
+		if session.Role == "" {
+			return session, nil
+		}
+
+		roleArn = session.Role
+		session.Role = ""
+	}
+
+	assumed, err := session.AssumeRole(roleArn)
+	if err == nil {
+		roleArn = assumed.ActiveRole
+	}
+	s.audit(name, AuditAssumeRole, roleArn, err)
+
+	return assumed, err
+}
+
+// vaultKey returns the master key of a vault file, which the keys for its
+// content and session cache are derived from (see VaultFile.contentKey and
+// store.sessionCacheKey). The master key of vaults sealed with a password is derived
+// from the password, vaults using key slots have their master key unwrapped by
+// the password, while vaults sealed for recipients are opened using the
+// steward's identity (and the password is ignored).
+func (s *store) vaultKey(name string, vf *VaultFile, password string) ([]byte, error) {
+	if vf.Key == nil {
+		return nil, ErrInvalidKeyConfig
+	}
+
+	if vf.Key.Method == KeySlotsKeyMethod {
+		return openKeySlots(vf.Slots, password)
+	}
+
+	if vf.Key.Method != RecipientKeyMethod {
+		return vf.Key.key(password, encryptionKeySize)
+	}
+
+	stewardIdentity, ok := s.steward.(StewardIdentity)
+	if !ok {
+		return nil, ErrNoIdentity
+	}
+
+	identity, err := stewardIdentity.GetIdentity(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return unwrapRecipientKey(vf.Recipients, identity)
+}
+
+// sessionCacheKey returns the key a vault's session cache is encrypted with.
+// The vault's master key is only derived when it isn't already cached (so a
+// vault that was just opened, or that was unlocked with a key held by the
+// steward's key cache, is able to use its session cache as well).
+func (s *store) sessionCacheKey(name string, vf *VaultFile, password string) ([]byte, error) {
+	masterKey := s.cachedKey(name, vf)
+	if masterKey == nil {
+		var err error
+		masterKey, err = s.vaultKey(name, vf, password)
+		if err != nil {
+			return nil, err
+		}
+	}
+	defer zero(masterKey)
+
+	return subKey(masterKey, sessionCacheKeyInfo)
+}
+
 func (s *store) sealSessionCache(sessionCache *SessionCache, name, password string) error {
 	// read the vault file (to get key details)
-	vf, err := readVaultFile(name)
+	vf, err := readVaultFile(s.backend, name)
 	if err != nil {
 		return err
 	}
@@ -294,83 +1079,75 @@ func (s *store) sealSessionCache(sessionCache *SessionCache, name, password stri
 	if err != nil {
 		return err
 	}
+	defer zero(content)
 
-	// encrypt the session
+	// encrypt the session (using the same encryption method as the vault)
 	sf := &SessionFile{
-		Method:  "secretbox",
+		Method:  vf.Method,
 		Details: make(Details),
 	}
 
-	switch sf.Method {
-	case "secretbox":
-		nonce := [24]byte{}
-		_, err = rand.Read(nonce[:])
-		if err != nil {
-			return err
-		}
-		sf.Details.SetBytes("nonce", nonce[:])
+	em, err := lookupEncryptionMethod(sf.Method)
+	if err != nil {
+		return err
+	}
 
-		key := [32]byte{}
-		derivedKey, err := vf.Key.key(password, len(key))
-		if err != nil {
-			return err
-		}
-		copy(key[:], derivedKey[:])
+	key, err := s.sessionCacheKey(name, vf, password)
+	if err != nil {
+		return err
+	}
+	defer zero(key)
 
-		sf.Ciphertext = secretbox.Seal(nil, content, &nonce, &key)
+	additionalData, err := sf.associatedData()
+	if err != nil {
+		return err
+	}
 
-	default:
+	sf.Ciphertext, err = sealContent(em, key, content, additionalData, sf.Details)
+	if err != nil {
 		return err
 	}
 
-	return writeSessionFile(name, sf)
+	return writeSessionFile(s.backend, name, sf)
 }
 
 func (s *store) openSessionCache(name, password string) (*SessionCache, error) {
-	vf, err := readVaultFile(name)
+	vf, err := readVaultFile(s.backend, name)
 	if err != nil {
 		return nil, err
 	}
 
-	sf, err := readSessionFile(name)
+	sf, err := readSessionFile(s.backend, name)
 	if err != nil {
 		return nil, err
 	}
 
-	sessionCache := SessionCache{}
-
-	switch sf.Method {
-	case "secretbox":
-		if vf.Key == nil {
-			return nil, ErrInvalidKeyConfig
-		}
-
-		nonce := sf.Details.Bytes("nonce")
-		if len(nonce) == 0 {
-			return nil, ErrInvalidEncryptionConfig
-		}
-		boxNonce := [24]byte{}
-		copy(boxNonce[:], nonce)
+	em, err := lookupEncryptionMethod(sf.Method)
+	if err != nil {
+		return nil, err
+	}
 
-		boxKey := [32]byte{}
-		derivedKey, err := vf.Key.key(password, len(boxKey))
-		if err != nil {
-			return nil, err
-		}
-		copy(boxKey[:], derivedKey[:])
+	key, err := s.sessionCacheKey(name, vf, password)
+	if err != nil {
+		return nil, err
+	}
+	defer zero(key)
 
-		plaintext, ok := secretbox.Open(nil, sf.Ciphertext, &boxNonce, &boxKey)
-		if !ok {
-			return nil, ErrIncorrectPassword
-		}
+	additionalData, err := sf.associatedData()
+	if err != nil {
+		return nil, err
+	}
 
-		err = json.Unmarshal(plaintext, &sessionCache)
-		if err != nil {
-			return nil, err
-		}
+	plaintext, err := openContent(em, key, sf.Ciphertext, additionalData, sf.Details)
+	if err != nil {
+		return nil, err
+	}
+	defer zero(plaintext)
 
-	default:
-		return nil, fmt.Errorf("Invalid encryption method: %s", sf.Method)
+	sessionCache := SessionCache{}
+	err = json.Unmarshal(plaintext, &sessionCache)
+	if err != nil {
+		return nil, err
 	}
 
 	if sessionCache.SessionCacheVersion != SessionCacheVersion {
@@ -1,6 +1,8 @@
 package vaulted_test
 
 import (
+	"encoding/json"
+	"fmt"
 	"io/ioutil"
 	"os"
 	"path/filepath"
@@ -28,13 +30,19 @@ func testStore() vaulted.Store {
 }
 
 func testStoreWithPassword(password string) vaulted.Store {
-	return vaulted.New(vaulted.NewStaticSteward(password))
+	return vaulted.New(vaulted.NewStaticSteward(password), vaulted.NewXDGBackend())
 }
 
 func TestListVaults(t *testing.T) {
 	setupVaults(t)
 	defer teardownVaults(t)
 
+	// temporary files left behind by an interrupted write aren't vaults
+	err := ioutil.WriteFile(filepath.Join(string(xdg.DATA_HOME), "vaulted", ".aaa.tmp123456"), []byte{}, 0600)
+	if err != nil {
+		t.Fatalf("failed to write temporary file: %v", err)
+	}
+
 	store := testStore()
 
 	vaults, err := store.ListVaults()
@@ -102,6 +110,374 @@ func TestSealVault(t *testing.T) {
 	}
 }
 
+func TestSealVaultDefaultKeyMethod(t *testing.T) {
+	setupVaults(t)
+	defer teardownVaults(t)
+
+	store := testStore()
+
+	v1 := vaulted.Vault{
+		Vars: map[string]string{
+			"TEST": "TESTING",
+		},
+	}
+	err := store.SealVault(&v1, "testing")
+	if err != nil {
+		t.Fatalf("failed to seal vault: %v", err)
+	}
+
+	vf := readTestVaultFile(t, "testing")
+	if vf.Key.Method != vaulted.DefaultKeyMethod {
+		t.Fatalf("expected: %s, got: %s", vaulted.DefaultKeyMethod, vf.Key.Method)
+	}
+	if vf.Key.Details.Int("time") == 0 || vf.Key.Details.Int("memory") == 0 || vf.Key.Details.Int("parallelism") == 0 {
+		t.Fatalf("expected argon2id parameters, got: %#v", vf.Key.Details)
+	}
+
+	v2, _, err := store.OpenVault("testing")
+	if err != nil {
+		t.Fatalf("failed to open vault: %v", err)
+	}
+	if v2.Vars["TEST"] != "TESTING" {
+		t.Fatalf("expected: TESTING, got: %s", v2.Vars["TEST"])
+	}
+}
+
+func TestSealVaultWithOptionsKeyMethod(t *testing.T) {
+	setupVaults(t)
+	defer teardownVaults(t)
+
+	store := testStore()
+
+	vault, password, err := store.OpenVault("aaa")
+	if err != nil {
+		t.Fatalf("failed to open vault: %v", err)
+	}
+
+	// resealing keeps the existing key derivation method
+	err = store.SealVaultWithPassword(vault, "aaa", password)
+	if err != nil {
+		t.Fatalf("failed to seal vault: %v", err)
+	}
+	if method := readTestVaultFile(t, "aaa").Key.Method; method != "pbkdf2-sha512" {
+		t.Fatalf("expected: pbkdf2-sha512, got: %s", method)
+	}
+
+	// migrate to argon2id
+	err = store.SealVaultWithOptions(vault, "aaa", password, vaulted.SealOptions{KeyMethod: "argon2id"})
+	if err != nil {
+		t.Fatalf("failed to seal vault: %v", err)
+	}
+	if method := readTestVaultFile(t, "aaa").Key.Method; method != "argon2id" {
+		t.Fatalf("expected: argon2id, got: %s", method)
+	}
+
+	_, _, err = store.OpenVault("aaa")
+	if err != nil {
+		t.Fatalf("failed to open vault: %v", err)
+	}
+
+	// unknown methods are rejected
+	err = store.SealVaultWithOptions(vault, "aaa", password, vaulted.SealOptions{KeyMethod: "rot13"})
+	if err == nil {
+		t.Fatal("expected sealing with an invalid key derivation method to fail")
+	}
+}
+
+func TestSealVaultIfUnmodified(t *testing.T) {
+	setupVaults(t)
+	defer teardownVaults(t)
+
+	store := testStore()
+
+	revision, err := store.VaultRevision("aaa")
+	if err != nil {
+		t.Fatalf("failed to read revision: %v", err)
+	}
+
+	vault, password, err := store.OpenVault("aaa")
+	if err != nil {
+		t.Fatalf("failed to open vault: %v", err)
+	}
+
+	// simulate a concurrent edit
+	err = store.SealVaultWithPassword(&vaulted.Vault{Vars: map[string]string{"OTHER": "edit"}}, "aaa", password)
+	if err != nil {
+		t.Fatalf("failed to seal vault: %v", err)
+	}
+
+	vault.Vars = map[string]string{"MINE": "edit"}
+	err = store.SealVaultIfUnmodified(vault, "aaa", password, revision, vaulted.SealOptions{})
+	if err != vaulted.ErrVaultModified {
+		t.Fatalf("expected %v, got %v", vaulted.ErrVaultModified, err)
+	}
+
+	saved, _, err := store.OpenVaultWithPassword("aaa", password)
+	if err != nil {
+		t.Fatalf("failed to open vault: %v", err)
+	}
+	if saved.Vars["OTHER"] != "edit" {
+		t.Fatalf("concurrent edit was overwritten: %#v", saved.Vars)
+	}
+
+	revision, err = store.VaultRevision("aaa")
+	if err != nil {
+		t.Fatalf("failed to read revision: %v", err)
+	}
+	err = store.SealVaultIfUnmodified(vault, "aaa", password, revision, vaulted.SealOptions{})
+	if err != nil {
+		t.Fatalf("failed to seal unmodified vault: %v", err)
+	}
+
+	newRevision, err := store.VaultRevision("aaa")
+	if err != nil {
+		t.Fatalf("failed to read revision: %v", err)
+	}
+	if newRevision != revision+1 {
+		t.Fatalf("expected revision %d, got %d", revision+1, newRevision)
+	}
+
+	err = store.RemoveVault("aaa")
+	if err != nil {
+		t.Fatalf("failed to remove vault: %v", err)
+	}
+	err = store.SealVaultIfUnmodified(vault, "aaa", password, newRevision, vaulted.SealOptions{})
+	if err != vaulted.ErrVaultModified {
+		t.Fatalf("expected %v for removed vault, got %v", vaulted.ErrVaultModified, err)
+	}
+}
+
+// racingBackend runs race before the first blob is locked, simulating another
+// process writing the vault while it is being sealed.
+type racingBackend struct {
+	*vaulted.MemoryBackend
+	race func()
+}
+
+func (b *racingBackend) Lock(kind vaulted.BlobKind, name string) (func(), error) {
+	if b.race != nil {
+		race := b.race
+		b.race = nil
+		race()
+	}
+	return b.MemoryBackend.Lock(kind, name)
+}
+
+func TestSealVaultModifiedWhileSealing(t *testing.T) {
+	backend := &racingBackend{MemoryBackend: vaulted.NewMemoryBackend()}
+	store := vaulted.New(vaulted.NewStaticSteward("password"), backend)
+	other := vaulted.New(vaulted.NewStaticSteward("password"), backend.MemoryBackend)
+
+	err := store.SealVault(&vaulted.Vault{}, "one")
+	if err != nil {
+		t.Fatalf("failed to seal vault: %v", err)
+	}
+	revision, err := store.VaultRevision("one")
+	if err != nil {
+		t.Fatalf("failed to read revision: %v", err)
+	}
+
+	for _, seal := range []func() error{
+		func() error {
+			return store.SealVaultIfUnmodified(&vaulted.Vault{Vars: map[string]string{"MINE": "edit"}}, "one", "password", revision, vaulted.SealOptions{})
+		},
+		func() error {
+			return store.SealVault(&vaulted.Vault{Vars: map[string]string{"MINE": "edit"}}, "one")
+		},
+	} {
+		backend.race = func() {
+			err := other.SealVault(&vaulted.Vault{Vars: map[string]string{"OTHER": "edit"}}, "one")
+			if err != nil {
+				t.Fatalf("failed to seal vault: %v", err)
+			}
+		}
+
+		err = seal()
+		if err != vaulted.ErrVaultModified {
+			t.Fatalf("expected %v, got %v", vaulted.ErrVaultModified, err)
+		}
+
+		saved, _, err := other.OpenVault("one")
+		if err != nil {
+			t.Fatalf("failed to open vault: %v", err)
+		}
+		if saved.Vars["OTHER"] != "edit" {
+			t.Fatalf("concurrent edit was overwritten: %#v", saved.Vars)
+		}
+		revision, _ = other.VaultRevision("one")
+	}
+}
+
+func TestVaultHistory(t *testing.T) {
+	setupVaults(t)
+	defer teardownVaults(t)
+
+	store := testStore()
+
+	for i, operation := range []string{"edit", "passwd"} {
+		vault := &vaulted.Vault{Vars: map[string]string{"REVISION": operation}}
+		err := store.SealVaultWithOptions(vault, "aaa", fmt.Sprintf("password %d", i), vaulted.SealOptions{
+			Operation: operation,
+		})
+		if err != nil {
+			t.Fatalf("failed to seal vault: %v", err)
+		}
+	}
+
+	entries, err := store.VaultHistory("aaa")
+	if err != nil {
+		t.Fatalf("failed to read history: %v", err)
+	}
+
+	var operations []string
+	for _, entry := range entries {
+		operations = append(operations, fmt.Sprintf("%d:%s", entry.Revision, entry.Operation))
+	}
+	expected := []string{"0:", "1:edit", "2:passwd"}
+	if !reflect.DeepEqual(expected, operations) {
+		t.Fatalf("expected %#v, got %#v", expected, operations)
+	}
+	if !entries[0].Timestamp.IsZero() || entries[1].Timestamp.IsZero() {
+		t.Fatal("expected only revisions recorded in the history to have timestamps")
+	}
+
+	// each revision opens with the password it was sealed with
+	vault, err := store.OpenVaultRevision("aaa", 0, "password")
+	if err != nil {
+		t.Fatalf("failed to open revision 0: %v", err)
+	}
+	if vault.Vars["TEST"] != "AAA" {
+		t.Fatalf("unexpected content for revision 0: %#v", vault.Vars)
+	}
+
+	_, err = store.OpenVaultRevision("aaa", 1, "password 1")
+	if err != vaulted.ErrIncorrectPassword {
+		t.Fatalf("expected %v, got %v", vaulted.ErrIncorrectPassword, err)
+	}
+
+	vault, err = store.OpenVaultRevision("aaa", 1, "password 0")
+	if err != nil {
+		t.Fatalf("failed to open revision 1: %v", err)
+	}
+	if vault.Vars["REVISION"] != "edit" {
+		t.Fatalf("unexpected content for revision 1: %#v", vault.Vars)
+	}
+
+	_, err = store.OpenVaultRevision("aaa", 42, "password")
+	if err != vaulted.ErrRevisionNotExist {
+		t.Fatalf("expected %v, got %v", vaulted.ErrRevisionNotExist, err)
+	}
+
+	// removing the vault removes its history
+	err = store.RemoveVault("aaa")
+	if err != nil {
+		t.Fatalf("failed to remove vault: %v", err)
+	}
+	err = store.SealVaultWithPassword(&vaulted.Vault{}, "aaa", "password")
+	if err != nil {
+		t.Fatalf("failed to seal vault: %v", err)
+	}
+	entries, err = store.VaultHistory("aaa")
+	if err != nil {
+		t.Fatalf("failed to read history: %v", err)
+	}
+	if len(entries) != 1 || entries[0].Revision != 1 {
+		t.Fatalf("expected only the new revision in the history, got %d entries", len(entries))
+	}
+}
+
+func TestVaultHistoryLength(t *testing.T) {
+	historyLength := vaulted.HistoryLength
+	defer func() { vaulted.HistoryLength = historyLength }()
+	vaulted.HistoryLength = 3
+
+	store := vaulted.New(vaulted.NewStaticSteward("password"), vaulted.NewMemoryBackend())
+	for i := 0; i < 5; i++ {
+		err := store.SealVault(&vaulted.Vault{}, "history")
+		if err != nil {
+			t.Fatalf("failed to seal vault: %v", err)
+		}
+	}
+
+	entries, err := store.VaultHistory("history")
+	if err != nil {
+		t.Fatalf("failed to read history: %v", err)
+	}
+
+	var revisions []int
+	for _, entry := range entries {
+		revisions = append(revisions, entry.Revision)
+	}
+	if !reflect.DeepEqual([]int{3, 4, 5}, revisions) {
+		t.Fatalf("expected revisions [3 4 5], got %v", revisions)
+	}
+}
+
+func TestSealVaultEncryptionMethods(t *testing.T) {
+	setupVaults(t)
+	defer teardownVaults(t)
+
+	store := testStore()
+
+	for _, method := range []string{"secretbox", "xchacha20poly1305", "aes-256-gcm"} {
+		v1 := vaulted.Vault{
+			Vars: map[string]string{
+				"TEST": method,
+			},
+		}
+		err := store.SealVaultWithOptions(&v1, method, "password", vaulted.SealOptions{Method: method})
+		if err != nil {
+			t.Fatalf("failed to seal %s vault: %v", method, err)
+		}
+
+		if vf := readTestVaultFile(t, method); vf.Method != method {
+			t.Fatalf("expected: %s, got: %s", method, vf.Method)
+		}
+
+		// resealing keeps the encryption method
+		err = store.SealVaultWithPassword(&v1, method, "password")
+		if err != nil {
+			t.Fatalf("failed to reseal %s vault: %v", method, err)
+		}
+
+		if vf := readTestVaultFile(t, method); vf.Method != method {
+			t.Fatalf("expected: %s, got: %s", method, vf.Method)
+		}
+
+		v2, _, err := store.OpenVault(method)
+		if err != nil {
+			t.Fatalf("failed to open %s vault: %v", method, err)
+		}
+		if v2.Vars["TEST"] != method {
+			t.Fatalf("expected: %s, got: %s", method, v2.Vars["TEST"])
+		}
+	}
+}
+
+func TestOpenVaultDetectsHeaderTampering(t *testing.T) {
+	setupVaults(t)
+	defer teardownVaults(t)
+
+	store := testStore()
+
+	for _, method := range []string{"xchacha20poly1305", "aes-256-gcm"} {
+		err := store.SealVaultWithOptions(&vaulted.Vault{}, method, "password", vaulted.SealOptions{Method: method})
+		if err != nil {
+			t.Fatalf("failed to seal %s vault: %v", method, err)
+		}
+
+		// alter the header without affecting the derived key
+		vf := readTestVaultFile(t, method)
+		vf.Key.Details.SetString("tampered", "true")
+		writeTestVaultFile(t, method, vf)
+
+		_, _, err = store.OpenVault(method)
+		if err != vaulted.ErrIncorrectPassword {
+			t.Fatalf("expected: %v, got: %v", vaulted.ErrIncorrectPassword, err)
+		}
+	}
+}
+
 func TestRemoveVault(t *testing.T) {
 	setupVaults(t)
 	defer teardownVaults(t)
@@ -118,6 +494,33 @@ func TestRemoveVault(t *testing.T) {
 	}
 }
 
+func readTestVaultFile(t *testing.T, name string) *vaulted.VaultFile {
+	content, err := ioutil.ReadFile(filepath.Join(string(xdg.DATA_HOME), "vaulted", name))
+	if err != nil {
+		t.Fatalf("failed to read '%s' vault file: %v", name, err)
+	}
+
+	vf := &vaulted.VaultFile{}
+	err = json.Unmarshal(content, vf)
+	if err != nil {
+		t.Fatalf("failed to parse '%s' vault file: %v", name, err)
+	}
+
+	return vf
+}
+
+func writeTestVaultFile(t *testing.T, name string, vf *vaulted.VaultFile) {
+	content, err := json.Marshal(vf)
+	if err != nil {
+		t.Fatalf("failed to marshal '%s' vault file: %v", name, err)
+	}
+
+	err = ioutil.WriteFile(filepath.Join(string(xdg.DATA_HOME), "vaulted", name), content, 0600)
+	if err != nil {
+		t.Fatalf("failed to write '%s' vault file: %v", name, err)
+	}
+}
+
 func setupVaults(t *testing.T) {
 	setupXDG(t)
 
@@ -220,3 +623,49 @@ func teardownXDG(t *testing.T) {
 	xdg.DATA = xdgBackup.DATA
 	xdg.CACHE_HOME = xdgBackup.CACHE_HOME
 }
+
+func TestNestedVaultNames(t *testing.T) {
+	root, err := ioutil.TempDir("", "vaulted")
+	if err != nil {
+		t.Fatalf("failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(root)
+
+	backend := vaulted.NewFileBackend(root)
+	store := vaulted.New(vaulted.NewStaticSteward("password"), backend)
+
+	vault := &vaulted.Vault{
+		Vars: map[string]string{"TEST": "TESTING"},
+	}
+	err = store.SealVault(vault, "prod/payments")
+	if err != nil {
+		t.Fatalf("failed to seal vault: %v", err)
+	}
+
+	opened, password, err := store.OpenVault("prod/payments")
+	if err != nil {
+		t.Fatalf("failed to open vault: %v", err)
+	}
+	_, err = store.GetSession(opened, "prod/payments", password)
+	if err != nil {
+		t.Fatalf("failed to get session: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(backend.CacheDir, "prod", "payments")); err != nil {
+		t.Fatalf("expected the session cache to be nested, got %v", err)
+	}
+
+	err = store.RemoveVault("prod/payments")
+	if err != nil {
+		t.Fatalf("failed to remove vault: %v", err)
+	}
+	for _, dir := range []string{backend.VaultDir, backend.HistoryDir, backend.CacheDir} {
+		if _, err := os.Stat(filepath.Join(dir, "prod")); !os.IsNotExist(err) {
+			t.Fatalf("expected %s to be removed, got %v", filepath.Join(dir, "prod"), err)
+		}
+	}
+
+	err = store.SealVault(vault, "../escape")
+	if err != vaulted.ErrInvalidVaultName {
+		t.Fatalf("expected %v, got %v", vaulted.ErrInvalidVaultName, err)
+	}
+}
@@ -0,0 +1,49 @@
+package vaulted
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"io"
+
+	"golang.org/x/crypto/hkdf"
+)
+
+const (
+	// HKDFKeySchedule derives the key a vault's content is encrypted with
+	// from its master key using HKDF-SHA256 (see VaultFile.KeySchedule).
+	HKDFKeySchedule = "hkdf-sha256"
+
+	vaultContentKeyInfo = "vaulted vault content"
+	sessionCacheKeyInfo = "vaulted session cache"
+)
+
+// subKey derives the key used for a single purpose (identified by info) from
+// a vault's master key. Sub-keys for different purposes are independent of
+// each other, so none of them reveal the master key or each other.
+func subKey(masterKey []byte, info string) ([]byte, error) {
+	key := make([]byte, encryptionKeySize)
+	_, err := io.ReadFull(hkdf.New(sha256.New, masterKey, nil, []byte(info)), key)
+	if err != nil {
+		return nil, err
+	}
+
+	return key, nil
+}
+
+// contentKey returns the key the vault file's content is encrypted with,
+// given its master key (the key derived from the password, unwrapped from a
+// key slot or unwrapped for a recipient). The content key is always a new
+// slice, so it is wiped without wiping the master key.
+func (vf *VaultFile) contentKey(masterKey []byte) ([]byte, error) {
+	switch vf.KeySchedule {
+	case "":
+		// vaults sealed before sub-keys were derived use the master key
+		return append([]byte{}, masterKey...), nil
+
+	case HKDFKeySchedule:
+		return subKey(masterKey, vaultContentKeyInfo)
+
+	default:
+		return nil, fmt.Errorf("Invalid key schedule: %s", vf.KeySchedule)
+	}
+}
@@ -0,0 +1,121 @@
+package vaulted
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestSubKeys(t *testing.T) {
+	backend := NewMemoryBackend()
+	s := New(NewStaticSteward("password"), backend).(*store)
+
+	err := s.SealVaultWithOptions(&Vault{}, "one", "password", SealOptions{Method: "xchacha20poly1305"})
+	if err != nil {
+		t.Fatalf("failed to seal vault: %v", err)
+	}
+
+	vf, err := readVaultFile(backend, "one")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if vf.KeySchedule != HKDFKeySchedule {
+		t.Fatalf("expected key schedule %s, got %q", HKDFKeySchedule, vf.KeySchedule)
+	}
+
+	masterKey, err := vf.Key.key("password", encryptionKeySize)
+	if err != nil {
+		t.Fatal(err)
+	}
+	contentKey, err := vf.contentKey(masterKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sessionKey, err := subKey(masterKey, sessionCacheKeyInfo)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Equal(masterKey, contentKey) || bytes.Equal(masterKey, sessionKey) || bytes.Equal(contentKey, sessionKey) {
+		t.Fatal("expected the sub-keys to differ from each other and the master key")
+	}
+
+	// the content is only encrypted with its sub-key
+	em, _ := lookupEncryptionMethod(vf.Method)
+	additionalData, _ := vf.associatedData()
+	_, err = openContent(em, masterKey, vf.Ciphertext, additionalData, vf.Details)
+	if err != ErrIncorrectPassword {
+		t.Fatalf("expected %v, got %v", ErrIncorrectPassword, err)
+	}
+	_, err = openContent(em, contentKey, vf.Ciphertext, additionalData, vf.Details)
+	if err != nil {
+		t.Fatalf("failed to open content: %v", err)
+	}
+
+	// as is the session cache
+	err = s.sealSessionCache(&SessionCache{}, "one", "password")
+	if err != nil {
+		t.Fatalf("failed to seal session cache: %v", err)
+	}
+
+	sf, err := readSessionFile(backend, "one")
+	if err != nil {
+		t.Fatal(err)
+	}
+	additionalData, _ = sf.associatedData()
+	_, err = openContent(em, contentKey, sf.Ciphertext, additionalData, sf.Details)
+	if err != ErrIncorrectPassword {
+		t.Fatalf("expected %v, got %v", ErrIncorrectPassword, err)
+	}
+	_, err = openContent(em, sessionKey, sf.Ciphertext, additionalData, sf.Details)
+	if err != nil {
+		t.Fatalf("failed to open session cache: %v", err)
+	}
+}
+
+func TestSessionCacheKeyReusesMasterKey(t *testing.T) {
+	backend := NewMemoryBackend()
+	s := New(NewStaticSteward("password"), backend).(*store)
+
+	err := s.SealVaultWithPassword(&Vault{}, "one", "password")
+	if err != nil {
+		t.Fatalf("failed to seal vault: %v", err)
+	}
+
+	// a new store has to derive the master key from the password
+	s = New(NewStaticSteward("password"), backend).(*store)
+	vf, err := readVaultFile(backend, "one")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	wrongKey, err := s.sessionCacheKey("one", vf, "wrong password")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, _, err = s.OpenVaultWithPassword("one", "password")
+	if err != nil {
+		t.Fatalf("failed to open vault: %v", err)
+	}
+
+	// once opened, the master key isn't derived again (so the password
+	// doesn't matter)
+	key, err := s.sessionCacheKey("one", vf, "wrong password")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Equal(wrongKey, key) {
+		t.Fatal("expected the opened master key to be used")
+	}
+
+	masterKey, err := vf.Key.key("password", encryptionKeySize)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected, err := subKey(masterKey, sessionCacheKeyInfo)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(expected, key) {
+		t.Fatalf("expected %x, got %x", expected, key)
+	}
+}
@@ -0,0 +1,449 @@
+package vaulted
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+const (
+	syncRemote = "origin"
+	syncBranch = "main"
+
+	syncIgnore = `# Managed by vaulted sync: only vault files are committed
+.*
+!.gitignore
+!.gitattributes
+`
+
+	// vault files are never merged line by line (which could produce a
+	// corrupted vault), so changes made to the same vault on both sides
+	// always conflict
+	syncAttributes = `# Managed by vaulted sync
+* -merge -diff -text
+`
+)
+
+var (
+	ErrSyncNotInitialized = errors.New("Vaults are not synced (see `vaulted sync init`)")
+	ErrSyncRemote         = errors.New("Sync remotes must be file:// or ssh remotes (e.g. file:///mnt/usb/vaults.git, ssh://host/vaults.git or host:vaults.git)")
+	ErrSyncBehind         = errors.New("Remote has changes that have not been pulled yet (run `vaulted sync pull` first)")
+
+	scpRemote = regexp.MustCompile(`^([^@/:]+@)?[^@/:]+:`)
+)
+
+// GitSync versions the vaults of a file backend in a git repository, to sync
+// them with a remote repository. Only vault files are ever committed (never
+// session caches, history or any other files), and changes are exchanged using
+// plain git commands, so any git remote reachable over file:// or ssh works.
+type GitSync struct {
+	// Dir is the directory the vaults are stored in (and the work tree of
+	// the repository)
+	Dir string
+
+	// Hostname is recorded in the commit messages
+	Hostname string
+}
+
+// SyncConflict describes a vault that was changed both locally and in the
+// remote repository. The local vault is kept, while the remote vault is
+// stored side by side as Copy.
+type SyncConflict struct {
+	Name string
+	Copy string
+}
+
+// NewGitSync returns a GitSync for the writable vaults of backend.
+func NewGitSync(backend *FileBackend) *GitSync {
+	hostname, _ := os.Hostname()
+	return &GitSync{
+		Dir:      backend.VaultDir,
+		Hostname: hostname,
+	}
+}
+
+// ValidateSyncRemote checks that remote is a file:// or ssh remote, returning
+// the remote to use (absolute paths are converted to file:// URLs).
+func ValidateSyncRemote(remote string) (string, error) {
+	if filepath.IsAbs(remote) {
+		return (&url.URL{Scheme: "file", Path: filepath.ToSlash(remote)}).String(), nil
+	}
+
+	if strings.Contains(remote, "://") {
+		u, err := url.Parse(remote)
+		if err == nil {
+			switch u.Scheme {
+			case "file", "ssh", "git+ssh", "ssh+git":
+				return remote, nil
+			}
+		}
+		return "", ErrSyncRemote
+	}
+
+	// scp-like ssh remotes (e.g. "git@host:vaults.git")
+	if scpRemote.MatchString(remote) {
+		return remote, nil
+	}
+
+	return "", ErrSyncRemote
+}
+
+// Initialized returns whether the vaults are already versioned.
+func (g *GitSync) Initialized() bool {
+	info, err := os.Stat(filepath.Join(g.Dir, ".git"))
+	return err == nil && info.IsDir()
+}
+
+// Init starts versioning the vaults, syncing them with remote. Vaults already
+// in the remote repository are pulled (see Pull) and the local vaults are
+// pushed to it.
+func (g *GitSync) Init(remote string) ([]SyncConflict, error) {
+	remote, err := ValidateSyncRemote(remote)
+	if err != nil {
+		return nil, err
+	}
+
+	err = os.MkdirAll(g.Dir, 0700)
+	if err != nil {
+		return nil, err
+	}
+
+	if !g.Initialized() {
+		_, err = g.git("init", "--quiet")
+		if err != nil {
+			return nil, err
+		}
+		_, err = g.git("symbolic-ref", "HEAD", "refs/heads/"+syncBranch)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for filename, content := range map[string]string{
+		".gitignore":     syncIgnore,
+		".gitattributes": syncAttributes,
+	} {
+		err = ioutil.WriteFile(filepath.Join(g.Dir, filename), []byte(content), 0600)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// commits are attributed to vaulted unless the user configured git
+	if _, err := g.git("config", "user.email"); err != nil {
+		_, err = g.git("config", "user.email", "vaulted@"+g.Hostname)
+		if err != nil {
+			return nil, err
+		}
+		_, err = g.git("config", "user.name", "vaulted")
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if _, err := g.git("remote", "get-url", syncRemote); err == nil {
+		_, err = g.git("remote", "set-url", syncRemote, remote)
+	} else {
+		_, err = g.git("remote", "add", syncRemote, remote)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	conflicts, err := g.Pull()
+	if err != nil {
+		return nil, err
+	}
+
+	return conflicts, g.Push()
+}
+
+// Push commits the local changes to the vaults and pushes them to the remote
+// repository. ErrSyncBehind is returned if the remote repository has changes
+// that haven't been pulled.
+func (g *GitSync) Push() error {
+	if !g.Initialized() {
+		return ErrSyncNotInitialized
+	}
+
+	err := g.commit()
+	if err != nil {
+		return err
+	}
+
+	remoteExists, err := g.fetch()
+	if err != nil {
+		return err
+	}
+	if remoteExists {
+		_, err = g.git("merge-base", "--is-ancestor", g.remoteRef(), "HEAD")
+		if err != nil {
+			return ErrSyncBehind
+		}
+	}
+
+	if !g.hasCommits() {
+		return nil
+	}
+
+	_, err = g.git("push", "--quiet", syncRemote, "HEAD:refs/heads/"+syncBranch)
+	return err
+}
+
+// Pull commits the local changes to the vaults and merges the changes from the
+// remote repository into them.
+//
+// A vault changed on both sides is not merged. Instead, the local vault is
+// kept and the remote vault is stored alongside it (named
+// "<name>.conflict-<commit>"), to be reconciled by hand.
+func (g *GitSync) Pull() ([]SyncConflict, error) {
+	if !g.Initialized() {
+		return nil, ErrSyncNotInitialized
+	}
+
+	err := g.commit()
+	if err != nil {
+		return nil, err
+	}
+
+	remoteExists, err := g.fetch()
+	if err != nil || !remoteExists {
+		return nil, err
+	}
+
+	_, mergeErr := g.git("merge", "--quiet", "--no-edit", "--allow-unrelated-histories", g.remoteRef())
+	if mergeErr == nil {
+		return nil, nil
+	}
+
+	conflicted, err := g.lines("diff", "--name-only", "--diff-filter=U")
+	if err != nil {
+		return nil, err
+	}
+	if len(conflicted) == 0 {
+		return nil, mergeErr
+	}
+
+	remoteCommit, err := g.git("rev-parse", "--short", g.remoteRef())
+	if err != nil {
+		return nil, err
+	}
+
+	var conflicts []SyncConflict
+	for _, path := range conflicted {
+		conflict, err := g.resolve(path, strings.TrimSpace(remoteCommit))
+		if err != nil {
+			g.git("merge", "--abort")
+			return nil, err
+		}
+		if conflict != nil {
+			conflicts = append(conflicts, *conflict)
+		}
+	}
+
+	_, err = g.git("commit", "--quiet", "--no-edit")
+	if err != nil {
+		return nil, err
+	}
+
+	return conflicts, nil
+}
+
+// resolve resolves a conflicted vault. If the vault was deleted on one side,
+// the changed vault is kept. Otherwise, the local vault is kept and the
+// remote vault is written side by side.
+func (g *GitSync) resolve(path, remoteCommit string) (*SyncConflict, error) {
+	local, localErr := g.git("show", ":2:"+path)
+	remote, remoteErr := g.git("show", ":3:"+path)
+
+	switch {
+	case localErr != nil && remoteErr != nil:
+		_, err := g.git("rm", "--quiet", "--cached", "--ignore-unmatch", "--", path)
+		return nil, err
+
+	case localErr != nil:
+		return nil, g.writeAndAdd(path, []byte(remote))
+
+	case remoteErr != nil:
+		return nil, g.writeAndAdd(path, []byte(local))
+	}
+
+	name := filepath.ToSlash(path)
+	copyName := fmt.Sprintf("%s.conflict-%s", name, remoteCommit)
+	for i := 2; fileExists(filepath.Join(g.Dir, filepath.FromSlash(copyName))); i++ {
+		copyName = fmt.Sprintf("%s.conflict-%s-%d", name, remoteCommit, i)
+	}
+
+	err := g.writeAndAdd(path, []byte(local))
+	if err != nil {
+		return nil, err
+	}
+	err = g.writeAndAdd(filepath.FromSlash(copyName), []byte(remote))
+	if err != nil {
+		return nil, err
+	}
+
+	return &SyncConflict{Name: name, Copy: copyName}, nil
+}
+
+func (g *GitSync) writeAndAdd(path string, data []byte) error {
+	filename := filepath.Join(g.Dir, path)
+	err := os.MkdirAll(filepath.Dir(filename), 0700)
+	if err != nil {
+		return err
+	}
+
+	err = ioutil.WriteFile(filename, data, 0600)
+	if err != nil {
+		return err
+	}
+
+	_, err = g.git("add", "--", path)
+	return err
+}
+
+// commit commits the changes to the vaults (if there are any). Only valid
+// vault files are staged; anything else in the directory is left untracked
+// (and files that stop being valid vaults are untracked).
+func (g *GitSync) commit() error {
+	names, err := (&FileBackend{VaultDir: g.Dir}).List(VaultBlob)
+	if err != nil {
+		return err
+	}
+
+	vaults := map[string]bool{}
+	for _, name := range names {
+		if isSyncableVaultFile(filepath.Join(g.Dir, filepath.FromSlash(name))) {
+			vaults[name] = true
+		}
+	}
+
+	tracked, err := g.lines("ls-files", "-z")
+	if err != nil {
+		return err
+	}
+	for _, path := range tracked {
+		if path == ".gitignore" || path == ".gitattributes" || vaults[path] {
+			continue
+		}
+		_, err = g.git("rm", "--quiet", "--cached", "--ignore-unmatch", "--", path)
+		if err != nil {
+			return err
+		}
+	}
+
+	paths := []string{".gitignore", ".gitattributes"}
+	for name := range vaults {
+		paths = append(paths, filepath.FromSlash(name))
+	}
+	sort.Strings(paths[2:])
+	_, err = g.git(append([]string{"add", "--force", "--"}, paths...)...)
+	if err != nil {
+		return err
+	}
+
+	if _, err := g.git("diff", "--cached", "--quiet"); err == nil && g.hasCommits() {
+		return nil
+	}
+
+	_, err = g.git("commit", "--quiet", "--allow-empty", "-m", fmt.Sprintf("Sync vaults from %s", g.Hostname))
+	return err
+}
+
+// fetch fetches the remote repository, returning whether it has any commits.
+func (g *GitSync) fetch() (bool, error) {
+	heads, err := g.git("ls-remote", "--heads", syncRemote, syncBranch)
+	if err != nil {
+		return false, err
+	}
+	if strings.TrimSpace(heads) == "" {
+		return false, nil
+	}
+
+	_, err = g.git("fetch", "--quiet", syncRemote, syncBranch)
+	return err == nil, err
+}
+
+func (g *GitSync) remoteRef() string {
+	return "refs/remotes/" + syncRemote + "/" + syncBranch
+}
+
+func (g *GitSync) hasCommits() bool {
+	_, err := g.git("rev-parse", "--verify", "--quiet", "HEAD")
+	return err == nil
+}
+
+func (g *GitSync) lines(args ...string) ([]string, error) {
+	output, err := g.git(args...)
+	if err != nil {
+		return nil, err
+	}
+
+	separator := "\n"
+	for _, arg := range args {
+		if arg == "-z" {
+			separator = "\x00"
+		}
+	}
+
+	var lines []string
+	for _, line := range strings.Split(output, separator) {
+		if line != "" {
+			lines = append(lines, line)
+		}
+	}
+	return lines, nil
+}
+
+// git runs a git command in the repository, returning its output. Errors
+// include what git wrote to stderr.
+func (g *GitSync) git(args ...string) (string, error) {
+	var stdout, stderr bytes.Buffer
+	cmd := exec.Command("git", append([]string{"-C", g.Dir}, args...)...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	cmd.Env = append(os.Environ(), "GIT_TERMINAL_PROMPT=0")
+
+	err := cmd.Run()
+	if err != nil {
+		message := strings.TrimSpace(stderr.String())
+		if message == "" {
+			return "", err
+		}
+		return "", fmt.Errorf("git %s: %s", args[0], message)
+	}
+
+	return stdout.String(), nil
+}
+
+// isSyncableVaultFile returns whether filename is an encrypted vault file
+// (and not, for example, a session cache or a file that isn't a vault).
+func isSyncableVaultFile(filename string) bool {
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return false
+	}
+
+	vf := VaultFile{}
+	err = json.Unmarshal(data, &vf)
+	if err != nil {
+		return false
+	}
+
+	hasKey := vf.Key != nil || len(vf.Recipients) > 0 || len(vf.Slots) > 0
+	return hasKey && vf.Method != "" && len(vf.Ciphertext) > 0
+}
+
+func fileExists(filename string) bool {
+	_, err := os.Stat(filename)
+	return err == nil
+}
@@ -0,0 +1,185 @@
+package vaulted_test
+
+import (
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/miquella/vaulted/lib"
+)
+
+func newSyncedStore(t *testing.T, root, name string) (vaulted.Store, *vaulted.GitSync) {
+	backend := vaulted.NewFileBackend(filepath.Join(root, name))
+	store := vaulted.New(vaulted.NewStaticSteward("password"), backend)
+
+	sync := vaulted.NewGitSync(backend)
+	sync.Hostname = name
+	return store, sync
+}
+
+func TestGitSync(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not installed")
+	}
+
+	root, err := ioutil.TempDir("", "vaulted")
+	if err != nil {
+		t.Fatalf("failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(root)
+
+	remote := filepath.Join(root, "remote.git")
+	err = exec.Command("git", "init", "--quiet", "--bare", remote).Run()
+	if err != nil {
+		t.Fatalf("failed to create remote: %v", err)
+	}
+
+	laptop, laptopSync := newSyncedStore(t, root, "laptop")
+	desktop, desktopSync := newSyncedStore(t, root, "desktop")
+
+	_, err = laptopSync.Pull()
+	if err != vaulted.ErrSyncNotInitialized {
+		t.Fatalf("expected %v, got %v", vaulted.ErrSyncNotInitialized, err)
+	}
+
+	err = laptop.SealVault(&vaulted.Vault{Vars: map[string]string{"A": "laptop"}}, "prod/one")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = laptopSync.Init(remote)
+	if err != nil {
+		t.Fatalf("failed to init sync: %v", err)
+	}
+
+	// the vault is pulled by another machine
+	_, err = desktopSync.Init("file://" + remote)
+	if err != nil {
+		t.Fatalf("failed to init sync: %v", err)
+	}
+	vault, _, err := desktop.OpenVault("prod/one")
+	if err != nil {
+		t.Fatalf("failed to open synced vault: %v", err)
+	}
+	if vault.Vars["A"] != "laptop" {
+		t.Fatalf("unexpected vault: %#v", vault)
+	}
+
+	// session caches and other files are never synced
+	err = ioutil.WriteFile(filepath.Join(desktopSync.Dir, "session"), []byte(`{"method":"secretbox","ciphertext":"AAAA"}`), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ioutil.WriteFile(filepath.Join(desktopSync.Dir, "notes.txt"), []byte("not a vault"), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = desktop.SealVault(&vaulted.Vault{}, "two")
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = desktopSync.Push()
+	if err != nil {
+		t.Fatalf("failed to push: %v", err)
+	}
+
+	files, err := exec.Command("git", "-C", remote, "ls-tree", "-r", "--name-only", "main").Output()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := ".gitattributes\n.gitignore\nprod/one\ntwo\n"
+	if string(files) != expected {
+		t.Fatalf("expected only vaults to be committed, got:\n%s", files)
+	}
+
+	// pushing with unpulled changes is refused
+	err = laptop.SealVault(&vaulted.Vault{}, "three")
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = laptopSync.Push()
+	if err != vaulted.ErrSyncBehind {
+		t.Fatalf("expected %v, got %v", vaulted.ErrSyncBehind, err)
+	}
+
+	conflicts, err := laptopSync.Pull()
+	if err != nil {
+		t.Fatalf("failed to pull: %v", err)
+	}
+	if len(conflicts) != 0 {
+		t.Fatalf("expected no conflicts, got %#v", conflicts)
+	}
+	if !laptop.VaultExists("two") || !laptop.VaultExists("three") {
+		t.Fatal("expected the vaults to be merged")
+	}
+	err = laptopSync.Push()
+	if err != nil {
+		t.Fatalf("failed to push: %v", err)
+	}
+
+	// changing the same vault on both machines keeps both versions
+	err = laptop.SealVault(&vaulted.Vault{Vars: map[string]string{"A": "laptop edit"}}, "prod/one")
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = laptopSync.Push()
+	if err != nil {
+		t.Fatalf("failed to push: %v", err)
+	}
+
+	err = desktop.SealVault(&vaulted.Vault{Vars: map[string]string{"A": "desktop edit"}}, "prod/one")
+	if err != nil {
+		t.Fatal(err)
+	}
+	conflicts, err = desktopSync.Pull()
+	if err != nil {
+		t.Fatalf("failed to pull: %v", err)
+	}
+	if len(conflicts) != 1 || conflicts[0].Name != "prod/one" {
+		t.Fatalf("expected a conflict for prod/one, got %#v", conflicts)
+	}
+
+	vault, _, err = desktop.OpenVault("prod/one")
+	if err != nil {
+		t.Fatalf("failed to open local vault: %v", err)
+	}
+	if vault.Vars["A"] != "desktop edit" {
+		t.Fatalf("expected the local vault to be kept, got %#v", vault)
+	}
+	vault, _, err = desktop.OpenVault(conflicts[0].Copy)
+	if err != nil {
+		t.Fatalf("failed to open conflicting vault: %v", err)
+	}
+	if vault.Vars["A"] != "laptop edit" {
+		t.Fatalf("expected the remote vault to be kept side by side, got %#v", vault)
+	}
+
+	err = desktopSync.Push()
+	if err != nil {
+		t.Fatalf("failed to push: %v", err)
+	}
+}
+
+func TestValidateSyncRemote(t *testing.T) {
+	valid := map[string]string{
+		"/mnt/usb/vaults.git":              "file:///mnt/usb/vaults.git",
+		"file:///mnt/usb/vaults.git":       "file:///mnt/usb/vaults.git",
+		"ssh://git@example.com/vaults.git": "ssh://git@example.com/vaults.git",
+		"git@example.com:vaults.git":       "git@example.com:vaults.git",
+		"example.com:vaults.git":           "example.com:vaults.git",
+	}
+	for remote, expected := range valid {
+		actual, err := vaulted.ValidateSyncRemote(remote)
+		if err != nil || actual != expected {
+			t.Errorf("expected %q for %q, got %q (%v)", expected, remote, actual, err)
+		}
+	}
+
+	for _, remote := range []string{"https://example.com/vaults.git", "vaults.git", ""} {
+		_, err := vaulted.ValidateSyncRemote(remote)
+		if err != vaulted.ErrSyncRemote {
+			t.Errorf("expected %v for %q, got %v", vaulted.ErrSyncRemote, remote, err)
+		}
+	}
+}
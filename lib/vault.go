@@ -21,6 +21,12 @@ type Vault struct {
 	AWSKey   *AWSKey           `json:"aws_key,omitempty"`
 	Vars     map[string]string `json:"vars,omitempty"`
 	SSHKeys  map[string]string `json:"ssh_keys,omitempty"`
+
+	// Includes names other vaults whose vars and SSH keys (and AWS key, if
+	// IncludeAWSKey is set) are merged into the vault's sessions (see
+	// Store.ResolveIncludes).
+	Includes      []string `json:"includes,omitempty"`
+	IncludeAWSKey bool     `json:"include_aws_key,omitempty"`
 }
 
 func (v *Vault) NewSession(name string) (*Session, error) {
@@ -7,41 +7,146 @@ import (
 	"encoding/json"
 	"fmt"
 	"math/big"
-	"os"
-	"path/filepath"
 
-	"github.com/miquella/xdg"
+	"golang.org/x/crypto/argon2"
 	"golang.org/x/crypto/pbkdf2"
 )
 
 const (
+	DefaultKeyMethod = "argon2id"
+
 	BaseIterations          = 1 << 17
 	AdditionIterationsRange = 1 << 18
+
+	Argon2Time        = 3
+	Argon2Memory      = 64 * 1024
+	Argon2Parallelism = 4
+
+	// maxArgon2Memory bounds the memory (in KiB) argon2id is allowed to use.
+	// The memory isn't calibrated (see Argon2Memory), so this only refuses
+	// tampered vault files before the key is derived.
+	maxArgon2Memory = 16 * Argon2Memory
 )
 
 type VaultFile struct {
+	// FormatVersion is the format version of the file (see
+	// CurrentFormatVersion). Older files are migrated when they are read, or
+	// when they are opened for migrations that must re-encrypt the content.
+	// The version is not part of the associated data, so it can't be trusted
+	// and every migration must be safe to run on a file already migrated.
+	FormatVersion int `json:"format_version,omitempty"`
+
 	Key *VaultKey `json:"key"`
 
+	// Revision is incremented each time the vault is sealed. It is used to
+	// detect changes made to the vault since it was opened.
+	Revision int `json:"revision,omitempty"`
+
+	// Recipients hold the vault's data key wrapped for each recipient (when
+	// the vault is sealed for recipients instead of a password).
+	Recipients []*RecipientKey `json:"recipients,omitempty"`
+
+	// Slots hold the vault's master key wrapped by each of its passwords
+	// (when the vault uses key slots). Slots are not part of the associated
+	// data, so they can be added and removed without re-encrypting the
+	// content; each slot is authenticated by its own wrapping instead.
+	Slots []*KeySlot `json:"slots,omitempty"`
+
+	// Recovery holds the vault's master key wrapped for its recovery key
+	// (when the vault has one, see Recovery).
+	Recovery *Recovery `json:"recovery,omitempty"`
+
+	// Metadata describes the vault (see VaultMetadata). PendingMetadata holds
+	// changes to its description, tags and team made without opening the
+	// vault, which are discarded when the vault is sealed again (unless they
+	// are confirmed, see SealOptions.Metadata).
+	Metadata        *VaultMetadata `json:"metadata,omitempty"`
+	PendingMetadata *VaultMetadata `json:"pending_metadata,omitempty"`
+
+	// Audit is the key the vault's audit log is encrypted with (see
+	// AuditKey).
+	Audit *AuditKey `json:"audit,omitempty"`
+
+	// KeySchedule is how the key the content is encrypted with is derived
+	// from the vault's master key (see HKDFKeySchedule). Vaults sealed before
+	// sub-keys were derived have none, using the master key itself.
+	KeySchedule string `json:"key_schedule,omitempty"`
+
 	Method     string  `json:"method"`
 	Details    Details `json:"details,omitempty"`
 	Ciphertext []byte  `json:"ciphertext"`
 }
 
-func readVaultFile(name string) (*VaultFile, error) {
-	existing := xdg.DATA.Find(filepath.Join("vaulted", name))
-	if len(existing) == 0 {
-		return nil, os.ErrNotExist
+// associatedData returns the unencrypted header fields of the vault file.
+//
+// Encryption methods that support additional data authenticate these fields
+// along with the ciphertext, so any tampering with them is detected when the
+// vault is opened.
+func (vf *VaultFile) associatedData() ([]byte, error) {
+	return json.Marshal(struct {
+		Key         *VaultKey       `json:"key"`
+		Revision    int             `json:"revision,omitempty"`
+		Recipients  []*RecipientKey `json:"recipients,omitempty"`
+		Recovery    *Recovery       `json:"recovery,omitempty"`
+		Metadata    *VaultMetadata  `json:"metadata,omitempty"`
+		Audit       *AuditKey       `json:"audit,omitempty"`
+		KeySchedule string          `json:"key_schedule,omitempty"`
+		Method      string          `json:"method"`
+	}{
+		Key:         vf.Key,
+		Revision:    vf.Revision,
+		Recipients:  vf.Recipients,
+		Recovery:    vf.Recovery,
+		Metadata:    vf.Metadata.authenticated(),
+		Audit:       vf.Audit,
+		KeySchedule: vf.KeySchedule,
+		Method:      vf.Method,
+	})
+}
+
+// encrypt encrypts a vault's content into the vault file, with the content key
+// derived from the vault's master key.
+func (vf *VaultFile) encrypt(vault *Vault, masterKey []byte) error {
+	em, err := lookupEncryptionMethod(vf.Method)
+	if err != nil {
+		return err
+	}
+
+	key, err := vf.contentKey(masterKey)
+	if err != nil {
+		return err
+	}
+	defer zero(key)
+
+	// marshal the vault content
+	content, err := json.Marshal(vault)
+	if err != nil {
+		return err
 	}
+	defer zero(content)
 
-	f, err := os.Open(existing[0])
+	additionalData, err := vf.associatedData()
+	if err != nil {
+		return err
+	}
+
+	vf.Ciphertext, err = sealContent(em, key, content, additionalData, vf.Details)
+	return err
+}
+
+func readVaultFile(backend Backend, name string) (*VaultFile, error) {
+	data, err := backend.Get(VaultBlob, name)
 	if err != nil {
 		return nil, err
 	}
-	defer f.Close()
 
-	d := json.NewDecoder(f)
 	vf := VaultFile{}
-	err = d.Decode(&vf)
+	err = json.Unmarshal(data, &vf)
+	if err != nil {
+		return nil, err
+	}
+
+	err = migrateVaultFile(&vf)
 	if err != nil {
 		return nil, err
 	}
@@ -49,29 +154,26 @@ func readVaultFile(name string) (*VaultFile, error) {
 	return &vf, nil
 }
 
-func writeVaultFile(name string, vaultFile *VaultFile) error {
-	pathname := xdg.DATA_HOME.Join("vaulted")
-	err := os.MkdirAll(pathname, 0700)
+func writeVaultFile(backend Backend, name string, vaultFile *VaultFile) error {
+	err := updateVaultFile(backend, name, vaultFile)
 	if err != nil {
 		return err
 	}
 
-	filename := xdg.DATA_HOME.Join(filepath.Join("vaulted", name))
-	f, err := os.OpenFile(filename, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
-	if err != nil {
-		return err
-	}
-	defer f.Close()
+	removeSessionCache(backend, name)
+
+	return nil
+}
 
-	e := json.NewEncoder(f)
-	err = e.Encode(vaultFile)
+// updateVaultFile writes a vault file whose key and content are unchanged
+// (e.g. only its metadata changed), so its session cache is kept.
+func updateVaultFile(backend Backend, name string, vaultFile *VaultFile) error {
+	data, err := json.Marshal(vaultFile)
 	if err != nil {
 		return err
 	}
 
-	removeSessionCache(name)
-
-	return nil
+	return backend.Put(VaultBlob, name, data)
 }
 
 type VaultKey struct {
@@ -88,21 +190,17 @@ func newVaultKey(previous *VaultKey) *VaultKey {
 		method = previous.Method
 		details = previous.Details.Clone()
 	} else {
-		method = "pbkdf2-sha512"
-		details = make(Details)
-
-		iterations := BaseIterations
-		r, err := rand.Int(rand.Reader, big.NewInt(AdditionIterationsRange))
-		if err == nil {
-			iterations += int(r.Int64())
+		defaultKey, err := calibratedVaultKey(DefaultKeyMethod, nil)
+		if err != nil {
+			return nil
 		}
-
-		details.SetInt("iterations", iterations)
+		method = defaultKey.Method
+		details = defaultKey.Details
 	}
 
 	// Generate new salt
 	switch method {
-	case "pbkdf2-sha512":
+	case "pbkdf2-sha512", "argon2id":
 		salt := make([]byte, 32)
 		_, err := rand.Read(salt)
 		if err != nil {
@@ -117,18 +215,91 @@ func newVaultKey(previous *VaultKey) *VaultKey {
 	}
 }
 
+// ValidateKeyMethod returns an error if method is not a supported key
+// derivation method.
+func ValidateKeyMethod(method string) error {
+	_, err := defaultVaultKey(method)
+	return err
+}
+
+func defaultVaultKey(method string) (*VaultKey, error) {
+	details := make(Details)
+
+	switch method {
+	case "pbkdf2-sha512":
+		iterations := BaseIterations
+		r, err := rand.Int(rand.Reader, big.NewInt(AdditionIterationsRange))
+		if err == nil {
+			iterations += int(r.Int64())
+		}
+
+		details.SetInt("iterations", iterations)
+
+	case "argon2id":
+		details.SetInt("time", Argon2Time)
+		details.SetInt("memory", Argon2Memory)
+		details.SetInt("parallelism", Argon2Parallelism)
+
+	default:
+		return nil, fmt.Errorf("Invalid key derivation method: %s", method)
+	}
+
+	return &VaultKey{
+		Method:  method,
+		Details: details,
+	}, nil
+}
+
 func (vk *VaultKey) key(password string, keyLength int) ([]byte, error) {
+	err := vk.validate()
+	if err != nil {
+		return nil, err
+	}
+
+	passwordBytes := []byte(password)
+	defer zero(passwordBytes)
+
+	salt := vk.Details.Bytes("salt")
+	switch vk.Method {
+	case "pbkdf2-sha512":
+		iterations := vk.Details.Int("iterations")
+		return pbkdf2.Key(passwordBytes, salt, iterations, keyLength, sha512.New), nil
+
+	default: // argon2id
+		time := vk.Details.Int("time")
+		memory := vk.Details.Int("memory")
+		parallelism := vk.Details.Int("parallelism")
+		return argon2.IDKey(passwordBytes, salt, uint32(time), uint32(memory), uint8(parallelism), uint32(keyLength)), nil
+	}
+}
+
+// validate returns an error if the key derivation method or its parameters
+// are invalid. The parameters are read from the vault file before it is
+// authenticated, so costs beyond those calibration chooses (see
+// maxCostFactor) are refused as well.
+func (vk *VaultKey) validate() error {
 	switch vk.Method {
 	case "pbkdf2-sha512":
 		iterations := vk.Details.Int("iterations")
 		salt := vk.Details.Bytes("salt")
-		if iterations == 0 || len(salt) == 0 {
-			return nil, ErrInvalidKeyConfig
+		if iterations <= 0 || iterations > maxCostFactor*BaseIterations || len(salt) == 0 {
+			return ErrInvalidKeyConfig
 		}
-		return pbkdf2.Key([]byte(password), salt, iterations, keyLength, sha512.New), nil
+
+	case "argon2id":
+		time := vk.Details.Int("time")
+		memory := vk.Details.Int("memory")
+		parallelism := vk.Details.Int("parallelism")
+		salt := vk.Details.Bytes("salt")
+		if time <= 0 || time > maxCostFactor*Argon2Time || memory <= 0 || memory > maxArgon2Memory || parallelism <= 0 || parallelism > 255 || len(salt) == 0 {
+			return ErrInvalidKeyConfig
+		}
+
+	default:
+		return fmt.Errorf("Invalid key derivation method: %s", vk.Method)
 	}
 
-	return nil, fmt.Errorf("Invalid key derivation method: %s", vk.Method)
+	return nil
 }
 
 type Details map[string]interface{}
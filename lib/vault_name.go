@@ -0,0 +1,28 @@
+package vaulted
+
+import (
+	"errors"
+	"strings"
+)
+
+var (
+	ErrInvalidVaultName = errors.New("Invalid vault name (names are made of slash-separated parts, which must not be empty or start with '.')")
+)
+
+// ValidateVaultName checks that a vault name is usable. Names may be
+// hierarchical (e.g. "prod/payments"), with each slash-separated part stored
+// as a directory, so parts must not be empty or start with "." (which also
+// rules out path traversal using "..").
+func ValidateVaultName(name string) error {
+	if name == "" || strings.ContainsAny(name, "\\\x00") {
+		return ErrInvalidVaultName
+	}
+
+	for _, part := range strings.Split(name, "/") {
+		if part == "" || strings.HasPrefix(part, ".") {
+			return ErrInvalidVaultName
+		}
+	}
+
+	return nil
+}
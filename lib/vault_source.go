@@ -0,0 +1,65 @@
+package vaulted
+
+import (
+	"errors"
+	"os"
+)
+
+// VaultSource describes where a vault was found.
+type VaultSource string
+
+const (
+	// UserVault is a vault in the (writable) vault directory.
+	UserVault VaultSource = "user"
+
+	// SystemVault is a vault only found in a read-only vault directory (e.g.
+	// distributed in $XDG_DATA_DIRS). System vaults are never modified, but
+	// may be forked (see SealOptions.Fork).
+	SystemVault VaultSource = "system"
+
+	// ShadowingVault is a vault in the vault directory that shadows a system
+	// vault of the same name (e.g. a forked system vault).
+	ShadowingVault VaultSource = "shadowing"
+)
+
+var (
+	ErrReadOnlyVault = errors.New("Vault is a read-only system vault (use --fork to save a copy to your own vaults instead)")
+)
+
+// VaultSource returns where a vault was found. Vaults in backends that don't
+// locate their blobs (see BackendLocator) are always UserVault.
+func (s *store) VaultSource(name string) (VaultSource, error) {
+	locator, ok := s.backend.(BackendLocator)
+	if !ok {
+		if !s.VaultExists(name) {
+			return "", os.ErrNotExist
+		}
+		return UserVault, nil
+	}
+
+	locations, err := locator.Locate(VaultBlob, name)
+	if err != nil {
+		return "", err
+	}
+
+	switch {
+	case len(locations) == 0:
+		return "", os.ErrNotExist
+	case locations[0].ReadOnly:
+		return SystemVault, nil
+	case len(locations) > 1:
+		return ShadowingVault, nil
+	default:
+		return UserVault, nil
+	}
+}
+
+// checkWritable returns ErrReadOnlyVault if the vault is a system vault
+// (writing it would silently shadow the system vault instead).
+func (s *store) checkWritable(name string) error {
+	source, err := s.VaultSource(name)
+	if err == nil && source == SystemVault {
+		return ErrReadOnlyVault
+	}
+	return nil
+}
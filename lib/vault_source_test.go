@@ -0,0 +1,93 @@
+package vaulted_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/miquella/vaulted/lib"
+)
+
+func TestSystemVaults(t *testing.T) {
+	root, err := ioutil.TempDir("", "vaulted")
+	if err != nil {
+		t.Fatalf("failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(root)
+
+	system := vaulted.New(vaulted.NewStaticSteward("password"), vaulted.NewFileBackend(filepath.Join(root, "system")))
+	err = system.SealVault(&vaulted.Vault{Vars: map[string]string{"ORG": "system"}}, "org")
+	if err != nil {
+		t.Fatalf("failed to seal vault: %v", err)
+	}
+	systemFile := filepath.Join(root, "system", "vaults", "org")
+	systemData, err := ioutil.ReadFile(systemFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	backend := vaulted.NewFileBackend(filepath.Join(root, "user"), filepath.Join(root, "system"))
+	store := vaulted.New(vaulted.NewStaticSteward("password"), backend)
+
+	source, err := store.VaultSource("org")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if source != vaulted.SystemVault {
+		t.Fatalf("expected %s, got %s", vaulted.SystemVault, source)
+	}
+
+	// using a system vault doesn't write a copy of it
+	vault, _, err := store.UnlockVault("org")
+	if err != nil {
+		t.Fatalf("failed to unlock vault: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(backend.VaultDir, "org")); !os.IsNotExist(err) {
+		t.Fatalf("expected no user copy of the vault, got %v", err)
+	}
+
+	// nor does modifying it
+	err = store.SealVault(vault, "org")
+	if err != vaulted.ErrReadOnlyVault {
+		t.Fatalf("expected %v, got %v", vaulted.ErrReadOnlyVault, err)
+	}
+	err = store.SetVaultMetadata("org", &vaulted.VaultMetadata{Description: "changed"})
+	if err != vaulted.ErrReadOnlyVault {
+		t.Fatalf("expected %v, got %v", vaulted.ErrReadOnlyVault, err)
+	}
+	_, err = store.AddKeySlot("org", "password", "another password", "")
+	if err != vaulted.ErrReadOnlyVault {
+		t.Fatalf("expected %v, got %v", vaulted.ErrReadOnlyVault, err)
+	}
+
+	// unless it's forked
+	vault.Vars["ORG"] = "forked"
+	err = store.SealVaultWithOptions(vault, "org", "password", vaulted.SealOptions{Fork: true})
+	if err != nil {
+		t.Fatalf("failed to fork vault: %v", err)
+	}
+
+	source, err = store.VaultSource("org")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if source != vaulted.ShadowingVault {
+		t.Fatalf("expected %s, got %s", vaulted.ShadowingVault, source)
+	}
+
+	opened, _, err := store.OpenVault("org")
+	if err != nil {
+		t.Fatalf("failed to open vault: %v", err)
+	}
+	if opened.Vars["ORG"] != "forked" {
+		t.Fatalf("expected the forked vault, got %v", opened.Vars)
+	}
+	assertFileContent(t, systemFile, string(systemData))
+
+	// forks are regular user vaults
+	err = store.SealVault(opened, "org")
+	if err != nil {
+		t.Fatalf("failed to seal vault: %v", err)
+	}
+}
@@ -0,0 +1,253 @@
+package vaulted
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+const (
+	VerifyOK      = "ok"
+	VerifyWarning = "warning"
+	VerifyError   = "error"
+)
+
+// VaultReport describes the health of a vault (see Store.VerifyVault).
+//
+// Errors are problems preventing the vault from being opened, while warnings
+// are weaknesses in how the vault is stored.
+type VaultReport struct {
+	Name   string `json:"name"`
+	Status string `json:"status"`
+
+	// Location is the file the vault is stored in, and Shadowed are the files
+	// of vaults with the same name that it hides (when the backend stores
+	// vaults in files)
+	Location string   `json:"location,omitempty"`
+	Mode     string   `json:"mode,omitempty"`
+	Shadowed []string `json:"shadowed,omitempty"`
+
+	FormatVersion int            `json:"format_version,omitempty"`
+	Revision      int            `json:"revision,omitempty"`
+	KeyMethod     string         `json:"key_method,omitempty"`
+	KeyParameters map[string]int `json:"key_parameters,omitempty"`
+	KeySlots      int            `json:"key_slots,omitempty"`
+	Recipients    int            `json:"recipients,omitempty"`
+	Method        string         `json:"method,omitempty"`
+
+	// Opened is set when the vault was opened to verify it can be decrypted
+	Opened *bool `json:"opened,omitempty"`
+
+	Errors   []string `json:"errors,omitempty"`
+	Warnings []string `json:"warnings,omitempty"`
+}
+
+func (r *VaultReport) addError(format string, a ...interface{}) {
+	r.Errors = append(r.Errors, fmt.Sprintf(format, a...))
+}
+
+func (r *VaultReport) addWarning(format string, a ...interface{}) {
+	r.Warnings = append(r.Warnings, fmt.Sprintf(format, a...))
+}
+
+func (r *VaultReport) updateStatus() {
+	switch {
+	case len(r.Errors) > 0:
+		r.Status = VerifyError
+	case len(r.Warnings) > 0:
+		r.Status = VerifyWarning
+	default:
+		r.Status = VerifyOK
+	}
+}
+
+// VerifyVault checks the vault's file without opening it. Problems found are
+// described by the report, an error is only returned if the vault can't be
+// read at all.
+func (s *store) VerifyVault(name string) (*VaultReport, error) {
+	report, _, err := s.verifyVault(name)
+	return report, err
+}
+
+// VerifyVaultWithPassword checks the vault's file (like VerifyVault) and that
+// it can be opened with the password. Vaults sealed for recipients are opened
+// using the steward's identity instead.
+func (s *store) VerifyVaultWithPassword(name, password string) (*VaultReport, error) {
+	report, vf, err := s.verifyVault(name)
+	if err != nil || len(report.Errors) > 0 {
+		return report, err
+	}
+
+	_, err = s.openVaultFile(name, vf, password)
+	opened := err == nil
+	report.Opened = &opened
+	if err != nil {
+		report.addError("Unable to open the vault: %v", err)
+	}
+
+	report.updateStatus()
+	return report, nil
+}
+
+func (s *store) verifyVault(name string) (*VaultReport, *VaultFile, error) {
+	data, err := s.backend.Get(VaultBlob, name)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	report := &VaultReport{Name: name}
+	defer report.updateStatus()
+
+	if locator, ok := s.backend.(BackendLocator); ok {
+		locations, err := locator.Locate(VaultBlob, name)
+		if err != nil {
+			return nil, nil, err
+		}
+		verifyLocations(report, locations)
+	}
+
+	vf := &VaultFile{}
+	err = json.Unmarshal(data, vf)
+	if err != nil {
+		report.addError("Invalid vault file: %v", err)
+		return report, nil, nil
+	}
+
+	report.FormatVersion = vf.FormatVersion
+	if vf.FormatVersion < CurrentFormatVersion {
+		report.addWarning("the vault file uses format version %d (see `vaulted upgrade --all`)", vf.FormatVersion)
+	}
+	err = migrateVaultFile(vf)
+	if err != nil {
+		report.addError("%v", err)
+		return report, nil, nil
+	}
+
+	report.Revision = vf.Revision
+	report.Method = vf.Method
+	verifyEncryptionMethod(report, vf)
+
+	if vf.PendingMetadata != nil {
+		report.addWarning("metadata was changed without opening the vault (it is discarded when the vault is sealed again, unless kept when editing it)")
+	}
+
+	if vf.Key == nil {
+		report.addError("%v: missing key", ErrInvalidKeyConfig)
+		return report, vf, nil
+	}
+	report.KeyMethod = vf.Key.Method
+
+	switch vf.Key.Method {
+	case RecipientKeyMethod:
+		report.Recipients = len(vf.Recipients)
+		if len(vf.Recipients) == 0 {
+			report.addError("%v: %v", ErrInvalidKeyConfig, ErrNoRecipients)
+		}
+		for _, recipientKey := range vf.Recipients {
+			if len(recipientKey.PublicKey) != 32 || len(recipientKey.EphemeralKey) != 32 || len(recipientKey.WrappedKey) == 0 {
+				report.addError("%v: recipient %s", ErrInvalidKeyConfig, FormatPublicKey(recipientKey.PublicKey))
+			}
+		}
+
+	case KeySlotsKeyMethod:
+		report.KeySlots = len(vf.Slots)
+		if len(vf.Slots) == 0 {
+			report.addError("%v: no key slots", ErrInvalidKeyConfig)
+		}
+		for _, slot := range vf.Slots {
+			if slot.Key == nil || len(slot.Nonce) != 24 || len(slot.WrappedKey) == 0 {
+				report.addError("%v: key slot %d", ErrInvalidKeyConfig, slot.ID)
+				continue
+			}
+			verifyVaultKey(report, slot.Key, fmt.Sprintf("key slot %d: ", slot.ID))
+		}
+
+	default:
+		if verifyVaultKey(report, vf.Key, "") {
+			report.KeyParameters = keyParameters(vf.Key)
+		}
+	}
+
+	return report, vf, nil
+}
+
+func verifyLocations(report *VaultReport, locations []BlobLocation) {
+	if len(locations) == 0 {
+		return
+	}
+
+	location := locations[0]
+	report.Location = location.Path
+	report.Mode = fmt.Sprintf("%04o", location.Mode.Perm())
+
+	if !location.ReadOnly && location.Mode.Perm()&0077 != 0 {
+		report.addWarning("%s is accessible by other users (mode %04o)", location.Path, location.Mode.Perm())
+	}
+	if location.ReadOnly && location.Mode.Perm()&0022 != 0 {
+		report.addWarning("%s is writable by other users (mode %04o)", location.Path, location.Mode.Perm())
+	}
+
+	for _, shadowed := range locations[1:] {
+		report.Shadowed = append(report.Shadowed, shadowed.Path)
+		report.addWarning("%s is shadowed by %s", shadowed.Path, location.Path)
+	}
+}
+
+func verifyEncryptionMethod(report *VaultReport, vf *VaultFile) {
+	em, err := lookupEncryptionMethod(vf.Method)
+	if err != nil {
+		report.addError("%v: %v", ErrInvalidEncryptionConfig, err)
+		return
+	}
+
+	if vf.Method == "secretbox" {
+		report.addWarning("secretbox doesn't authenticate the vault's metadata, recipients and recovery key (see `vaulted passwd --cipher`)")
+	}
+
+	nonce := vf.Details.Bytes("nonce")
+	if len(nonce) == 0 || len(nonce) > em.nonceSize() {
+		report.addError("%v: invalid nonce", ErrInvalidEncryptionConfig)
+	}
+
+	if _, err := vf.contentKey(make([]byte, encryptionKeySize)); err != nil {
+		report.addError("%v: %v", ErrInvalidEncryptionConfig, err)
+	}
+}
+
+// verifyVaultKey reports problems with a key derivation method (prefixed by
+// prefix), returning whether the key is valid.
+func verifyVaultKey(report *VaultReport, vk *VaultKey, prefix string) bool {
+	err := vk.validate()
+	if err != nil {
+		if err != ErrInvalidKeyConfig {
+			err = fmt.Errorf("%v: %v", ErrInvalidKeyConfig, err)
+		}
+		report.addError("%s%v", prefix, err)
+		return false
+	}
+
+	switch vk.Method {
+	case "pbkdf2-sha512":
+		report.addWarning("%spbkdf2-sha512 is less resistant to cracking than %s (see `vaulted passwd --kdf`)", prefix, DefaultKeyMethod)
+		if vk.Details.Int("iterations") < BaseIterations {
+			report.addWarning("%spbkdf2-sha512 uses only %d iterations", prefix, vk.Details.Int("iterations"))
+		}
+
+	case "argon2id":
+		if vk.Details.Int("time") < Argon2Time || vk.Details.Int("memory") < Argon2Memory {
+			report.addWarning("%sargon2id parameters are weaker than the defaults", prefix)
+		}
+	}
+
+	return true
+}
+
+// keyParameters returns the cost parameters of a key derivation method.
+func keyParameters(vk *VaultKey) map[string]int {
+	parameters := make(map[string]int)
+	for _, name := range []string{"iterations", "time", "memory", "parallelism"} {
+		if _, ok := vk.Details[name]; ok {
+			parameters[name] = vk.Details.Int(name)
+		}
+	}
+	return parameters
+}
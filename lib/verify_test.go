@@ -0,0 +1,149 @@
+package vaulted_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/miquella/vaulted/lib"
+)
+
+func TestVerifyVault(t *testing.T) {
+	root, err := ioutil.TempDir("", "vaulted")
+	if err != nil {
+		t.Fatalf("failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(root)
+
+	backend := vaulted.NewFileBackend(root)
+	store := vaulted.New(vaulted.NewStaticSteward("password"), backend)
+
+	err = store.SealVaultWithOptions(&vaulted.Vault{}, "healthy", "password", vaulted.SealOptions{
+		Method: "xchacha20poly1305",
+	})
+	if err != nil {
+		t.Fatalf("failed to seal vault: %v", err)
+	}
+
+	report, err := store.VerifyVault("healthy")
+	if err != nil {
+		t.Fatalf("failed to verify vault: %v", err)
+	}
+	expected := &vaulted.VaultReport{
+		Name:          "healthy",
+		Status:        vaulted.VerifyOK,
+		Location:      filepath.Join(backend.VaultDir, "healthy"),
+		Mode:          "0600",
+		FormatVersion: vaulted.CurrentFormatVersion,
+		Revision:      1,
+		KeyMethod:     "argon2id",
+		KeyParameters: map[string]int{
+			"time":        vaulted.Argon2Time,
+			"memory":      vaulted.Argon2Memory,
+			"parallelism": vaulted.Argon2Parallelism,
+		},
+		Method: "xchacha20poly1305",
+	}
+	if !reflect.DeepEqual(expected, report) {
+		t.Fatalf("expected %#v, got %#v", expected, report)
+	}
+
+	report, err = store.VerifyVaultWithPassword("healthy", "password")
+	if err != nil {
+		t.Fatalf("failed to verify vault: %v", err)
+	}
+	if report.Status != vaulted.VerifyOK || report.Opened == nil || !*report.Opened {
+		t.Fatalf("expected the vault to be opened, got %#v", report)
+	}
+
+	report, err = store.VerifyVaultWithPassword("healthy", "wrong")
+	if err != nil {
+		t.Fatalf("failed to verify vault: %v", err)
+	}
+	if report.Status != vaulted.VerifyError || report.Opened == nil || *report.Opened {
+		t.Fatalf("expected the vault not to be opened, got %#v", report)
+	}
+
+	_, err = store.VerifyVault("missing")
+	if !os.IsNotExist(err) {
+		t.Fatalf("expected a missing vault error, got %v", err)
+	}
+}
+
+func TestVerifyVaultProblems(t *testing.T) {
+	root, err := ioutil.TempDir("", "vaulted")
+	if err != nil {
+		t.Fatalf("failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(root)
+
+	backend := vaulted.NewFileBackend(root)
+	backend.ReadOnlyVaultDirs = []string{filepath.Join(root, "system")}
+	store := vaulted.New(vaulted.NewStaticSteward("password"), backend)
+
+	err = store.SealVaultWithOptions(&vaulted.Vault{}, "weak", "password", vaulted.SealOptions{
+		KeyMethod: "pbkdf2-sha512",
+	})
+	if err != nil {
+		t.Fatalf("failed to seal vault: %v", err)
+	}
+
+	// readable by other users and shadowing a system vault
+	err = os.Chmod(filepath.Join(backend.VaultDir, "weak"), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	writeFile(t, filepath.Join(root, "system", "weak"), "{}")
+
+	writeFile(t, filepath.Join(backend.VaultDir, "corrupt"), "{not json")
+	writeFile(t, filepath.Join(backend.VaultDir, "badkey"), `{"key":{"method":"argon2id","details":{}},"method":"secretbox","details":{"nonce":"AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA"}}`)
+	writeFile(t, filepath.Join(backend.VaultDir, "badcipher"), `{"key":{"method":"argon2id","details":{"time":3,"memory":65536,"parallelism":4,"salt":"c2FsdA=="}},"method":"rot13"}`)
+	writeFile(t, filepath.Join(backend.VaultDir, "newer"), `{"format_version":1000,"key":{"method":"argon2id","details":{}},"method":"secretbox"}`)
+
+	report, err := store.VerifyVault("weak")
+	if err != nil {
+		t.Fatalf("failed to verify vault: %v", err)
+	}
+	if report.Status != vaulted.VerifyWarning {
+		t.Fatalf("expected a warning, got %#v", report)
+	}
+	if report.Mode != "0644" || !reflect.DeepEqual([]string{filepath.Join(root, "system", "weak")}, report.Shadowed) {
+		t.Fatalf("unexpected location details: %#v", report)
+	}
+	if len(report.Warnings) != 3 {
+		t.Fatalf("expected permission, shadowing and key derivation warnings, got %#v", report.Warnings)
+	}
+
+	for name, problem := range map[string]string{
+		"corrupt":   "Invalid vault file",
+		"badkey":    vaulted.ErrInvalidKeyConfig.Error(),
+		"badcipher": vaulted.ErrInvalidEncryptionConfig.Error(),
+		"newer":     vaulted.ErrUnsupportedFormatVersion.Error(),
+	} {
+		report, err := store.VerifyVaultWithPassword(name, "password")
+		if err != nil {
+			t.Fatalf("failed to verify vault: %v", err)
+		}
+		if report.Status != vaulted.VerifyError || report.Opened != nil {
+			t.Fatalf("expected %s to fail verification without being opened, got %#v", name, report)
+		}
+		if len(report.Errors) == 0 || !strings.HasPrefix(report.Errors[0], problem) {
+			t.Fatalf("expected %s to report %q, got %#v", name, problem, report.Errors)
+		}
+	}
+}
+
+func writeFile(t *testing.T, filename, content string) {
+	err := os.MkdirAll(filepath.Dir(filename), 0700)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = ioutil.WriteFile(filename, []byte(content), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -1,14 +1,30 @@
 package main
 
 import (
+	"encoding/json"
 	"fmt"
 	"sort"
+	"strings"
+	"time"
 
 	"github.com/miquella/vaulted/lib"
 )
 
 type List struct {
 	Active string
+	Prefix string
+
+	Tree bool
+	Long bool
+	Tags []string
+	JSON bool
+}
+
+type listEntry struct {
+	Name   string              `json:"name"`
+	Active bool                `json:"active,omitempty"`
+	Source vaulted.VaultSource `json:"source"`
+	*vaulted.VaultMetadata
 }
 
 func (l *List) Run(store vaulted.Store) error {
@@ -18,12 +34,154 @@ func (l *List) Run(store vaulted.Store) error {
 	}
 
 	sort.Strings(vaults)
+
+	entries := []*listEntry{}
 	for _, vault := range vaults {
-		if vault == l.Active {
-			vault = fmt.Sprintf("%s (active)", vault)
+		if !strings.HasPrefix(vault, l.Prefix) {
+			continue
+		}
+
+		entry := &listEntry{
+			Name:          vault,
+			Active:        vault == l.Active,
+			VaultMetadata: &vaulted.VaultMetadata{},
+		}
+
+		entry.Source, err = store.VaultSource(vault)
+		if err != nil {
+			return err
+		}
+
+		if l.Long || l.JSON || len(l.Tags) > 0 {
+			entry.VaultMetadata, err = store.VaultMetadata(vault)
+			if err != nil {
+				return err
+			}
+		}
+
+		if !hasTags(entry.VaultMetadata, l.Tags) {
+			continue
+		}
+
+		entries = append(entries, entry)
+	}
+
+	switch {
+	case l.JSON:
+		output, err := json.MarshalIndent(entries, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(output))
+
+	case l.Long:
+		printLongList(entries)
+
+	case l.Tree:
+		printTree(entries)
+
+	default:
+		for _, entry := range entries {
+			fmt.Println(listName(entry, entry.Name))
 		}
-		fmt.Println(vault)
 	}
 
 	return nil
 }
+
+func hasTags(metadata *vaulted.VaultMetadata, tags []string) bool {
+	for _, tag := range tags {
+		if !metadata.HasTag(tag) {
+			return false
+		}
+	}
+	return true
+}
+
+// printTree prints the vaults as a tree, with the parts of hierarchical names
+// (e.g. "prod/payments") shown as directories.
+func printTree(entries []*listEntry) {
+	var dirs []string
+	for _, entry := range entries {
+		parts := strings.Split(entry.Name, "/")
+		entryDirs := parts[:len(parts)-1]
+
+		// skip the directories shared with the previous vault
+		shared := 0
+		for shared < len(dirs) && shared < len(entryDirs) && dirs[shared] == entryDirs[shared] {
+			shared++
+		}
+		for i := shared; i < len(entryDirs); i++ {
+			fmt.Printf("%s%s/\n", strings.Repeat("  ", i), entryDirs[i])
+		}
+		dirs = entryDirs
+
+		fmt.Printf("%s%s\n", strings.Repeat("  ", len(entryDirs)), listName(entry, parts[len(parts)-1]))
+	}
+}
+
+func printLongList(entries []*listEntry) {
+	nameWidth := len("NAME")
+	teamWidth := len("TEAM")
+	tagsWidth := len("TAGS")
+	for _, entry := range entries {
+		if width := len(listName(entry, entry.Name)); width > nameWidth {
+			nameWidth = width
+		}
+		if width := len(listValue(entry.Team)); width > teamWidth {
+			teamWidth = width
+		}
+		if width := len(listValue(strings.Join(entry.Tags, ","))); width > tagsWidth {
+			tagsWidth = width
+		}
+	}
+
+	format := fmt.Sprintf("%%-%ds  %%-%ds  %%-%ds  %%-16s  %%-16s  %%s\n", nameWidth, teamWidth, tagsWidth)
+	fmt.Printf(format, "NAME", "TEAM", "TAGS", "MODIFIED", "LAST USED", "DESCRIPTION")
+	for _, entry := range entries {
+		description := entry.Description
+		if entry.Unverified {
+			description = strings.TrimSpace(fmt.Sprintf("%s (unverified)", description))
+		}
+
+		fmt.Printf(
+			format,
+			listName(entry, entry.Name),
+			listValue(entry.Team),
+			listValue(strings.Join(entry.Tags, ",")),
+			listTime(entry.Modified),
+			listTime(entry.LastUsed),
+			description,
+		)
+	}
+}
+
+// listName returns the name to list a vault by, marking whether it is active
+// and where it comes from.
+func listName(entry *listEntry, name string) string {
+	switch entry.Source {
+	case vaulted.SystemVault:
+		name = fmt.Sprintf("%s (system)", name)
+	case vaulted.ShadowingVault:
+		name = fmt.Sprintf("%s (shadows system vault)", name)
+	}
+
+	if entry.Active {
+		name = fmt.Sprintf("%s (active)", name)
+	}
+	return name
+}
+
+func listValue(value string) string {
+	if value == "" {
+		return "-"
+	}
+	return value
+}
+
+func listTime(t *time.Time) string {
+	if t == nil || t.IsZero() {
+		return "-"
+	}
+	return t.Local().Format("2006-01-02 15:04")
+}
@@ -2,7 +2,10 @@ package main
 
 import (
 	"bytes"
+	"encoding/json"
+	"reflect"
 	"testing"
+	"time"
 
 	"github.com/miquella/vaulted/lib"
 )
@@ -47,3 +50,182 @@ func TestListWithActive(t *testing.T) {
 		t.Fatalf("Expected:\n%s\nGot:\n%s", expected, output)
 	}
 }
+
+func TestListWithTags(t *testing.T) {
+	store := NewTestStore()
+	store.Vaults["one"] = &vaulted.Vault{}
+	store.Vaults["two"] = &vaulted.Vault{}
+	store.Vaults["three"] = &vaulted.Vault{}
+	store.Metadata["one"] = &vaulted.VaultMetadata{Tags: []string{"aws", "prod"}}
+	store.Metadata["two"] = &vaulted.VaultMetadata{Tags: []string{"aws"}}
+
+	output := CaptureStdout(func() {
+		l := List{
+			Tags: []string{"prod", "aws"},
+		}
+		err := l.Run(store)
+		if err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	expected := []byte("one\n")
+	if bytes.Compare(output, expected) != 0 {
+		t.Fatalf("Expected:\n%s\nGot:\n%s", expected, output)
+	}
+}
+
+func TestListLong(t *testing.T) {
+	modified := time.Date(2019, 6, 1, 12, 30, 0, 0, time.Local)
+
+	store := NewTestStore()
+	store.Vaults["one"] = &vaulted.Vault{}
+	store.Vaults["two"] = &vaulted.Vault{}
+	store.Metadata["one"] = &vaulted.VaultMetadata{
+		Description: "Production",
+		Tags:        []string{"aws", "prod"},
+		Team:        "ops",
+		Modified:    &modified,
+	}
+	store.Metadata["two"] = &vaulted.VaultMetadata{
+		Description: "Changed",
+		Unverified:  true,
+	}
+
+	output := CaptureStdout(func() {
+		l := List{
+			Active: "two",
+			Long:   true,
+		}
+		err := l.Run(store)
+		if err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	expected := []byte("" +
+		"NAME          TEAM  TAGS      MODIFIED          LAST USED         DESCRIPTION\n" +
+		"one           ops   aws,prod  2019-06-01 12:30  -                 Production\n" +
+		"two (active)  -     -         -                 -                 Changed (unverified)\n")
+	if bytes.Compare(output, expected) != 0 {
+		t.Fatalf("Expected:\n%s\nGot:\n%s", expected, output)
+	}
+}
+
+func TestListJSON(t *testing.T) {
+	store := NewTestStore()
+	store.Vaults["one"] = &vaulted.Vault{}
+	store.Vaults["two"] = &vaulted.Vault{}
+	store.Sources["two"] = vaulted.SystemVault
+	store.Metadata["one"] = &vaulted.VaultMetadata{
+		Description: "Production",
+		Tags:        []string{"prod"},
+	}
+
+	output := CaptureStdout(func() {
+		l := List{
+			Active: "one",
+			JSON:   true,
+		}
+		err := l.Run(store)
+		if err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	var entries []map[string]interface{}
+	err := json.Unmarshal(output, &entries)
+	if err != nil {
+		t.Fatalf("Failed to parse output: %v\n%s", err, output)
+	}
+
+	expected := []map[string]interface{}{
+		{"name": "one", "active": true, "source": "user", "description": "Production", "tags": []interface{}{"prod"}},
+		{"name": "two", "source": "system"},
+	}
+	if !reflect.DeepEqual(expected, entries) {
+		t.Fatalf("Expected %#v, got %#v", expected, entries)
+	}
+}
+
+func TestListPrefix(t *testing.T) {
+	store := NewTestStore()
+	store.Vaults["prod/payments"] = &vaulted.Vault{}
+	store.Vaults["prod/search"] = &vaulted.Vault{}
+	store.Vaults["production"] = &vaulted.Vault{}
+	store.Vaults["staging/payments"] = &vaulted.Vault{}
+
+	output := CaptureStdout(func() {
+		l := List{
+			Prefix: "prod/",
+		}
+		err := l.Run(store)
+		if err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	expected := []byte("prod/payments\nprod/search\n")
+	if bytes.Compare(output, expected) != 0 {
+		t.Fatalf("Expected:\n%s\nGot:\n%s", expected, output)
+	}
+}
+
+func TestListTree(t *testing.T) {
+	store := NewTestStore()
+	store.Vaults["prod/db/main"] = &vaulted.Vault{}
+	store.Vaults["prod/db/replica"] = &vaulted.Vault{}
+	store.Vaults["prod/payments"] = &vaulted.Vault{}
+	store.Vaults["prod-legacy"] = &vaulted.Vault{}
+	store.Vaults["staging/payments"] = &vaulted.Vault{}
+	store.Vaults["zeta"] = &vaulted.Vault{}
+
+	output := CaptureStdout(func() {
+		l := List{
+			Active: "prod/payments",
+			Tree:   true,
+		}
+		err := l.Run(store)
+		if err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	expected := []byte(`prod-legacy
+prod/
+  db/
+    main
+    replica
+  payments (active)
+staging/
+  payments
+zeta
+`)
+	if bytes.Compare(output, expected) != 0 {
+		t.Fatalf("Expected:\n%s\nGot:\n%s", expected, output)
+	}
+}
+
+func TestListSources(t *testing.T) {
+	store := NewTestStore()
+	store.Vaults["forked"] = &vaulted.Vault{}
+	store.Vaults["org"] = &vaulted.Vault{}
+	store.Vaults["personal"] = &vaulted.Vault{}
+	store.Sources["forked"] = vaulted.ShadowingVault
+	store.Sources["org"] = vaulted.SystemVault
+
+	output := CaptureStdout(func() {
+		l := List{
+			Active: "org",
+		}
+		err := l.Run(store)
+		if err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	expected := []byte("forked (shadows system vault)\norg (system) (active)\npersonal\n")
+	if bytes.Compare(output, expected) != 0 {
+		t.Fatalf("Expected:\n%s\nGot:\n%s", expected, output)
+	}
+}
@@ -10,9 +10,15 @@ import (
 
 type Load struct {
 	VaultName string
+	Fork      bool
 }
 
 func (l Load) Run(store vaulted.Store) error {
+	err := checkWritable(store, l.VaultName, l.Fork)
+	if err != nil {
+		return err
+	}
+
 	jvault, err := ioutil.ReadAll(os.Stdin)
 	if err != nil {
 		return err
@@ -24,7 +30,15 @@ func (l Load) Run(store vaulted.Store) error {
 		return err
 	}
 
-	err = store.SealVault(vault, l.VaultName)
+	password, err := store.Steward().GetPassword(vaulted.SealOperation, l.VaultName)
+	if err != nil {
+		return err
+	}
+
+	err = store.SealVaultWithOptions(vault, l.VaultName, password, vaulted.SealOptions{
+		Fork:      l.Fork,
+		Operation: "load",
+	})
 	if err != nil {
 		return err
 	}
@@ -63,3 +63,34 @@ func TestLoad(t *testing.T) {
 		t.Fatalf("Expected: %#v, got: %#v", v.Duration, store.Vaults["one"].Duration)
 	}
 }
+
+func TestLoadSystemVault(t *testing.T) {
+	store := NewTestStore()
+	store.Vaults["org"] = &vaulted.Vault{}
+	store.Sources["org"] = vaulted.SystemVault
+
+	var err error
+	WriteStdin([]byte(`{"vars":{"VAR1":"TESTING"}}`), func() {
+		l := Load{
+			VaultName: "org",
+		}
+		err = l.Run(store)
+	})
+	if err != vaulted.ErrReadOnlyVault {
+		t.Fatalf("Expected %v, got %v", vaulted.ErrReadOnlyVault, err)
+	}
+
+	WriteStdin([]byte(`{"vars":{"VAR1":"TESTING"}}`), func() {
+		l := Load{
+			VaultName: "org",
+			Fork:      true,
+		}
+		err = l.Run(store)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if store.Vaults["org"].Vars["VAR1"] != "TESTING" {
+		t.Fatalf("Expected the loaded vault, got %#v", store.Vaults["org"])
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 
+	"github.com/miquella/vaulted/agent"
 	"github.com/miquella/vaulted/lib"
 	"github.com/miquella/vaulted/lib/legacy"
 )
@@ -29,6 +30,9 @@ var (
 )
 
 func main() {
+	// keep decrypted secrets out of core dumps (best effort)
+	vaulted.DisableCoreDumps()
+
 	command, err := ParseArgs(os.Args[1:])
 	if err == nil {
 		steward := NewSteward()
@@ -36,7 +40,7 @@ func main() {
 			vaulted.Store
 			legacy.LegacyStore
 		}{
-			Store:       vaulted.New(steward),
+			Store:       vaulted.New(steward, newBackend(StoreDirs)),
 			LegacyStore: legacy.New(steward),
 		}
 
@@ -57,6 +61,16 @@ func main() {
 	}
 }
 
+// newBackend returns the backend for the stores given by --store (or
+// VAULTED_HOME), or the XDG backend if no stores were given.
+func newBackend(storeDirs []string) vaulted.Backend {
+	if len(storeDirs) == 0 {
+		return vaulted.NewXDGBackend()
+	}
+
+	return vaulted.NewFileBackend(storeDirs[0], storeDirs[1:]...)
+}
+
 func mapErrorWithExitCode(err error) error {
 	switch err {
 	case vaulted.ErrIncorrectPassword:
@@ -65,6 +79,52 @@ func mapErrorWithExitCode(err error) error {
 		return ErrorWithExitCode{vaulted.ErrInvalidKeyConfig, EX_DATA_ERROR}
 	case vaulted.ErrInvalidEncryptionConfig:
 		return ErrorWithExitCode{vaulted.ErrInvalidEncryptionConfig, EX_DATA_ERROR}
+	case vaulted.ErrNoIdentity:
+		return ErrorWithExitCode{vaulted.ErrNoIdentity, EX_UNAVAILABLE}
+	case vaulted.ErrNotARecipient:
+		return ErrorWithExitCode{vaulted.ErrNotARecipient, EX_TEMPORARY_ERROR}
+	case agent.ErrAgentNotRunning:
+		return ErrorWithExitCode{agent.ErrAgentNotRunning, EX_UNAVAILABLE}
+	case vaulted.ErrKeySlotNotExist:
+		return ErrorWithExitCode{vaulted.ErrKeySlotNotExist, EX_USAGE_ERROR}
+	case vaulted.ErrLastKeySlot:
+		return ErrorWithExitCode{vaulted.ErrLastKeySlot, EX_USAGE_ERROR}
+	case vaulted.ErrKeySlotsNotSupported:
+		return ErrorWithExitCode{vaulted.ErrKeySlotsNotSupported, EX_USAGE_ERROR}
+	case vaulted.ErrRevisionNotExist:
+		return ErrorWithExitCode{vaulted.ErrRevisionNotExist, EX_USAGE_ERROR}
+	case vaulted.ErrInvalidBundle:
+		return ErrorWithExitCode{vaulted.ErrInvalidBundle, EX_DATA_ERROR}
+	case vaulted.ErrReadOnlyVault:
+		return ErrorWithExitCode{vaulted.ErrReadOnlyVault, EX_USAGE_ERROR}
+	case vaulted.ErrInvalidVaultName:
+		return ErrorWithExitCode{vaulted.ErrInvalidVaultName, EX_USAGE_ERROR}
+	case vaulted.ErrNoRecovery:
+		return ErrorWithExitCode{vaulted.ErrNoRecovery, EX_USAGE_ERROR}
+	case vaulted.ErrInvalidRecoveryShares:
+		return ErrorWithExitCode{vaulted.ErrInvalidRecoveryShares, EX_USAGE_ERROR}
+	case vaulted.ErrInvalidRecoveryShare:
+		return ErrorWithExitCode{vaulted.ErrInvalidRecoveryShare, EX_DATA_ERROR}
+	case vaulted.ErrRecoveryShareMismatch:
+		return ErrorWithExitCode{vaulted.ErrRecoveryShareMismatch, EX_DATA_ERROR}
+	case vaulted.ErrNotEnoughRecoveryShares:
+		return ErrorWithExitCode{vaulted.ErrNotEnoughRecoveryShares, EX_DATA_ERROR}
+	case vaulted.ErrPasswordTooShort:
+		return ErrorWithExitCode{vaulted.ErrPasswordTooShort, EX_DATA_ERROR}
+	case vaulted.ErrPasswordTooWeak:
+		return ErrorWithExitCode{vaulted.ErrPasswordTooWeak, EX_DATA_ERROR}
+	case vaulted.ErrPasswordDenied:
+		return ErrorWithExitCode{vaulted.ErrPasswordDenied, EX_DATA_ERROR}
+	case vaulted.ErrSyncNotInitialized:
+		return ErrorWithExitCode{vaulted.ErrSyncNotInitialized, EX_USAGE_ERROR}
+	case vaulted.ErrSyncRemote:
+		return ErrorWithExitCode{vaulted.ErrSyncRemote, EX_USAGE_ERROR}
+	case vaulted.ErrSyncBehind:
+		return ErrorWithExitCode{vaulted.ErrSyncBehind, EX_TEMPORARY_ERROR}
+	case vaulted.ErrNoAuditKey:
+		return ErrorWithExitCode{vaulted.ErrNoAuditKey, EX_USAGE_ERROR}
+	case vaulted.ErrVaultModified:
+		return ErrorWithExitCode{vaulted.ErrVaultModified, EX_TEMPORARY_ERROR}
 	default:
 		return err
 	}
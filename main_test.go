@@ -2,6 +2,7 @@ package main
 
 import (
 	"bytes"
+	"encoding/json"
 	"errors"
 	"io"
 	"os"
@@ -62,6 +63,21 @@ func NewTestStore() *TestStore {
 		Passwords: make(map[string]string),
 		Vaults:    make(map[string]*vaulted.Vault),
 		Sessions:  make(map[string]*vaulted.Session),
+
+		History:           make(map[string][]*vaulted.HistoryEntry),
+		RevisionVaults:    make(map[string]map[int]*vaulted.Vault),
+		RevisionPasswords: make(map[string]map[int]string),
+		Operations:        make(map[string]string),
+
+		Recipients: make(map[string][]vaulted.Recipient),
+		Slots:      make(map[string][]*vaulted.KeySlot),
+		Reports:    make(map[string]*vaulted.VaultReport),
+		Metadata:   make(map[string]*vaulted.VaultMetadata),
+		Recoveries: make(map[string]*vaulted.Recovery),
+		Sources:    make(map[string]vaulted.VaultSource),
+		AuditLogs:  make(map[string]*vaulted.AuditLog),
+
+		FormatVersions: make(map[string]int),
 	}
 }
 
@@ -70,6 +86,24 @@ type TestStore struct {
 	Vaults    map[string]*vaulted.Vault
 	Sessions  map[string]*vaulted.Session
 
+	History           map[string][]*vaulted.HistoryEntry
+	RevisionVaults    map[string]map[int]*vaulted.Vault
+	RevisionPasswords map[string]map[int]string
+	Operations        map[string]string
+
+	Recipients map[string][]vaulted.Recipient
+	Identity   *vaulted.Identity
+	Slots      map[string][]*vaulted.KeySlot
+	Reports    map[string]*vaulted.VaultReport
+	Metadata   map[string]*vaulted.VaultMetadata
+	Recoveries map[string]*vaulted.Recovery
+	Sources    map[string]vaulted.VaultSource
+	AuditLogs  map[string]*vaulted.AuditLog
+
+	// FormatVersions holds the format version of vaults that are not in the
+	// current format
+	FormatVersions map[string]int
+
 	LegacyPassword     string
 	LegacyEnvironments map[string]legacy.Environment
 }
@@ -85,6 +119,14 @@ func (ts TestStore) GetPassword(operation vaulted.Operation, name string) (strin
 	}
 }
 
+func (ts TestStore) GetIdentity(name string) (*vaulted.Identity, error) {
+	if ts.Identity == nil {
+		return nil, vaulted.ErrNoIdentity
+	}
+
+	return ts.Identity, nil
+}
+
 func (ts TestStore) GetMFAToken(name string) (string, error) {
 	return "123456", nil
 }
@@ -98,6 +140,17 @@ func (ts TestStore) VaultExists(name string) bool {
 	return exists
 }
 
+func (ts TestStore) VaultSource(name string) (vaulted.VaultSource, error) {
+	if !ts.VaultExists(name) {
+		return "", os.ErrNotExist
+	}
+
+	if source, exists := ts.Sources[name]; exists {
+		return source, nil
+	}
+	return vaulted.UserVault, nil
+}
+
 func (ts TestStore) ListVaults() ([]string, error) {
 	var vaults []string
 	for name := range ts.Vaults {
@@ -117,6 +170,48 @@ func (ts TestStore) SealVaultWithPassword(vault *vaulted.Vault, name, password s
 	return nil
 }
 
+func (ts TestStore) SealVaultWithOptions(vault *vaulted.Vault, name, password string, options vaulted.SealOptions) error {
+	if ts.Sources[name] == vaulted.SystemVault {
+		if !options.Fork {
+			return vaulted.ErrReadOnlyVault
+		}
+		ts.Sources[name] = vaulted.ShadowingVault
+	}
+	ts.Operations[name] = options.Operation
+	if options.KeyMethod != "" || options.Replace {
+		delete(ts.Recipients, name)
+		delete(ts.Slots, name)
+	}
+	if options.Replace {
+		delete(ts.Recoveries, name)
+	}
+	if options.Recipients != nil {
+		ts.Recipients[name] = options.Recipients
+	}
+	if options.Metadata != nil {
+		ts.Metadata[name] = options.Metadata
+	}
+	if options.Recovery != nil {
+		ts.Recoveries[name] = options.Recovery
+	}
+	if options.RemoveRecovery {
+		delete(ts.Recoveries, name)
+	}
+	return ts.SealVaultWithPassword(vault, name, password)
+}
+
+func (ts TestStore) SealVaultIfUnmodified(vault *vaulted.Vault, name, password string, revision int, options vaulted.SealOptions) error {
+	return ts.SealVaultWithOptions(vault, name, password, options)
+}
+
+func (ts TestStore) VaultRevision(name string) (int, error) {
+	if !ts.VaultExists(name) {
+		return 0, os.ErrNotExist
+	}
+
+	return 0, nil
+}
+
 func (ts TestStore) OpenVault(name string) (*vaulted.Vault, string, error) {
 	return ts.OpenVaultWithPassword(name, "prompted password")
 }
@@ -129,6 +224,36 @@ func (ts TestStore) OpenVaultWithPassword(name, password string) (*vaulted.Vault
 	return cloneVault(ts.Vaults[name]), ts.Passwords[name], nil
 }
 
+func (ts TestStore) OpenVaultWithRecovery(name string, shares []*vaulted.RecoveryShare) (*vaulted.Vault, error) {
+	if !ts.VaultExists(name) {
+		return nil, os.ErrNotExist
+	}
+
+	recovery, exists := ts.Recoveries[name]
+	if !exists {
+		return nil, vaulted.ErrNoRecovery
+	}
+
+	for _, share := range shares {
+		if !bytes.Equal(share.ID, recovery.ID()) {
+			return nil, vaulted.ErrRecoveryShareMismatch
+		}
+	}
+	if len(shares) < recovery.Threshold {
+		return nil, vaulted.ErrNotEnoughRecoveryShares
+	}
+
+	return cloneVault(ts.Vaults[name]), nil
+}
+
+func (ts TestStore) UnlockVault(name string) (*vaulted.Vault, string, error) {
+	return ts.OpenVault(name)
+}
+
+func (ts TestStore) ResolveIncludes(vault *vaulted.Vault, name string) (*vaulted.Vault, error) {
+	return vault, nil
+}
+
 func (ts TestStore) RemoveVault(name string) error {
 	if !ts.VaultExists(name) {
 		return os.ErrNotExist
@@ -140,6 +265,187 @@ func (ts TestStore) RemoveVault(name string) error {
 	return nil
 }
 
+func (ts TestStore) UpgradeVault(name string) (int, error) {
+	if !ts.VaultExists(name) {
+		return 0, os.ErrNotExist
+	}
+
+	version, exists := ts.FormatVersions[name]
+	if !exists {
+		return vaulted.CurrentFormatVersion, nil
+	}
+	if version > vaulted.CurrentFormatVersion {
+		return version, vaulted.ErrUnsupportedFormatVersion
+	}
+	if ts.Sources[name] == vaulted.SystemVault {
+		return version, vaulted.ErrReadOnlyVault
+	}
+
+	delete(ts.FormatVersions, name)
+
+	return version, nil
+}
+
+func (ts TestStore) ExportVaults(names []string, passphrase string) ([]byte, error) {
+	for _, name := range names {
+		if !ts.VaultExists(name) {
+			return nil, os.ErrNotExist
+		}
+	}
+
+	return json.Marshal(map[string]interface{}{
+		"vaults":     names,
+		"passphrase": passphrase,
+	})
+}
+
+func (ts TestStore) ImportVault(vault *vaulted.BundledVault, name string) error {
+	ts.Vaults[name] = &vaulted.Vault{}
+	ts.Operations[name] = "import"
+	return nil
+}
+
+func (ts TestStore) VaultHistory(name string) ([]*vaulted.HistoryEntry, error) {
+	if !ts.VaultExists(name) {
+		return nil, os.ErrNotExist
+	}
+
+	return ts.History[name], nil
+}
+
+func (ts TestStore) OpenVaultRevision(name string, revision int, password string) (*vaulted.Vault, error) {
+	if !ts.VaultExists(name) {
+		return nil, os.ErrNotExist
+	}
+
+	vault, exists := ts.RevisionVaults[name][revision]
+	if !exists {
+		return nil, vaulted.ErrRevisionNotExist
+	}
+
+	if password != ts.RevisionPasswords[name][revision] {
+		return nil, vaulted.ErrIncorrectPassword
+	}
+
+	return cloneVault(vault), nil
+}
+
+func (ts TestStore) VaultMetadata(name string) (*vaulted.VaultMetadata, error) {
+	if !ts.VaultExists(name) {
+		return nil, os.ErrNotExist
+	}
+
+	if metadata, exists := ts.Metadata[name]; exists {
+		return metadata, nil
+	}
+
+	return &vaulted.VaultMetadata{}, nil
+}
+
+func (ts TestStore) SetVaultMetadata(name string, metadata *vaulted.VaultMetadata) error {
+	if !ts.VaultExists(name) {
+		return os.ErrNotExist
+	}
+
+	ts.Metadata[name] = metadata
+	return nil
+}
+
+func (ts TestStore) VaultRecipients(name string) ([]vaulted.Recipient, error) {
+	if !ts.VaultExists(name) {
+		return nil, os.ErrNotExist
+	}
+
+	return ts.Recipients[name], nil
+}
+
+func (ts TestStore) KeySlots(name string) ([]*vaulted.KeySlot, error) {
+	if !ts.VaultExists(name) {
+		return nil, os.ErrNotExist
+	}
+
+	return ts.Slots[name], nil
+}
+
+func (ts TestStore) AddKeySlot(name, password, newPassword, keyMethod string) (int, error) {
+	if !ts.VaultExists(name) {
+		return 0, os.ErrNotExist
+	}
+
+	if password != ts.Passwords[name] {
+		return 0, vaulted.ErrIncorrectPassword
+	}
+
+	if keyMethod == "" {
+		keyMethod = vaulted.DefaultKeyMethod
+	}
+
+	slots := ts.Slots[name]
+	if len(slots) == 0 {
+		slots = append(slots, &vaulted.KeySlot{ID: 0, Key: &vaulted.VaultKey{Method: vaulted.DefaultKeyMethod}})
+	}
+	slot := &vaulted.KeySlot{ID: len(slots), Key: &vaulted.VaultKey{Method: keyMethod}}
+	ts.Slots[name] = append(slots, slot)
+
+	return slot.ID, nil
+}
+
+func (ts TestStore) RemoveKeySlot(name, password string, id int) error {
+	if !ts.VaultExists(name) {
+		return os.ErrNotExist
+	}
+
+	if password != ts.Passwords[name] {
+		return vaulted.ErrIncorrectPassword
+	}
+
+	var remaining []*vaulted.KeySlot
+	for _, slot := range ts.Slots[name] {
+		if slot.ID != id {
+			remaining = append(remaining, slot)
+		}
+	}
+
+	if len(remaining) == len(ts.Slots[name]) {
+		return vaulted.ErrKeySlotNotExist
+	}
+	if len(remaining) == 0 {
+		return vaulted.ErrLastKeySlot
+	}
+
+	ts.Slots[name] = remaining
+
+	return nil
+}
+
+func (ts TestStore) VerifyVault(name string) (*vaulted.VaultReport, error) {
+	if !ts.VaultExists(name) {
+		return nil, os.ErrNotExist
+	}
+
+	if report, exists := ts.Reports[name]; exists {
+		return report, nil
+	}
+
+	return &vaulted.VaultReport{Name: name, Status: vaulted.VerifyOK}, nil
+}
+
+func (ts TestStore) VerifyVaultWithPassword(name, password string) (*vaulted.VaultReport, error) {
+	report, err := ts.VerifyVault(name)
+	if err != nil {
+		return nil, err
+	}
+
+	opened := password == ts.Passwords[name]
+	report.Opened = &opened
+	if !opened {
+		report.Status = vaulted.VerifyError
+		report.Errors = append(report.Errors, vaulted.ErrIncorrectPassword.Error())
+	}
+
+	return report, nil
+}
+
 func (ts TestStore) GetSession(vault *vaulted.Vault, name, password string) (*vaulted.Session, error) {
 	if !ts.VaultExists(name) {
 		return nil, os.ErrNotExist
@@ -206,6 +512,26 @@ func (ts TestStore) CreateSession(vault *vaulted.Vault, name, password string) (
 	return s, nil
 }
 
+func (ts TestStore) AssumeRole(session *vaulted.Session, name, roleArn string) (*vaulted.Session, error) {
+	if roleArn == "" {
+		return session.AssumeSessionRole()
+	}
+
+	return session.AssumeRole(roleArn)
+}
+
+func (ts TestStore) VaultAuditLog(name string) (*vaulted.AuditLog, error) {
+	if !ts.VaultExists(name) {
+		return nil, os.ErrNotExist
+	}
+
+	log, exists := ts.AuditLogs[name]
+	if !exists {
+		return nil, vaulted.ErrNoAuditKey
+	}
+	return log, nil
+}
+
 func (ts TestStore) OpenLegacyVault() (environments map[string]legacy.Environment, password string, err error) {
 	return ts.LegacyEnvironments, ts.LegacyPassword, nil
 }
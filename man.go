@@ -1,17 +1,30 @@
 // Code generated by go-bindata.
 // sources:
 // doc/man/vaulted-add.1
+// doc/man/vaulted-agent.1
+// doc/man/vaulted-audit.1
 // doc/man/vaulted-cp.1
+// doc/man/vaulted-diff.1
 // doc/man/vaulted-dump.1
 // doc/man/vaulted-edit.1
 // doc/man/vaulted-env.1
 // doc/man/vaulted-exec.1
+// doc/man/vaulted-export.1
+// doc/man/vaulted-history.1
+// doc/man/vaulted-import.1
 // doc/man/vaulted-load.1
+// doc/man/vaulted-lock.1
 // doc/man/vaulted-ls.1
+// doc/man/vaulted-metadata.1
 // doc/man/vaulted-passwd.1
+// doc/man/vaulted-recipients.1
+// doc/man/vaulted-recovery.1
 // doc/man/vaulted-rm.1
+// doc/man/vaulted-rollback.1
 // doc/man/vaulted-shell.1
+// doc/man/vaulted-sync.1
 // doc/man/vaulted-upgrade.1
+// doc/man/vaulted-verify.1
 // doc/man/vaulted.1
 // DO NOT EDIT!
 
@@ -80,7 +93,7 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var _vaultedAdd1 = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x8f\x41\x4e\xc3\x30\x10\x45\xf7\x39\xc5\x3f\x00\x8d\xc4\x11\xa0\x54\x4a\x16\xa4\x56\x5d\x16\x48\xde\x0c\xf1\x18\x2c\xb5\xe3\x92\x4c\x1d\x71\x7b\x14\xb7\x20\x14\xa1\xae\xdf\x9b\x37\xfa\xf5\xbe\x41\xa6\xf3\x41\xd9\xbb\x15\x79\x8f\xfb\xaa\xb6\x0d\xba\x87\xe7\x4d\x55\x1b\x53\x5d\x19\x66\xe4\x56\x88\xa2\x3c\x50\xaf\x31\xf3\xe1\x0b\xfd\xc0\xa4\x3c\x42\x3f\x18\x7d\x12\x65\x51\xa4\x00\x82\xf0\x74\xa9\x96\x98\x7d\xed\xb6\xc6\xb6\xb6\x04\x5d\x78\x74\x61\xfd\x27\xeb\xc2\x0e\x2e\xb4\x42\x47\x76\xc1\xfc\xe3\x5c\xbe\x2c\xb5\xb7\x61\xa1\x09\x4f\x4b\xc7\x36\x78\xda\xd8\xf5\xae\x35\xfb\x76\xdb\x95\xb4\x3d\xd1\x24\x23\x48\x7e\xa7\x64\xc6\x31\x79\x46\x48\x03\xd8\x47\x8d\xf2\x7e\x63\x50\x5d\x2a\x2f\xa7\x24\xf8\x3c\x47\x9d\xed\xbb\xa2\xcf\xc6\xcf\x49\x1c\x31\x52\x66\x0f\x4d\x85\x5d\x2f\xbf\x03\x00\x00\xff\xff\xb4\x37\x77\xb9\x6c\x01\x00\x00")
+var _vaultedAdd1 = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x53\xc1\x4e\xdc\x30\x10\xbd\xe7\x2b\xe6\x54\xb1\xd2\x26\x02\x2a\x7a\xaa\x2a\x51\x40\x22\x52\xcb\x46\x9b\x6d\x2b\x54\xf7\x30\xd8\x63\x62\x91\xd8\xa9\x3d\x49\xc8\xdf\x57\x4e\xb2\xab\x6d\x8b\xe0\xb6\xeb\xf7\xde\xcc\x7b\x33\x93\x6c\x77\x0b\x3d\x76\x35\x93\x12\x29\x2a\x05\x67\x49\x56\xde\xc2\xdd\xe5\xd7\x9b\x24\x2b\x8a\x64\xc1\x20\x42\x22\x05\x63\x99\x3c\x4a\x36\x3d\xd5\x23\x48\x4f\xc8\x14\x80\x2b\x02\xe9\x2c\x93\x65\x70\x1a\x10\x2c\x0d\x73\xd5\xa9\x58\x79\x7f\xb7\x29\xca\xbc\x9c\x0a\x0a\xfd\x59\xe8\xab\xa3\xb2\x42\x6f\x41\xe8\xdc\x62\x43\x42\x17\xf0\x53\xe8\x7c\x53\xec\xf2\xcd\x5d\x29\x74\xf1\xeb\x05\xcd\xdc\xf5\x2d\xd9\x83\xff\x47\x66\x69\x78\x4b\x53\xde\xc2\xf5\x4d\x79\xb5\xcd\xa7\xc7\xa9\x75\xd9\xe2\x60\x03\xa0\x3d\x44\xef\x09\x1a\xa7\x08\xb4\xf3\x40\xca\xb0\xb1\x8f\xaf\x0c\x20\x9b\xaa\x7c\x6b\x9d\x85\xdf\x9d\xe1\xc8\x5e\x4f\xf4\xc8\xd8\x4b\x4c\x80\x80\x3d\x29\x60\x37\x61\x47\xca\xdd\x42\x6d\x31\x84\xc1\x79\x05\x4d\x17\x18\x02\xb2\x09\x7a\x9c\xc8\x07\xa4\x75\xb5\x91\x23\x9c\x04\x22\x28\x2e\xcb\xf2\xc7\x66\x7b\x0d\xc5\xe6\x4b\x7e\x75\x0f\xc6\xee\x37\x79\x72\xb6\x5a\x65\x53\xd4\x25\x7a\x92\xed\xf6\x13\x16\xa9\x48\xa5\x69\x2b\xf2\x71\x52\x1f\x03\x49\x4f\xfc\xe0\x9e\xd7\xcf\xb2\x42\x59\xe1\xf9\x69\xeb\xea\xf1\xec\xfd\xe9\xc5\x1a\x29\x88\xf4\xfc\xe2\x83\x48\x1f\x65\xf3\x29\x29\x5b\x92\x46\x9b\xe5\x16\xc8\x4a\x3f\xb6\x6c\x9c\x85\x86\xb8\x72\x0a\xba\x30\xa7\x0b\x84\xf5\x51\x44\xb8\x26\x1d\x7f\x04\x60\xb7\x78\xf8\xaf\x95\xd0\x5b\xf1\x2e\x4b\xb2\xbc\x78\x85\x01\x68\x15\xcc\xf0\x5f\xce\x62\x0e\xac\x83\x03\xec\xb8\x22\xcb\x46\x22\xd3\x64\xa0\xb3\x8b\x4b\x52\x49\x45\xa8\xc8\xc7\xcd\x1d\xac\x81\x36\x35\xc1\xc9\x8b\x69\xe6\x05\x3e\xd1\x08\x8a\xbc\xe9\x31\x42\x89\x22\x46\x53\x87\x19\x6b\x88\x51\x21\xe3\xfc\xcf\x93\x34\xad\x21\xcb\xf1\x8e\xd4\xfe\xc9\xf5\xe4\x47\x78\xa2\x71\xb5\x86\x68\xd0\x8e\x09\x63\xd3\x92\x8f\x07\x35\x18\xae\x22\xb1\x01\x13\x40\x11\x93\x8c\x5f\xcb\x50\x91\x3d\xb2\x68\x02\xb8\x96\x2c\xa9\x6c\x89\x7e\x58\x58\x8c\x1d\xbd\x27\x6a\x9e\x6f\x8c\xe6\xea\x98\xb1\x27\x1f\x8c\xb3\x21\xbe\x7c\x8f\x10\xa9\x15\x28\x47\x01\xac\xe3\x2c\xf9\x33\x00\x8f\xe8\x40\x14\x0f\x04\x00\x00")
 
 func vaultedAdd1Bytes() ([]byte, error) {
 	return bindataRead(
@@ -100,7 +113,47 @@ func vaultedAdd1() (*asset, error) {
 	return a, nil
 }
 
-var _vaultedCp1 = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x90\xc1\x6a\xeb\x30\x10\x45\xf7\xfe\x8a\xbb\x7a\xab\xc4\xf0\x3e\x21\x4d\x0c\x31\xb4\x8e\x89\xd2\x86\x82\xa0\x28\xf6\x08\x0b\x1c\xc9\x95\x14\x9b\xfc\x7d\x91\xa2\x24\x14\xda\x2e\xda\x9d\xf1\x5c\xdd\x73\x66\xf2\xdd\x1a\xa3\x38\xf5\x9e\x5a\x3e\x6f\x06\xfc\xcf\x72\xb6\x46\xb5\x78\x2a\xb2\xbc\xae\xb3\x34\x42\x33\x80\xcf\xd1\x98\x41\x91\x83\xef\x08\x8d\xd1\x9e\xb4\x87\x91\x10\x97\x02\x08\xdd\xc2\x89\x91\x1c\x94\x87\x70\x10\xd0\x34\xa5\xd9\xa4\x7c\x97\x7e\x0c\xc2\xb9\xc9\xd8\x36\x82\xd8\x6b\xb5\xa9\x59\xc9\x22\x8c\xcb\x07\x2e\x97\x77\x24\x97\x5b\x70\x59\x9a\xbe\xe5\xb2\x0e\x5f\x9a\x26\x2e\xeb\xaf\xb2\x66\x38\x7f\x9b\x66\x6b\xac\x0a\xb6\xdc\x96\xf5\xae\xdc\x54\xf1\xf5\x32\xd9\x2b\x1d\x97\xb9\x85\x93\xad\x72\x68\x2c\x89\xd0\x6c\x2c\x2c\x0d\xbd\x68\xa8\xc5\xe1\x7c\x5b\x5b\x5a\x73\xbc\xd3\xf8\xbf\x3c\xd6\x96\x32\xd5\x05\xb7\x97\xc5\xf3\xe3\xae\x58\xbd\xd5\x0b\xc6\xf6\x9b\xed\x2a\xf8\x91\x1e\x95\x35\xfa\x18\x2a\x46\x61\x95\x38\xf4\x14\x68\x8e\xfc\x2c\x5c\x6d\x52\x7d\x8f\x03\xe1\xe4\xa8\x0d\x27\xf4\x1d\x65\xd7\x7b\x41\x1a\x7b\x47\xce\x60\x7c\x47\x76\x52\x8e\x22\xf3\x96\xba\x56\x58\x7a\x3f\x91\x0b\x2b\x8c\x4a\xc4\x88\xf7\xe7\x1f\x34\xab\x62\xff\x17\xd5\xec\x93\x44\x52\xbd\x1c\xf5\xb7\xaa\x1f\x01\x00\x00\xff\xff\xac\xf1\xb5\x97\x9b\x02\x00\x00")
+var _vaultedAgent1 = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x55\xc1\x6e\x1b\x37\x10\xbd\xf3\x2b\xe6\x50\x24\x32\x60\x6d\x6a\x14\x05\x8a\xdc\x14\x5b\x48\x84\xd4\x96\xb0\x52\x9a\x06\xdd\xc2\xa0\x96\x43\x2d\xe1\x5d\x52\xe5\x70\x25\xeb\x92\x6f\x2f\x86\xa4\x24\xaf\xec\x9b\xb0\x24\xdf\xbc\xf7\xe6\xcd\xa8\x58\x7d\x81\x9d\xec\xdb\x80\xaa\x1a\xcb\x0d\xda\x00\x37\xa2\x58\x7e\x81\x87\xc9\xfd\x54\x14\x8b\x85\xc8\xa7\x90\x0e\xab\x31\x34\xae\x55\x04\xbd\x6d\x5d\xfd\x84\x2a\xbd\x86\x27\x3c\x10\x18\x0b\x1d\x76\xce\x1f\x22\xc2\xf2\xc7\xc3\x7c\xb1\x9c\x2d\x23\x4a\xa5\x3f\x55\xfa\x76\x80\x55\xe9\x12\xfe\xa9\xf4\x6c\xbe\x58\xcd\xe6\x0f\xcb\x4a\x2f\xfe\x8d\xef\xee\xa6\xcb\xdb\x72\x16\x3f\xc6\xa7\xcb\x20\x7d\x20\x90\x36\x53\x18\x91\xe9\x4c\x2b\x3d\x04\x07\x09\x96\xa8\xc9\xe4\x2b\x5d\x5e\x41\x68\x64\xc8\x2c\x43\x83\x89\x9a\x42\x6f\x76\xa8\x40\x7b\xd7\x25\xca\x62\x2b\x89\xf6\xce\xab\x17\xbc\x0b\xf8\xde\x98\x16\xe3\xb3\x88\x07\x86\xc0\xf7\xd6\x1a\xbb\xb9\x86\xa1\x06\xb4\xbb\x4a\x97\xaf\xbe\x3e\x63\xcd\x9f\xc5\xf0\x33\x35\xd8\xb6\x2c\x78\x24\xad\x02\xda\xca\x3d\x43\x02\x21\x91\x71\x96\x60\x6f\x42\x73\x81\x54\x8d\x6d\x54\xd3\x53\xe2\x13\x65\x98\x2c\x4c\x18\x4b\x01\xa5\x02\xa7\x61\xeb\x5d\xb7\x0d\x0c\xa7\x9d\x8f\x57\x63\xd5\xf7\x04\x47\x85\xc0\x45\xa3\x03\x7c\x2b\x83\x81\xdc\x48\x63\x8b\x68\xf1\xe4\xf4\x84\x0f\x0c\x41\x23\xad\x42\xc5\x0e\x9f\xad\xd8\x37\x68\x71\x87\x2f\x4a\xb0\x3b\x6e\x8b\x16\x15\x38\x0f\x24\xd9\x60\x56\x22\x4c\x38\x17\x2f\xe0\x2b\x33\x97\x1e\xc1\xd9\xf6\x00\x3d\xf1\xad\x93\xcd\x09\xc8\x63\x27\x8d\x25\x20\x94\x6d\x06\x89\xa7\x24\x3b\x14\x4f\x78\xb8\x06\x72\x50\x37\xd2\x6e\x58\x81\x7c\xad\xd0\xe3\x7f\xbd\xf1\x48\x60\x02\xb3\x5e\xe3\x39\xa0\x49\x27\xdc\xba\xae\x93\x56\x91\x88\xf1\x60\xb2\x47\x1c\x18\x61\xb1\x29\x2e\x1b\xa9\x4c\xe0\x86\x39\x7f\x71\x10\x75\xa9\xd8\x1a\xd9\xee\xe5\x81\x72\x03\x40\x3b\x2f\x98\xf3\x49\x78\xb4\xf6\x24\xbe\xc1\x56\x71\xd2\x32\xab\x34\x28\x51\x57\x68\xf0\x10\xaf\x24\x7b\xf7\xde\x84\x80\x96\x65\xd0\x5e\x6e\x61\x44\x88\x70\x3f\xbd\x9f\x97\x3f\xc4\xa2\x9c\xaf\xa6\xb7\x3c\x1a\x0c\x95\x29\x8d\x6e\xae\xae\x0a\x98\xca\xba\x39\xb6\x6f\x6f\xb6\xc7\xac\xa7\x3a\xe0\x6c\x8d\xec\x4d\x23\x09\xac\x0b\xb0\x46\xb4\x22\xb6\xe2\x18\x1a\xa3\xb8\x23\xa6\x43\xd7\x87\x42\x4c\xda\x96\xc1\x52\xdf\x12\xdc\xfa\x70\xe1\x04\x2b\xc9\x16\x71\x36\x5e\x44\x05\x9f\x4d\xa0\x42\x2c\x11\x41\x14\x9f\xca\x23\xd1\x31\xbf\x80\xd1\xcd\x55\x4a\xdd\xea\x74\xdf\xf7\x36\x4e\x21\x23\x68\xe7\x71\xe3\x5d\x6f\x15\xf4\x36\x98\x96\x59\x1b\x3e\x0d\xe8\x7d\xbf\xe5\x69\x1a\x31\x65\xf4\x9d\xb1\x32\xa0\xba\x2a\x60\xe5\x78\x4a\x05\xdf\x4c\x34\xd6\xb2\x7e\x4a\x20\xd7\x40\xbc\x3f\x18\xe5\x8d\x21\x4b\xc9\x7e\xc7\x2a\x18\x54\x12\x48\x0e\xa8\x07\x42\xbf\x33\x35\xbe\x62\xda\x1a\x0a\x68\x09\x9c\xe5\x9b\xd6\x3c\x03\x71\x3f\x43\x5a\x3a\x3c\x0f\x9c\x71\x59\xd7\x3c\xd9\xeb\x16\x61\x7d\x88\x84\x18\xf4\x63\x5e\x0a\xbf\xfc\x7d\xf7\xf9\xb1\xfc\xf6\xb0\x9a\xdd\x4f\x1f\xef\x66\xe5\x87\xcc\xe6\x43\x64\x53\x50\xb6\x75\x74\x19\xbd\x6a\x5c\xe9\xb2\xd2\xb3\xde\xa8\x4a\x2f\xd2\xd1\xc5\x9b\xe4\xa1\x08\xd8\x6d\x41\x19\x8f\x75\x70\xfe\x90\xba\xf3\x76\x6d\x2e\x64\x52\x24\x08\x03\x5b\xd9\x60\xae\xfa\xd7\xe4\xdb\x9f\xab\xe9\xdd\xe3\xe4\xf3\xf4\x61\xf5\xb8\x9c\xdf\x7e\xad\x74\x29\xd0\xee\x8c\x77\xb6\x63\xdf\x76\xd2\x1b\xc9\x22\xdd\x0e\xbd\x37\x0a\x89\xab\x67\x47\xde\x13\xb4\xae\x96\xc1\x38\x7b\x7d\xda\x4c\x91\x2d\x48\x82\x3d\xb6\x2d\x48\x12\xc7\x83\x3a\x4f\x67\xf2\x91\x77\x9e\x09\x67\xef\x4f\x88\x67\x4d\x86\xa0\xf6\xc8\xfd\x4f\xf2\x4c\x00\xe5\x90\xec\xfb\x00\xf8\x6c\x28\x14\x30\xb1\xe9\x17\x6f\x8c\xd3\x3b\xc1\x62\xe3\x98\x75\x4e\x19\x6d\x50\x7d\x7c\x41\xcc\xa3\xee\x89\x55\xb8\x9c\x9a\xde\xb6\x48\x49\xd5\xa0\xb4\xdb\xf3\xd2\x5b\x1f\xc4\xb1\xb7\x71\xc5\x66\x23\x87\xdd\x77\xa1\x41\x1f\xef\xd0\x71\xda\xb3\x1e\xa0\xc6\xf5\xad\x82\x35\xc2\xb6\x95\x35\x2a\x61\x38\x55\xe7\x42\x4e\x03\x6f\x52\xb7\xb7\x83\x15\xf5\xf3\x43\x71\x4a\x44\x34\x74\x18\x82\x6b\x26\x91\xa3\xf6\x73\x78\xc4\x71\x5e\x7e\x81\xfc\x8f\x2b\x8a\xd5\xf1\x8f\xb9\x1a\x57\xe3\x3c\xfb\x9c\x88\x4a\xcf\x54\xef\x63\xef\x2a\xbd\x10\xdf\xcd\x16\x79\x32\x9e\xf0\xed\x45\x02\xa7\x45\x32\x7c\x38\x60\xfd\xdb\xaf\xdd\x60\x9f\xfe\xd1\xf0\x0e\x2d\xc4\x1d\x6a\x16\x43\xe7\xbf\xf3\x9b\xdf\xbb\x4a\x97\xd5\xbb\x42\xfc\x3f\x00\x9d\x01\xf3\xb5\x9e\x08\x00\x00")
+
+func vaultedAgent1Bytes() ([]byte, error) {
+	return bindataRead(
+		_vaultedAgent1,
+		"vaulted-agent.1",
+	)
+}
+
+func vaultedAgent1() (*asset, error) {
+	bytes, err := vaultedAgent1Bytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "vaulted-agent.1", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _vaultedAudit1 = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x55\xc1\x8e\xdb\x36\x10\x3d\x87\x5f\x31\xb7\xac\x01\x47\x68\x81\x36\x87\xf6\x94\x26\x06\xe2\x43\x92\x45\x6c\xa4\x2d\xe0\x43\x46\xe4\x93\x45\x2c\x45\xaa\x24\x6d\xaf\xfa\xf5\xc5\x50\xb2\x64\xa7\x4d\x0f\x0b\xac\x45\xf2\xcd\xcc\x7b\x6f\x66\xaa\xfd\x7b\x3a\xf3\xc9\x65\x98\xc3\x2b\x3e\x19\x9b\xe9\x47\x55\xed\xde\xd3\xc7\x37\x1f\x36\xaa\x7a\x7c\x54\xd3\x29\x8d\x87\x87\x57\x64\x6c\xea\x1d\x0f\x89\xd8\x1b\x3a\x23\xda\xc6\x22\x51\x6e\x31\x5d\x71\xe1\x48\xa1\x21\x1e\x71\x0b\xd8\xee\xcf\x8f\x9f\x1e\x77\xdb\x5d\x01\x3c\x34\xbf\x1d\x9a\xb7\x77\xb0\x87\xe6\x33\x1d\x9a\xad\xe7\x0e\x87\xe6\xb1\x3c\x79\xb7\xd9\xbd\xfd\xbc\x7d\xdc\x6f\x3f\x7d\x2c\xaf\x36\xac\xdb\x11\x91\x9e\x80\x5e\xa2\x2f\xf1\xd6\x14\xa1\x43\x34\xd6\x1f\xe9\xd2\xc2\x97\x6c\xc6\xcb\x17\x4e\x14\x7a\x78\x98\x35\x25\xb0\x83\xa1\x10\x55\x44\x17\xce\xf2\xa9\xdc\x4e\x48\xc9\x06\x9f\xe8\x82\x08\xd2\x11\x2c\x99\x3d\x84\x48\x11\x39\x5a\x9c\x61\xa8\x89\xa1\x2b\xb0\xd3\x65\xd2\xac\x5b\xac\xd6\xc2\x82\x2a\x28\x31\x38\x4c\x10\x9c\xd2\xa9\x83\xa9\xa8\x64\x0d\x9f\xe3\x30\x65\x38\x12\x95\x6d\x87\x75\xf9\x2f\xf4\x88\x9c\x6d\xf0\xe3\x4f\xc1\x50\x0f\x05\x2e\x78\x94\xe4\x27\xac\xd5\x78\x41\x87\xae\x13\xde\x9d\xf5\x28\xb1\xa5\xde\xdc\x22\xde\xa3\x51\x3a\x69\x0d\x18\x18\x25\x65\xc8\x19\x62\x0c\x91\x6c\xa6\x86\xad\xb0\x70\xb1\xb9\x5d\x55\x23\xb7\x5e\xaa\x4c\xc4\x11\x04\xaf\xe3\xd0\x4b\xfd\x4d\x88\xc4\xf4\x84\x81\x7a\xb6\x91\x6a\xb8\xe0\x8f\xc2\x70\x0e\x0b\xbf\x6b\x4a\x81\xb0\xbc\x57\x63\x99\x30\x84\x33\xfc\xb7\x62\xd8\x44\x3e\xe4\x49\x0f\x7a\x40\x75\xac\xc6\x2b\xec\xc9\x7a\x1d\x62\x84\xce\xd4\x73\x4a\x97\x10\x0d\xd9\xa4\xe0\x33\x22\xcc\x6a\x4d\xf5\x29\x53\xf0\x6e\x58\xe0\x5e\x26\x0a\x17\x8f\x48\x36\x11\xd7\x0e\x94\x03\x45\xb0\x91\x1b\x5d\x45\xfb\x16\x4b\xf6\x36\xa9\x27\xf4\x79\x49\x68\x0e\x12\x9a\x9b\x04\x75\xcb\xfe\x88\x54\xaa\x0a\xce\x20\xce\xb5\x45\x74\x6c\x7d\xc1\x97\x58\xf7\xc4\x35\x21\x76\xc4\xd4\x72\x6a\x49\xb7\x6c\xfd\x2f\x84\x45\x79\xeb\xb5\x3b\x19\xa4\xeb\x8d\x29\xe2\x78\x58\xa3\x09\x11\x64\x73\xa5\x7e\x6f\xe1\x71\x46\x14\x6b\x4f\x2f\xd3\x64\x1b\xd1\xab\xb5\x0e\x37\xa9\xda\xd1\xd7\xa3\x2d\x5a\xf0\x5c\x49\x49\x40\x3d\xc8\x67\x7f\xea\x6a\x44\xe9\xc6\x59\x22\x5f\xe8\xb9\x4b\xc4\x71\x12\x6a\xb1\xba\x8b\xc7\xe2\x65\xe7\xd6\x8a\x4f\xb9\x85\xcf\x56\x97\xae\xa8\x87\xc9\x14\xdf\xd1\xa2\xe5\x54\xd1\xad\xa1\xb8\xef\xe1\x0d\xcc\x64\x1b\x55\xda\x35\x05\x4a\x52\x2a\x3b\xba\x1b\x07\x32\x08\xfa\x18\x34\x52\xc2\x35\x17\xb1\xdc\x9c\xbe\x24\xaa\x41\x26\xf8\x97\x99\x5c\x48\x28\x44\xab\x20\x2d\xf0\x32\x5d\xef\x55\xff\x37\x68\x84\xb5\x74\x43\xe4\x43\xc4\x5f\x27\xa4\x2c\x71\x6c\x4e\xb3\x33\x56\xeb\x65\xd4\xd9\x5c\xac\x28\xd8\xeb\x7f\xcf\xbd\xc2\x78\xf9\x2c\xef\x45\x8b\x8a\xb6\x0b\xe7\x65\x24\x5c\x27\x8e\x8a\x10\x3d\x63\x99\x43\xd4\x05\x23\xe3\xd3\x8c\x2a\xf6\xc1\xfa\x62\xd1\x88\x1b\xdc\x3a\x82\x9f\x92\x68\x93\xda\x70\xf1\x63\xfc\xaf\x53\x59\xaa\x94\xf5\x95\xf0\x2c\xa1\xa5\xa7\x89\xc9\x07\x7f\x78\xf5\x37\x62\xa0\x94\x39\x9f\x6e\x04\x99\xd5\x4d\xd6\xeb\x5b\x37\xc9\xa4\x11\x1f\xa8\xbb\xde\x9c\x46\x05\xe7\x8c\xae\xcf\x49\x24\x94\x73\xb2\x79\x55\x86\x85\x34\xb3\x0e\x67\xe9\x50\xaa\x87\xc5\x89\x03\xb2\x68\xac\x72\x8b\x81\x74\x38\x39\x43\xf5\x4c\xc1\x32\x4a\xe1\x67\xd3\xca\xd2\x90\xec\xc3\x29\x53\x0d\x91\xc2\x20\x43\x67\x19\xa2\x6f\x64\xc4\xab\x4b\x2b\x6a\x17\x78\x49\xf6\x0a\x66\x02\x92\x78\xa1\x08\x32\x10\x67\x62\xe7\x46\xfd\xf7\xdf\x59\x49\x42\xe5\xfd\x30\x98\x3f\x5f\x51\x1f\x16\xeb\x89\x67\xcb\x67\x76\xab\xff\x20\x72\xea\xdf\x7b\x26\xaf\x30\xa2\xd4\x75\x9d\xf0\x51\xda\x52\xb3\x17\xd2\x0a\x1b\x6c\xc6\xa9\x26\x4c\xa6\x6c\x9d\xbb\xba\xca\x8c\xf9\x7f\x91\xac\xd2\x0c\x20\xf4\x4c\x43\xe9\x8c\x38\x2e\xac\xd0\xd0\x97\xc9\xdf\x29\x73\x1c\x77\xa3\x64\x7d\xbb\x1d\x85\x60\xe5\xf1\x9c\xcb\xde\x91\x5f\x43\x51\x6f\x5a\x87\xe3\x18\xae\x87\xfb\x4e\x24\x8c\xed\x22\x2b\x62\xf7\x9e\x36\x7f\x6c\xf7\xf4\xf6\xd3\xbb\xcd\x4e\x55\xfb\x9d\x62\xe7\xea\xf0\xfc\xab\xd2\x35\xe9\x5a\x69\x72\xf3\x5f\xa5\x36\xcf\x56\x5c\x61\xf0\xe2\x03\xd8\x5b\x7f\x54\x3f\xbc\xb8\x97\x62\x29\xf3\xf5\x4f\xe5\x68\x64\xae\x65\x59\x0f\x37\xf7\x06\xe4\x4a\xbd\xfe\xf9\x9b\xd7\x93\x29\x73\x98\x34\xaf\x54\xb5\xdf\xa8\x7f\x06\x00\x16\x96\x11\x34\xc9\x08\x00\x00")
+
+func vaultedAudit1Bytes() ([]byte, error) {
+	return bindataRead(
+		_vaultedAudit1,
+		"vaulted-audit.1",
+	)
+}
+
+func vaultedAudit1() (*asset, error) {
+	bytes, err := vaultedAudit1Bytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "vaulted-audit.1", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _vaultedCp1 = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x53\xdf\x8b\xda\x40\x10\x7e\xcf\x5f\x31\x4f\x45\x41\x03\xbe\xf6\xcd\xaa\x60\xe0\xaa\xc1\xd8\x1e\x07\x81\xb2\x26\x93\xcb\x70\x71\x77\xbb\x33\x49\x9a\xff\xbe\xec\x5e\xa2\x08\xd7\xbe\xdc\x9b\x38\xdf\x7e\x3f\xe6\x9b\xc4\xe7\x3d\x74\xaa\x6d\x04\xcb\x7c\x59\x58\x58\x45\x71\xb6\x87\xc3\xfa\xfb\x2e\x8a\xd3\x34\x1a\x47\x50\x58\xc8\x97\x50\x18\x4b\xc8\x20\x35\x42\x61\xb4\xa0\x16\x30\x15\xa8\x77\x02\x50\xba\x04\x56\x1d\x32\x90\x80\x62\x50\xa0\xb1\x1f\x67\x3d\x49\x3d\xfe\x61\x15\x73\x6f\x5c\x19\x84\xb2\x97\xc3\x31\xcd\x92\x2c\x88\xe5\xd5\xb7\xbc\xda\xdc\x25\xf3\xea\x04\x79\x95\x98\xa6\xcc\xab\xd4\xff\xd2\xd8\xe7\x55\xfa\x11\xd6\xd8\xe1\x9f\xe8\x6c\x0f\xdb\x5d\xb6\x39\x25\xe9\x39\x39\x1e\xc2\xeb\xcd\xe8\x9e\x74\x08\x73\x03\x8f\x6e\x89\xa1\x70\xa8\xbc\x0b\xe3\xc0\xa1\x6d\x54\x81\x25\x5c\x86\x5b\xec\xca\x99\xeb\x5d\x2d\xff\x12\xc3\x5a\x47\xf8\x87\x58\x48\xbf\x7e\xc4\x77\x23\x41\x2d\xe4\xb0\x19\xbe\x02\x09\xc3\x1b\x0e\xc0\x8d\x11\x5e\x80\xc3\x82\x2c\xa1\x16\xf6\x9b\x8c\x1c\x16\xa6\x43\x37\x04\x88\x72\x08\x0e\xaf\xa6\xc3\x72\xe1\xa7\xc1\xb5\x75\xd8\x91\x69\xf9\x2e\xf2\x86\x56\x80\x74\x60\xae\x89\xc5\xb8\x21\x86\x73\x8d\xd1\x15\x45\x95\x4a\x94\xaf\xeb\xbe\x23\x1f\xd3\x37\x5a\x2e\xa0\xd5\x0d\x72\x28\xae\x57\x0c\x45\xad\xf4\x2b\x96\xa1\x35\xd3\x0a\x18\x8b\x7a\xcc\x35\x3e\x9d\x31\xe2\x74\x1c\xf9\x72\xa2\x9f\xad\xe6\xf3\x38\x2c\x38\xa9\xc6\xc5\xfa\x46\x7f\xae\x7f\x3c\x9d\x77\xdb\x5f\xe9\x3a\xcb\x9e\x8f\xa7\xad\x6f\x0a\x75\x47\xce\xe8\xab\xbf\xa1\x4e\x39\x52\x97\x06\x7d\x04\x46\x59\x04\x1b\xd4\x34\x70\x41\x68\x19\x4b\x7f\x4c\x52\x63\x34\x5d\x0e\x54\xc6\xdd\xbd\x2c\xc0\x48\x8d\xae\x27\xc6\xa0\x79\x43\x4d\x14\x0e\x7f\xb7\xc8\xbe\xcc\x8e\x54\x80\x88\x0c\xff\xb1\x79\xd8\x3d\x7f\xc6\x6a\xf4\x60\x62\xb4\xfa\x7e\x0e\x9f\xb1\x7a\xae\xf1\xe1\xf3\x81\x6b\xcb\x02\xac\x84\xb8\x1a\x1e\xd9\xac\x69\xa8\x18\x42\x47\x30\x05\x81\xf4\xf8\x94\x6c\x5e\x80\xf4\x54\xdb\x6c\x35\x9f\xc7\xd1\xdf\x01\x00\x0c\x01\x7f\x24\x00\x04\x00\x00")
 
 func vaultedCp1Bytes() ([]byte, error) {
 	return bindataRead(
@@ -120,6 +173,26 @@ func vaultedCp1() (*asset, error) {
 	return a, nil
 }
 
+var _vaultedDiff1 = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x54\x4d\x6f\xe3\x36\x13\x3e\x2f\x7f\xc5\x9c\xf6\xb5\xdf\xca\x44\xb3\x40\x7b\xd8\x5e\x9a\x26\x06\xe2\x02\x1b\x1b\x96\xd1\x0f\x94\xc5\x82\x12\x87\x11\xbb\x34\x29\x70\x28\x6b\x7d\xe9\x6f\x2f\x48\xd1\xb6\x92\x43\x7b\x30\x60\x71\x66\x9e\xf9\x7a\xe6\xe1\x87\x27\x38\xc9\xc1\x46\x54\x62\xa5\x8c\xd6\x70\xc7\x78\xfd\x04\xcf\xf7\x9f\xd6\x8c\xef\x76\xac\x18\x21\xdb\xc4\x0a\x5a\x7f\xec\x65\x40\x82\xd8\x21\xb4\xde\x45\x74\x11\xbc\x86\x38\xfa\x09\x88\x72\x7c\xfd\xfb\xf3\x76\x57\x6f\xea\x8c\x21\xf4\x4f\x42\x3f\xcc\x91\x84\xde\xc3\x1f\x42\x6f\xb6\xbb\xc3\x66\xfb\x5c\x0b\xbd\xfb\x13\x84\xde\x78\xab\x84\xde\xa5\x7f\x0e\x47\xa1\x77\x19\xea\x71\x5d\x3f\xec\x37\xd9\x31\xa3\x3d\xfc\x67\x05\x15\x58\x43\xd1\xb8\x97\x5c\xe4\x49\x06\x23\x1b\x8b\x54\x41\x5d\x3f\xc1\x17\x3c\x53\x05\xf7\xbf\xd6\xe9\x1f\xd3\x06\xad\xa2\x0a\xd4\x10\x64\x34\xde\x81\x74\x0a\x8c\x6b\xed\xa0\x72\x8f\x32\xc2\x88\x01\x41\x2a\x85\x0a\x16\x53\x27\xdf\x08\xbd\x5f\x56\x10\xf0\xe8\x4f\xb7\x57\xb1\x4a\xcf\xe0\x03\xb4\x9d\x74\x2f\xa8\x58\x31\xfc\x9d\xdf\x8d\xbb\xf5\x75\x99\xa2\x82\xe8\x6f\x7d\x8b\xf7\x3c\x37\xb8\x96\x6d\x97\x46\x7a\x1b\x48\x2a\xea\x16\x6c\x08\xbc\x43\xf0\xfa\x23\xe3\xfb\x9a\xf1\xcd\x0e\xc4\xa2\x19\xe0\x03\x3b\x74\x08\x4e\x1e\x93\x0d\xe4\x34\x0c\x3e\xb7\x27\x0c\x79\x44\xa1\x77\x53\x65\x3f\x0a\xbd\x17\x7a\x13\xf0\x64\xc8\x78\x27\xf4\xae\x02\x09\x97\x4f\xd0\xc1\x1f\xf3\x08\x3b\x43\xd1\x87\xf3\x0c\x16\x16\x84\xc8\xf8\x7e\x22\x09\xdc\x38\x54\x5c\x17\x77\xcb\x25\x87\x8d\xce\xe1\x57\xc0\x51\x12\x90\x4c\x33\x1b\x4d\xec\x40\x66\x56\x61\x48\x0b\xec\x25\xd1\xe8\x83\x62\x90\xa6\xee\xca\xe6\x06\x1b\xff\x47\xd0\x0e\xe1\x95\x4f\x05\x67\x3f\xc0\x68\xac\x05\x69\xc9\x43\x83\xd0\x07\x7f\xec\x13\xbb\xb4\x0f\x29\x96\xc1\xd5\xfb\xdf\x4a\xe0\xec\xff\x30\xdb\x5e\x95\xf6\x11\x50\xaa\x6b\x9b\x79\x04\x14\x95\x71\x20\x09\x7e\xae\xb7\xcf\xb0\x30\x53\x75\xda\x87\x63\xa2\x47\x30\x31\xa2\x83\xe6\xcc\x00\xde\x30\x7d\x38\xf6\x69\xf7\x1c\x0e\x9d\x21\x30\x04\x03\xa1\x1e\x6c\xca\x42\x88\x30\x76\x32\xbe\x09\xb1\x5e\xaa\x74\x1c\xa3\x1f\xac\x2a\x44\x9a\x58\xf1\x8b\xb4\x03\x12\xc8\x80\x10\x50\xc9\x36\xa2\xaa\x80\x7c\x2e\xc5\x0f\xb1\x1f\x62\x4a\x40\x52\x63\x86\xef\x64\xc0\x8f\x57\xee\xc3\x29\x47\x67\xde\x33\xc2\x36\x60\x4c\xf4\xa7\x4c\x77\x42\xca\xdb\x89\xfe\x0b\xba\x29\x03\x75\x7e\xcc\x1d\x4f\xd5\x2d\x2e\x19\x97\x89\x30\xef\xf9\xf5\x90\x66\xce\xcd\x99\xa5\x52\xea\xa7\xfb\x0f\xdf\x7d\x0f\xda\xb8\x17\x0c\x7d\x30\xe5\x36\x3b\x34\x01\xfa\xa1\xb1\xa6\x4d\x81\x55\x4e\x5c\x8e\x10\x36\x8f\x04\xcd\xb9\x38\x59\x49\x11\xb4\x1f\x02\x6b\x3b\x19\x52\x9f\x81\x78\x16\x82\x22\x16\x8c\x1f\x2e\x92\x22\x56\x62\x95\x4a\x15\xab\xa9\x27\x12\x7a\xcf\xea\xce\x8f\x54\xf8\x93\x9a\x4e\xf9\xaf\x1a\x30\xcf\x4b\x60\x1c\xc5\xb4\x6d\xaf\xcb\x4c\x8b\x66\x1c\xf9\x9b\x24\x7f\x51\xba\x8e\x3d\xdb\xe6\x41\x4f\xe8\x17\xee\xb6\x69\x2b\x04\x72\x62\x47\x52\x1e\x0e\xf9\x84\x6f\x0e\xd0\x65\x87\xa9\xe8\x69\xa9\x09\xad\xe8\x43\xd6\x96\xcc\xbe\xe9\xbb\xe8\x4a\xa2\x81\x0f\xaf\x82\x12\x35\x96\xd5\x15\x29\x8b\x57\x72\x2b\x40\x27\x19\x68\x86\x43\xd4\x7d\x4e\x4b\x9e\x3d\xc9\x91\xd2\x53\x7a\x29\xdd\x5d\x84\x6f\xe6\x74\x91\xbf\x57\x05\x94\xc7\xcf\x37\x84\x54\x88\x53\x79\x14\x13\x54\x01\x2e\x01\x59\xd3\xf6\x45\xba\x92\x39\x4b\xdf\x9e\x95\xa5\x2c\xc6\x0e\xf3\x1d\x9d\x41\xf6\xbd\x3d\x2f\xa7\x1d\xaf\x7f\xdb\x1c\xe0\x61\xfb\xb8\xae\x19\x3f\xd4\x4c\x5a\xdb\xf8\xaf\x3f\xb0\xb6\x81\xb6\x61\x2d\xd8\xfc\xe3\x6c\xfd\xd5\x44\x68\xbd\xc2\x77\x9f\x50\x3a\xe3\x5e\xd8\xb7\xef\x0e\x17\xc5\x98\x28\x6c\x14\xba\x68\x5a\x69\x39\xbb\x9b\xdb\xa6\xad\x70\xc6\x0f\x6b\xf6\xcf\x00\x9d\x06\xa8\x84\xfe\x06\x00\x00")
+
+func vaultedDiff1Bytes() ([]byte, error) {
+	return bindataRead(
+		_vaultedDiff1,
+		"vaulted-diff.1",
+	)
+}
+
+func vaultedDiff1() (*asset, error) {
+	bytes, err := vaultedDiff1Bytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "vaulted-diff.1", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
 var _vaultedDump1 = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\xcd\x41\x8a\x83\x30\x14\xc6\xf1\x7d\x4e\xf1\x5d\xc0\xc0\x1c\x61\x46\x05\x33\x30\x1a\x8c\x9b\x81\x6c\x42\xcd\xa3\x42\x93\x88\xbe\xb4\xd7\x2f\xa6\x5d\x94\x76\xf9\xf8\x78\xbf\xbf\x9c\x3a\x5c\x5d\xbe\xb0\x9f\x6d\x35\xe7\xb0\xe2\x4b\x48\xd3\xa1\xff\xfe\x6b\x85\xd4\x5a\x3c\x47\x94\xcd\x56\xb8\x6d\x0b\xfb\x1d\x7c\xf6\x38\xa5\xc8\x3e\x32\x12\xc1\x3d\x10\x70\xc2\xce\x73\xca\x0c\xb7\xe3\xd7\x0c\x7d\xc1\xcc\x7f\x3f\x68\xa3\x4c\x01\x2d\xfd\x58\xaa\x5f\x59\x4b\x23\x2c\xa9\xe8\x82\xb7\xa4\xcb\x47\xd3\x9a\x7a\x54\x7a\x52\x87\xa0\xb5\x68\x72\x58\x3f\xa2\xc7\xf9\x9e\x5d\x62\xc9\x82\xd2\x16\x1c\x4b\x71\x0f\x00\x00\xff\xff\xbe\x1d\xa8\x5d\xe0\x00\x00\x00")
 
 func vaultedDump1Bytes() ([]byte, error) {
@@ -140,7 +213,7 @@ func vaultedDump1() (*asset, error) {
 	return a, nil
 }
 
-var _vaultedEdit1 = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x54\xdb\x6e\xdb\x46\x10\x7d\xdf\xaf\x98\xa7\xc6\x01\x64\x16\xe9\x1f\x28\xb1\x5c\x0b\x89\x6c\x41\x54\x11\x04\xe0\xcb\x90\x3b\x14\xb7\xd9\x8b\xba\x17\x31\xfa\xfb\x62\x86\x94\x2c\x1b\x05\xfa\xba\x33\x73\xf6\x5c\x66\xb7\xda\x3f\xc1\x09\x8b\xcd\xa4\x9b\x7b\xd2\x26\xc3\x27\x55\xd5\x4f\xf0\xbc\xdc\xac\x54\xb5\xdd\xaa\xb9\x08\x52\x6b\xee\xc1\xf8\x4c\x11\xbb\x6c\x4e\x64\xcf\x72\x9a\x20\x0f\x04\x5d\xf0\x99\x7c\x86\xd0\x03\x7a\xa0\x5f\x26\x65\xe3\x0f\x13\xb6\x20\xd6\x3f\x9e\x5f\xb6\xf5\xba\x16\xd4\xa6\xff\xdc\xf4\x5f\x6e\xb1\x9b\x7e\x07\x4d\xbf\xf6\xe8\xa8\xe9\xb7\x32\xf1\xb0\xaa\xbf\xec\xd6\xdb\xfd\xfa\xe5\x59\x86\xea\x23\x8e\x3e\x31\xfc\x85\xc4\x89\xc0\x05\x4d\xd0\x87\x28\x20\x7c\xe3\xff\x91\xa9\x04\xeb\xaf\x63\xf0\xf0\x4f\x31\x99\x0b\x0b\x19\xf2\x34\x5e\x07\x4d\x82\x84\x27\xd2\x90\x83\xd4\x2e\x93\xf5\x13\xfc\xf9\xed\xe5\xf3\xf2\x9b\xaa\x76\xb5\xaa\xd6\x5b\x68\xee\xda\x02\x7f\xa8\x9a\xbd\xa9\x87\x30\xfe\xfe\x64\x34\x41\x4d\x5d\xa4\x9c\x54\xd5\x46\xb5\x0f\x87\x83\xa5\x04\xe3\x40\x79\xa0\x08\x49\x6a\x70\x42\x5b\x28\x01\x46\x02\x6d\xd2\xd1\xe2\x99\x34\xf7\x78\x38\x19\x1a\xaf\x74\x41\x53\x46\x63\x93\x32\x5e\x98\x48\x0e\x8e\x7c\xa9\x60\x3f\x30\x4d\x12\x09\xcc\xf8\x60\x43\x8b\x16\xd0\x6b\xc0\xbe\xa7\x6e\x4e\x86\x7c\x36\x91\x2e\xfe\xa8\x44\x29\x99\xe0\xa5\xcd\x24\x88\x94\x28\xb3\xcc\xc1\x68\x4d\x1e\x08\xbb\x01\xb2\x71\xf4\xaa\x7b\x6a\x0b\x47\xf2\xa4\xd9\x6a\x35\x43\x55\xaa\xda\xad\xc4\x93\xe5\xf7\x1a\xbe\xae\x7e\xbc\x37\xe5\x27\x9b\xf2\x95\xce\x62\xc3\x06\x3d\x1e\x28\xc1\xb2\xeb\x28\x25\x3e\x86\xf5\x83\xb0\x98\xcc\xba\x2d\x74\x91\x34\xd3\x46\x9b\xaa\x5b\x40\xc7\x80\x9b\xc7\xe5\x1b\xc0\xcd\xe3\x12\xee\x5c\xb1\xd9\x34\xf7\x3d\x76\x39\x44\xc0\x92\x07\x9e\xef\x30\x9b\xe0\x3f\xc2\x72\xf7\x0c\x81\x9d\x8f\x06\x2d\xf8\xe2\x5a\x8a\x15\xac\x7b\x20\x8f\xad\x25\xbd\x50\x25\x51\x84\xd1\x58\x0b\x2d\xc1\x31\x06\x77\xcc\x53\xfa\xc4\xab\x26\x77\x74\xbc\x69\x12\x10\x0a\xd3\xd7\x8d\x92\x32\x0f\xab\x48\x0e\x0d\x37\xf0\xfb\x90\xb5\x7c\x75\x51\x97\x28\x74\x2a\x61\xbf\xee\xe1\x1c\x8a\xc4\x5f\x04\xaa\xde\xd7\xb7\xba\x17\x30\x0e\xa6\x1b\x20\x74\x5d\x89\x09\xda\x33\x68\xea\x05\xe7\x2e\xd1\x14\xce\x87\xfc\x41\x85\x23\x43\x42\x4b\x36\x8c\x72\xdf\xbc\x2e\x1f\x17\x02\xef\x4a\xca\x30\xe0\x89\x84\xe2\xac\x96\x65\x19\x7f\x0a\x3f\x09\xd0\x9f\x61\xbd\xdc\x40\x87\xf6\x9d\xd5\x91\xad\xde\x05\x4b\xc2\x56\x0c\xec\x21\x06\x4b\x3c\xdd\x12\x60\x4a\xc5\x5d\x36\xf6\xbd\x21\xea\xbb\x9c\x72\x0b\x1f\xa2\x0c\x4e\xaf\xcc\xe1\x2f\xe3\x8a\xbb\xba\x01\x68\x6d\x18\x49\xb3\x42\x5e\x23\x93\xe0\x13\x0c\xa1\x4c\xf9\x9c\x43\x89\xea\xda\xca\x3b\x1e\x09\x39\x90\x3c\xa0\x9f\x1b\x27\x0a\x97\x77\x70\x7b\xd7\x75\x70\x0e\x56\xa1\xfe\xbb\xa4\x39\xd8\xf9\x96\x5b\xcd\xf2\xc1\xd5\xa5\x4d\xd9\xe4\x92\x09\x46\x93\x07\xc8\xe4\x8e\x21\x62\x7c\xb3\x95\xff\xf9\xb0\x99\xac\x68\xb8\x69\x94\x80\xd3\x15\x52\x4f\x98\xc8\x74\xd9\xd0\x2b\xb8\xba\x5d\x79\x78\x0c\x11\x5c\xe0\x9f\x61\x4a\x13\x02\x3f\x7e\x93\x78\x33\xd9\xe9\x05\x5c\x76\x40\x87\xae\x38\xf2\x79\xd2\xc9\x8f\xf3\xed\xd7\x9a\x06\xb2\xb6\xe9\x77\xcd\x6f\x6f\x94\x3e\xb0\xd2\x07\xb2\x94\xa7\x7c\x77\xe4\xc2\x89\x7f\x23\x6b\x45\xc1\xe5\xde\x94\x43\x24\x0d\xf3\xdf\x73\xf9\x05\x77\x2b\xf5\x6f\x00\x00\x00\xff\xff\xf3\x29\xcb\x6c\x38\x06\x00\x00")
+var _vaultedEdit1 = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x57\xdd\x8e\xe3\xba\x0d\xbe\xd7\x53\xf0\xa2\xe8\x66\x80\x8c\x8b\xed\x1b\xe4\xec\x64\x77\x82\xdd\xf9\x41\x3c\xdb\xed\x41\x53\x2c\x14\x8b\x8e\xd5\xc8\x52\x2a\xca\xf1\xfa\xed\x0b\xd2\x8a\xc7\x99\x33\x45\x7b\x35\x03\x4b\xa4\xc8\x8f\x1f\x3f\x32\xc5\xcb\x3d\x9c\x75\xe7\x12\x9a\xdd\x2d\x1a\x9b\xe0\xa3\x2a\xca\x7b\x78\x5c\x3d\xac\x55\xf1\xfc\xac\xf2\x21\xc8\xd9\xee\x16\xac\x4f\x18\x75\x95\xec\x19\xdd\x20\x5f\x09\x52\x83\x50\x05\x9f\xd0\x27\x08\x35\x68\x0f\xf8\xcb\x52\xb2\xfe\x30\xfa\x16\x8f\xe5\xef\x8f\x4f\xcf\xe5\xa6\x14\xaf\xbb\xfa\xb7\x5d\xfd\x69\xee\x7b\x57\x6f\x61\x57\x6f\xbc\x6e\x71\x57\x3f\xc3\x3f\x76\xf5\xe6\xe9\xf9\x65\xf3\xf4\x58\xee\xea\xe7\x7f\x8a\x87\xbb\x75\xf9\x69\xbb\x91\x8f\xe2\xa4\x3c\xe9\xde\x13\x3f\x77\x09\xea\x8c\xd0\x06\x83\x50\x87\x28\xa1\x71\x04\xff\x2b\xb8\x42\x7c\x7d\x3f\x05\x0f\xff\xee\x6c\xe2\x83\xa5\x64\xe4\xb1\x9f\x0c\x2d\x01\xe9\x33\x1a\x48\x41\xce\x66\x96\x9b\xfa\xf5\x0b\xf4\x9a\x38\x02\x5b\x5b\x34\xb0\xc0\xe2\x50\xc0\x7e\x80\xeb\x6c\x5d\xd0\x86\xb3\x0d\x11\xea\x18\x5a\xd0\x3e\xa4\x06\x23\x24\x8c\xad\xf5\xda\xdd\xa8\xbe\xb1\x0e\xc1\x8e\xee\xf6\xc8\x59\x70\x36\x68\x96\xb3\xa7\x2c\x81\x0f\x29\x87\xa5\xbb\x14\x5a\x9d\x6c\xa5\x9d\x1b\x0a\xd8\x78\x4a\xa8\xcd\x12\x86\xd0\x29\x1d\x11\x0e\xf6\x8c\x5e\x8c\xab\x26\xd8\x0a\x39\x0f\x6a\x42\x0f\x7d\x63\xab\x06\x4e\x3a\x26\x82\x30\xcf\xc4\xd8\xba\xc6\x08\x0b\xc2\x2a\x62\x82\xb3\x76\x1d\x12\xe8\x88\xca\xe3\x19\x23\x18\x4b\x27\xa7\x07\x34\x37\x4b\x08\x67\x8c\x7d\xb4\x09\xe5\x85\x31\xa2\x8c\x87\x4d\x0d\x07\x31\x96\x83\x96\x10\x22\xe8\x7d\x88\x09\xb4\x37\xca\x58\xaa\x74\x34\xb3\x0b\x05\x3c\x65\x5f\x9c\xf4\x11\xf1\x44\x6f\x7d\x7e\x20\x38\x69\xa2\x3e\x44\x03\x8b\x21\x74\x12\xd3\x29\x86\xf6\xc4\xe0\x72\xe5\x6d\x02\x5b\x5f\xe0\xab\x1a\xed\x0f\x12\xa5\xf6\xf2\x12\xbc\x8b\x87\x3e\x68\xeb\xd9\x2c\x35\xa8\x26\x80\xa7\x52\x06\x5f\x31\xb5\x22\xc2\x1e\xeb\x10\xa5\x38\x17\x4e\x5c\xd1\xc0\x20\x55\xd1\x9e\x92\x0d\x7e\x09\x49\x1f\x88\x33\x4e\xa8\xdb\x6b\x74\x7b\x8c\x78\x09\x0d\x7a\x9b\x9a\xd0\x25\x08\x27\xf4\x9c\xb6\x4d\x6a\x41\x98\xaf\x72\x5f\xb6\x98\xb4\xd1\x49\x2f\x3e\xde\xdc\x2c\xa7\x1c\x34\x1d\xd9\xb8\xc1\x91\x3d\x41\xe0\xca\x39\x71\xce\x74\x89\xf5\x2a\x25\x41\xb2\x80\x4f\xf2\x36\x83\xab\x13\x23\xe2\x3f\x24\x38\xe2\x49\xfe\x87\x5c\x97\x4b\x6a\xe5\x40\x09\xdb\x31\x1c\x82\x45\xfe\x6b\x3d\x25\xed\x1c\x1a\xb0\x3e\x33\xfc\x4f\x7f\xbf\xfb\xf2\xf3\x6e\xf5\xb2\xfa\x79\xb7\xd9\x96\xbb\x7a\x7b\x23\xee\x22\x6a\xb3\xbb\x0d\x9e\x99\xb9\xce\x5d\xa9\x15\xcd\xbc\x32\xd6\x11\xeb\x8e\xd0\x40\xe7\x1d\x12\x65\x8f\xbb\xdb\xdd\x6d\x1d\xe2\x91\xfb\x85\x83\x3f\x61\x25\xbd\x55\x88\x20\x64\x81\x50\xc5\xcb\xb3\xfa\xc3\x7d\x55\xea\xb3\x24\x88\xb9\x79\xf2\x53\x29\x30\x82\x11\x42\xef\xa7\x94\xde\xc9\xe0\xfe\xe9\x61\x2d\x19\x70\x9e\xa8\x0d\x84\x5a\xb1\xaf\x79\xd8\x4b\xa0\x46\x9b\xd0\x5f\x64\xe6\x2a\x25\xe9\xed\xd4\xa0\x87\xe0\xc7\x70\xbf\x7c\x7b\xfa\x6d\xf5\x4d\x15\xdb\x52\x15\x9b\x67\xd8\x2d\xf6\x1d\xfc\x55\x95\xb0\xbb\x85\xb2\x09\xfd\x5f\xee\xad\x41\x28\xa5\xdf\x48\x15\xfb\xa8\x5e\xc2\xe1\xe0\x90\xa6\x1a\xff\xa1\x17\x5f\xbb\x90\xef\x78\x38\x5b\xec\x27\x61\x03\x83\x49\x5b\x47\xca\xfa\x09\x05\x68\xd1\x77\x05\xbc\x34\x0c\x26\x8a\xd8\x31\xf6\x07\x17\xf6\xda\x71\x4f\x82\xae\x6b\xac\x52\xc6\xcd\x27\x1b\xf1\xa2\xa4\x8a\x90\xc8\x06\x2f\xd7\xa4\x60\x84\x89\x79\xd7\x58\x63\xd0\x03\xea\xaa\x81\x64\x5b\x9c\xf1\x5c\xae\x31\xaf\xc7\xd6\x54\xd9\x55\xa1\x8a\xed\x5a\x30\x59\xfd\x28\xe1\xeb\xfa\xf7\xb7\xa0\x1c\x19\x94\xaf\x38\x08\x0c\x0f\xda\x6b\xa6\xf3\xaa\xaa\x98\x19\x5f\x71\x80\xcd\x9d\x44\x31\x82\x35\x3f\xa8\x22\x1a\xf4\xc9\x6a\x47\xc5\xdc\x61\xcb\x0e\x1f\x3e\xaf\xae\x1c\x3e\x7c\x5e\xc1\xa2\xed\x5c\xb2\xbb\xdb\x5a\x57\x89\xc5\xa9\xe3\x92\xb1\x90\x26\x1b\xfc\x0d\xac\xb6\x8f\xdc\xc1\x84\xd1\x6a\x07\xbe\x6b\xf7\x18\x0b\xd8\xd4\x80\x5e\xef\x1d\x9a\xa5\xea\x08\x23\xf4\xd6\x39\xd8\x23\x4c\x3a\x94\x02\x20\x0f\x25\x79\xa3\xe2\x99\x24\x05\xd2\x12\xe9\xeb\xec\x91\x63\x36\x56\x11\x5b\x56\xa0\x71\xb2\x32\x56\x33\x14\x4d\x17\x25\x9c\x42\xa2\xdf\xd4\x93\x04\x74\xe2\xaa\x7c\x29\xe7\x79\x2f\xb3\xa8\x87\xaa\xea\x22\xf1\xfc\x31\x58\x8b\x1f\x51\x15\x76\xfb\x21\x7d\x50\x41\x44\x0a\xf6\xe8\x42\x2f\xef\x65\xba\x64\x85\x69\x3b\x4a\xd0\xe8\x33\x4a\x88\x39\x5b\xae\xb6\xf5\xe7\x70\x44\xd0\x7e\x80\xcd\xea\x01\x78\xe2\x5c\x43\x1d\x19\xea\x6d\x70\x28\xd1\x0a\x80\x35\xc4\xe0\x64\xe8\xec\x59\xb7\xa8\x6b\xd1\xbc\x0f\x88\xfa\x21\x5f\x89\xba\x96\x3f\x6a\x31\x1c\xc7\x5e\xab\x7f\xd9\xb6\x6b\x27\x34\x40\x3b\x17\x7a\x34\x9c\x21\xd3\xc8\x12\x7c\x84\x26\x74\x63\x7d\xb8\xc7\xd5\x74\x95\x39\x1e\x51\x73\x41\x52\xa3\x7d\xbe\x38\x86\x70\xe9\x83\xf9\x5b\x93\x61\x2e\xac\xd2\xe6\x5f\x1d\xe5\xc2\xe6\x57\xe6\x39\x27\xce\xb9\xec\xf6\x94\x6c\xea\x12\x8a\xa4\x43\xc2\xf6\x14\xa2\x8e\x57\xac\x7c\xb7\xb1\x39\x58\x58\xfd\xb8\x2a\xa3\x14\x98\x26\x97\x66\xf4\xa9\xb9\x6d\x65\x96\x5c\x9c\xab\x99\x4d\x01\x9f\x43\x1c\xe7\x54\xae\x26\x04\x6e\x7e\x4b\xcc\x4c\x46\x7a\x09\x17\x0e\x98\x50\x75\x2d\xfa\x24\xcc\x62\x02\xa8\xeb\x35\x85\x1a\x74\x6e\x57\x6f\x77\x7f\xbe\xaa\xee\x1d\x67\x7a\x87\x0e\xd3\x58\xdf\x2d\xb6\x81\x75\x56\x3b\x27\x19\x5c\xde\xa5\x14\xe2\x38\x1b\x26\x1e\xbf\x76\xfd\xe6\xf1\xd3\xb7\xef\x77\xeb\x71\x17\x5c\x65\x96\x57\xb2\xc8\x55\xae\x33\x08\xe3\x46\x94\xe5\x79\x3f\x00\xaf\x85\x4b\xa0\x30\x2d\x64\xd4\x68\x76\xbf\x1f\x80\x78\x1d\xd1\x2e\x5f\x56\xe3\xd2\x75\x8a\xe1\xd7\x70\xa9\xac\xcc\xe0\x88\x07\x4b\x29\x0e\x90\xc2\x11\x3d\xdd\x00\xcf\x23\x68\x34\x65\x56\xe6\x78\x79\xd0\xb3\x3e\x72\xc8\x91\x94\x88\x4c\x79\x0f\x47\x1c\xa6\xfd\x28\xc7\x98\xd7\x91\x51\x87\x5b\x8c\x07\xc9\x76\xbe\x1f\x7e\x60\x8d\x15\xc9\xa4\x42\x6d\xde\xb1\xea\xbc\x0b\x15\x0f\x71\x4d\xe0\x11\xf9\x74\x31\x2a\x88\xf5\x87\x8b\x06\xd8\x38\xed\x3b\x94\xb7\x98\x4a\x7b\xf5\x2e\x52\xa9\xc1\x96\xd0\x9d\x91\x96\xa0\x09\x5c\xf0\x07\xfe\x3b\x8b\x9a\xc0\x04\xd9\x1b\xeb\x10\x5b\xd0\x50\x0d\x95\xc3\x71\xcc\xff\xed\x35\xb0\x7c\x79\x2a\x5f\x88\x46\x3a\x07\x07\x89\xdb\x59\x6e\x05\xa9\x87\x93\x9e\x9a\x9c\x27\x7d\x44\x75\x8a\x58\x31\x25\x2b\x94\xcd\x10\x50\x47\x67\x31\x42\xf0\x48\x17\x6c\xc7\x45\x6e\x9c\xc1\x91\xe0\x0a\x67\x76\x02\xaf\x4e\x94\x38\x61\x7e\x05\xde\xea\x68\x4a\xa5\x78\x3b\x35\x34\x73\x73\x65\x8c\x10\x73\x65\x0c\x81\xce\xdc\xca\x65\x41\x6f\xde\x54\x91\xfe\x3f\x72\xbf\x99\xe9\xef\x5b\x5f\x0d\xad\xb7\x2d\xce\x56\xdc\x1f\x47\x1c\xfe\x1b\x91\x2c\x01\x6f\x40\x23\x44\xaf\x77\x65\xed\x70\x9a\xd2\x1b\x83\x51\x10\x82\x47\x9e\xe0\xc2\x66\xb6\xce\x3b\x6c\xbe\xc2\xfc\xf6\x61\xee\x8c\x01\x0c\xbd\x1f\x1f\xe1\xa6\xe0\x45\x95\x25\xde\xe0\x59\x16\x61\x6f\x44\x07\xb9\x79\x46\xf1\xc8\xe5\x02\x4a\xac\x85\xfa\x74\x72\x43\xfe\x19\x74\x21\xa1\x61\xe7\x23\x35\x25\x8e\xd7\x5d\x6c\x7c\x80\xb7\x37\x2e\x62\xb4\x06\x49\x4a\x38\x7e\x87\xc5\x78\x73\xf5\xa3\xfc\xb9\x5d\x7f\xd9\x3c\x3d\xf2\x4d\xfe\x65\xf0\xfa\xfd\x6e\xfd\x79\xf5\xfd\xdb\xcb\xec\x7c\x24\xf0\x4d\xa1\x8a\xed\x5a\xfd\x67\x00\x1a\x70\xa6\x87\xc0\x0e\x00\x00")
 
 func vaultedEdit1Bytes() ([]byte, error) {
 	return bindataRead(
@@ -200,7 +273,67 @@ func vaultedExec1() (*asset, error) {
 	return a, nil
 }
 
-var _vaultedLoad1 = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x5c\xce\x5f\x6a\x03\x21\x18\x04\xf0\x77\x4f\x31\x17\x88\xd0\x23\xb4\x69\x20\x16\xea\xca\x9a\x97\x82\x2f\xb2\x7e\x12\x61\xab\x41\xbf\xdd\x5e\xbf\x54\xfb\x8f\xbc\x0d\x0c\xc3\x6f\xe4\xe5\x8c\xdd\x6f\x2b\x53\x70\x87\xb5\xf8\x80\x07\x21\xed\x19\xfa\xf1\xf5\x24\xa4\x31\xe2\xbb\x44\xef\xdc\x01\x5b\xa3\x86\x17\x3b\x69\xdc\x6a\xd9\x53\xa0\x00\x2e\x68\x1c\x52\xfe\x0a\x4b\x25\xcf\x84\x52\x51\xe9\xb6\xfa\x85\xc0\x57\xc2\x52\x32\x53\x66\x94\x08\x3f\xb8\x8e\xd8\x37\x3d\x19\xab\x6c\x87\x5c\x7c\x72\xf1\xf8\x9f\x73\x71\x86\x8b\x2a\xfb\x77\x72\xd1\xf4\xc5\xf3\xc9\x1e\x67\x65\x2e\x6a\xd2\x7d\x34\x0f\xa4\xdd\x2b\x7f\x33\x7c\x24\xbe\x8e\xc3\x3f\xfd\xef\xf1\x3d\xf9\xf1\x5c\x8a\xcf\x00\x00\x00\xff\xff\x29\xac\xab\x44\x08\x01\x00\x00")
+var _vaultedExport1 = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x54\x52\xc1\x6e\xdb\x30\x0c\xbd\xeb\x2b\x78\x4c\x80\x44\x43\xaf\xbb\x65\x4d\x80\x06\xd8\x12\x23\xce\x16\x0c\x53\x51\xa8\x16\x1d\x09\xb5\x25\x4f\xa4\x93\xe5\xef\x07\xc9\x76\x9b\xde\x44\x3e\xf2\xe9\xf1\x91\xf2\xf8\x04\x17\xdd\x37\x8c\x46\x2d\xf1\x5f\x17\x22\xc3\x83\x90\xe5\x13\xec\x56\x3f\x36\x42\x16\x85\x18\x61\x18\x51\xb5\x1c\x5f\x34\x34\x12\x70\x00\xed\x01\x7d\x15\x6f\x5d\x2a\x7c\xed\xbd\x69\x30\x93\x94\xbf\x77\xfb\xa2\xdc\x96\x99\x48\xd5\xdf\x54\xfd\xf8\x99\x4e\xd5\x07\xf8\xa3\xea\xed\xbe\x38\x6e\xf7\xbb\x52\xd5\xc5\x73\x8e\xbd\x6e\x51\xd5\x05\x48\x29\x9f\x33\xd3\x7a\x53\x3e\x1e\xb6\xb9\x2a\x93\x6d\x46\x0d\x6c\x11\x52\xb1\x99\xd4\xcc\x42\x04\xdd\x34\x63\xb8\x80\xab\x45\x0f\x3e\xe4\x22\x02\x1d\x11\xce\xee\x82\x7e\x9e\x65\x03\x39\x7f\x6e\x50\x0c\x92\x17\x80\xf2\x2c\x13\xd0\x86\x0b\x02\x5b\x6c\x53\xa0\x7d\x60\x8b\x11\x5a\x5d\x59\xe7\x51\xc2\xd1\xe2\x38\x24\x54\xda\xc3\x2b\x82\x6b\x93\x1a\x34\xd0\x27\xc2\xc9\x32\xb5\x1c\xf2\xb3\x87\xb9\xcc\xa2\xef\x1a\x1d\xdd\x39\x76\x75\x6c\x41\x43\xa7\x89\x3a\x1b\x35\x61\x92\xed\x2a\x0b\x8e\x20\xe2\xdf\x1e\x29\x39\x96\x27\xe1\x77\x0a\xe1\x08\xaa\x88\x3a\x41\xb3\xc1\xdb\x5f\xab\x9f\xdf\x8f\x9b\xf5\xcb\x6e\x73\x7a\x29\x56\x65\x79\xda\x1f\xd6\xc9\x61\x47\xd0\xd3\xc4\x40\xc8\xf3\x61\x86\x69\x7f\x16\x5b\xc2\xe6\x32\xd8\x23\x86\xc5\xa0\x01\x9d\xa1\x5b\x4a\x02\xa1\x6e\xd0\x2c\x80\x42\xca\xb9\x98\xb5\x5e\x43\x34\xb9\x07\x7c\xe0\x0f\xa1\x5f\x01\x75\x65\x87\x05\x88\x37\xc4\x8e\xc0\x31\xbd\x77\xe4\x0d\x45\xac\x5c\xe7\xd0\x33\xcd\x17\xf0\x86\x37\x30\x18\xdd\x45\xb3\x0b\x1e\xb4\x37\x93\x37\x29\x6c\x91\x6d\x30\xb4\x48\x79\xd1\x22\x6b\xa3\x59\x0f\x03\x58\x47\x1c\xe2\x0d\x42\x7d\xf7\x65\x32\x2d\xe9\x99\xe6\x90\xf9\x7e\xc6\x03\x13\xf2\x38\x1d\xa2\x5a\xaa\x65\xe8\xb9\xeb\x39\x59\xa4\xea\x6d\xed\x9a\x7c\x73\x5f\x60\x2a\x08\x9f\x11\x71\x8a\x8e\x91\xee\x76\x90\xce\xe3\xae\xd3\x79\x62\xd4\x26\xe9\x21\x36\xa1\x67\x29\xfe\x0f\x00\xf3\xc9\xbf\x3f\x61\x03\x00\x00")
+
+func vaultedExport1Bytes() ([]byte, error) {
+	return bindataRead(
+		_vaultedExport1,
+		"vaulted-export.1",
+	)
+}
+
+func vaultedExport1() (*asset, error) {
+	bytes, err := vaultedExport1Bytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "vaulted-export.1", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _vaultedHistory1 = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x74\x53\xcd\x6e\xdb\x30\x0c\xbe\xeb\x29\x78\x6c\x81\x44\x58\x1e\x61\xed\x02\x34\xc0\x96\x06\x4e\x2e\x03\x7c\x61\x2d\xaa\x16\x62\x4b\x81\xc8\xc4\xcb\xdb\x0f\x94\xe3\xc4\x1b\xd0\x23\x45\x93\xdf\x1f\x6d\x0f\x6f\x70\xc1\x73\x27\xe4\xea\x65\x1b\x58\x52\xbe\xc2\xca\xd8\xfd\x1b\x6c\xbf\xff\x5a\x1b\xbb\xdb\x99\x5b\x1f\xa6\x76\xbd\x84\x2e\xb0\x30\x48\x4b\x90\xe9\x12\x38\xa4\xc8\x70\xa4\x93\x80\x4f\x19\x70\xdc\x58\x96\xec\x7f\x6f\xdf\x77\xfb\xcd\xbe\x2c\xaa\xfd\x4b\xed\x5f\xff\x5b\x57\xfb\x0a\x6a\xbf\x89\xd8\x53\xed\x77\x65\xe8\xc7\x7a\xff\x5a\x6d\x76\x87\xcd\xfb\xb6\xcc\xfd\xfc\x0a\x2d\xc4\xf2\x3a\x11\x4b\xbe\x94\x05\x60\x01\x91\x06\x62\x01\x1f\x32\x8b\x85\x35\x36\xad\x99\xc6\x21\x30\x70\x9b\x86\x08\x43\x90\xb6\x0c\x39\x14\x82\x20\x30\x20\x03\xe3\x85\x1c\x60\x74\xa5\x93\x4e\x94\x51\x42\x52\x2c\x14\x68\x32\xa1\xd2\x0f\x62\x9e\xc8\x7e\x5a\x18\x55\xa1\x73\xb5\xaf\x16\xb7\x8a\x5c\x90\x59\xd9\x25\x9c\x77\x4f\xc8\x3c\xcc\x1f\x9a\x93\xba\x90\xf2\xad\xcc\xa9\xeb\x3e\xb0\x39\xd6\xbe\x7a\xb6\x70\x68\x09\x9a\x73\xce\x14\xe5\x21\x60\x2e\x55\xd5\xf4\x98\x8f\xca\x99\x81\xcf\x4d\x6b\x8b\x6d\x2a\x19\x24\xf4\x34\x25\xa2\x1f\x16\x6d\x8b\x32\x1c\x69\xb8\x1b\xaa\x2d\x74\x8e\x1c\x48\x82\x20\x7c\x0f\x5b\x5d\x48\xb1\xbb\x1a\x9d\x58\x7d\x83\x3e\xb1\x40\xa6\x86\xa2\xcc\xd2\xc0\x4c\x25\x11\x0b\xd5\x3f\x6f\x9a\x0b\x39\xa0\x3f\xd8\x48\x77\x55\x7a\xd2\xd2\x15\x06\xca\x64\x6e\x4c\x28\x36\xf9\x7a\x52\x47\xef\x59\x14\x7f\x52\x56\xfb\x71\x4c\x24\x44\x38\x33\x01\x4a\xe9\xab\x26\x45\xea\xd3\x25\xc4\xcf\xfb\xbd\x61\xc7\x09\x32\xf5\xe9\x42\x3c\xd7\x30\xba\xf1\x20\x56\x80\xe1\x83\x7c\xca\x8f\xdb\x51\x14\x55\x30\xd2\x7e\xdc\x06\xc6\x5b\x28\xe7\x78\x8c\x69\x88\x1a\x54\xb9\x15\x8c\xce\xdc\x4f\x63\x84\x38\x28\xbc\xae\x53\xc7\x27\x73\x16\xc0\x44\x60\xec\x4b\x35\xfd\x68\xcb\x29\x5f\x78\x5a\x3d\x5b\xf3\x77\x00\x16\x18\x45\xf3\x83\x03\x00\x00")
+
+func vaultedHistory1Bytes() ([]byte, error) {
+	return bindataRead(
+		_vaultedHistory1,
+		"vaulted-history.1",
+	)
+}
+
+func vaultedHistory1() (*asset, error) {
+	bytes, err := vaultedHistory1Bytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "vaulted-history.1", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _vaultedImport1 = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x93\xdd\x8e\xda\x3a\x10\x80\xef\xf3\x14\x73\x77\x12\x09\x22\xed\xe9\x13\xb0\x0b\x12\x91\x5a\x88\x08\x6d\x55\xd5\x55\x65\xe2\x71\x6d\x11\xec\xd4\x63\x36\x9b\xb7\xaf\xec\x98\xbf\xbd\xd8\x3b\xf0\x78\xbe\xf9\x66\xc6\x29\xf7\x6b\x78\xe5\xe7\xce\xa3\x60\x73\x7d\xea\xad\xf3\xf0\x94\x95\xcd\x1a\x36\x8b\x2f\xab\xac\xac\xeb\x2c\x85\x21\x45\xd9\x3c\xfd\xa2\x29\x91\x40\x3a\x7b\x02\x6e\x00\x4d\xeb\xc6\x3e\x5c\x3d\x9c\x8d\xe8\x30\x62\x9a\x1f\x9b\x6d\xdd\x54\x4d\x44\x31\xf9\xcc\xe4\xcb\x23\x90\xc9\x1d\xfc\x64\xb2\xda\xd6\xfb\x6a\xbb\x69\x98\xac\x7f\x01\x93\x95\xd4\x1d\x32\x59\x47\xc6\x72\xd5\xbc\xec\xaa\x18\x8f\x98\x2a\xd5\xf7\x0a\x1f\x1d\x52\x61\x68\x1d\xf2\x50\xe1\x30\xde\x9a\xc3\xb7\x90\x94\x3f\x15\x25\xec\x15\x42\xcf\x89\x7a\xe5\x38\x61\x66\x65\x24\xa5\x5c\x4d\xe0\xf0\xef\x19\x29\x00\xf2\xc9\xf8\xdb\xe2\xeb\xe7\xfd\x6a\xf9\xbb\x5e\x34\xcd\xf7\xed\x6e\x19\x9c\x35\xc1\x99\x50\xc0\xa0\xd0\x00\xa1\x2f\xca\xe8\xb6\xe2\xad\x9a\x8a\x82\xa6\xd4\x22\x0a\xe0\x04\xda\xc3\xc0\x09\x08\x79\x87\x62\x06\x64\xc3\x89\x26\xb0\x3d\x9a\xc0\xd1\x5e\x45\x0f\xe2\xa7\x49\x6f\xb0\x4e\x64\xb9\x75\xa0\x05\x1a\xaf\xfd\x58\x5c\x18\xf7\x29\x07\x94\xd6\xe1\x25\x32\x75\x89\x62\x92\x79\x1e\x41\xa0\x0c\x32\x33\x30\xd6\x2b\x6d\xfe\x3c\x58\x45\x77\x6e\x46\xb0\xf2\x7e\x98\xda\xdc\x0f\x84\x77\x0e\xb9\x18\x33\x7c\xd3\xe4\xa7\xe1\xd9\xde\x6b\x6b\x08\x0e\xd8\xd9\x01\x5a\x65\x2d\x21\x28\x3b\x80\x57\x96\xae\x18\xee\xf0\x56\x4a\x1b\xf2\xc8\x83\x57\xb3\x86\xb4\xeb\xac\xdc\x5f\xde\x04\x9b\xb3\xb9\x43\xc3\x4f\xc8\xe4\xee\xba\xe0\x04\xf2\x8a\xfb\x8b\x06\x44\x0d\x38\x1b\x81\x0e\x38\x18\x1c\x20\x64\x41\x1e\x8c\xa5\x76\xe4\x43\x33\x4c\x56\xe1\x94\xc9\x9a\xcd\xff\x9f\x65\xf7\x7f\x3f\xcd\x00\x7d\x5b\x4e\x50\x4d\x61\x2e\x57\x76\x58\x68\x51\xbe\xd3\xa2\xa3\xee\x83\x54\x73\xd4\xfd\x07\x4a\xef\xd3\xec\x2b\xba\xc1\x69\x1f\x1b\xda\x61\xdf\xf1\x16\x3f\x4a\x8f\x83\x75\xd3\x3d\x71\x7b\x41\x47\xec\x7d\x5a\xc8\xf4\x25\xfe\x47\xa0\x34\x79\xeb\x46\xc8\x09\xd3\xb0\xc3\x03\x4f\xa7\xf9\x53\x51\x94\xd9\xbf\x01\x00\xa8\x4d\x0a\xfe\xd8\x03\x00\x00")
+
+func vaultedImport1Bytes() ([]byte, error) {
+	return bindataRead(
+		_vaultedImport1,
+		"vaulted-import.1",
+	)
+}
+
+func vaultedImport1() (*asset, error) {
+	bytes, err := vaultedImport1Bytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "vaulted-import.1", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _vaultedLoad1 = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x91\xc1\x8a\xdb\x30\x14\x45\xf7\xfe\x8a\xbb\xe8\x22\x81\xda\x30\x9f\x90\x4e\x42\xe3\xc2\xc4\xc6\x4a\xa1\xa5\x2a\x83\xb0\x9e\x62\x51\x47\x0a\x7a\x72\x4c\xfe\xbe\x58\xf6\x0c\x69\xa6\x2b\x1b\x49\xdc\xf3\xce\x7d\xc5\x71\x8f\xab\x1a\xfa\x48\x5a\xe6\xbd\x57\x1a\x4f\x59\x21\xf6\x38\x6c\x5e\x76\x59\x51\xd7\xd9\x72\x89\x74\x27\x73\x0c\x4c\x8c\x6f\xa2\x3a\xe0\x12\xfc\xd5\x6a\xd2\x88\x1e\x1c\xb5\x75\xd3\x4f\x1b\x48\x45\x82\x0f\x08\x74\xe9\x55\x4b\x88\x1d\xa1\xf5\x2e\x92\x8b\xf0\x06\x6a\xc6\x25\x88\xf8\x79\xa8\x6a\x51\x8a\x04\x92\xe6\x8b\x34\xcf\xf7\x38\x69\x1a\x48\x53\x3a\x75\x26\x69\x6a\xfc\x92\xa6\xac\xea\x63\x59\x1d\x84\x34\xf5\xef\x94\xb0\xdd\x89\xe7\xa6\x4c\x87\x29\xa4\x99\xa1\xfc\x48\xbd\x8b\x19\x6d\xec\x66\x81\xb7\xfb\x77\x91\xab\x55\xb3\x49\x81\xef\x4c\x0f\x13\x69\x6b\xcc\xc3\x44\xf3\x03\x99\x4f\xc7\x29\x76\xa2\xb2\x3a\xd3\x9c\x3f\xf5\x42\x84\xb1\x53\x11\xa3\x1f\x7a\x8d\xb6\x53\xee\x44\x58\x31\xd1\x5b\xb1\x32\x9f\x82\x57\x4f\xeb\x75\x91\x0c\xc4\x8d\x23\x9d\xe7\x92\x18\xab\xe5\x6b\x1d\x47\xd5\xf7\xa4\x61\xdd\x82\xfd\xf4\x63\xfb\xf5\x75\xbb\x39\x6e\x5e\xb7\x65\x23\xa4\x69\xd6\x50\x81\x10\x48\x69\x99\x7b\xd7\xdf\x0a\xcc\x6d\x58\x77\xca\x3e\x2c\x81\xef\x30\xb0\x8c\x40\x66\x60\xd2\x18\x5c\x4f\xcc\x0b\x42\xe6\x32\x37\x3e\xfc\x99\xfc\x2c\x83\x2f\xd4\x5a\x63\x49\x17\xa9\xfb\x65\x17\x59\x71\xac\xb3\x0f\xef\x33\xa1\xae\x0f\x6b\x88\x1e\x37\x3f\x04\xf8\xd1\xbd\xeb\xfd\xc7\x66\x5f\xbd\xec\x92\xcd\xe4\x4c\x4a\x4f\xeb\x8b\x1d\x65\xf7\x13\x7f\x06\x77\x4a\xfb\xd1\xba\x53\x42\xfc\x63\x63\x82\x3f\x23\x76\xe4\xe0\x5d\x91\xfd\x1d\x00\x2d\x0f\x7a\x34\xe1\x02\x00\x00")
 
 func vaultedLoad1Bytes() ([]byte, error) {
 	return bindataRead(
@@ -220,7 +353,27 @@ func vaultedLoad1() (*asset, error) {
 	return a, nil
 }
 
-var _vaultedLs1 = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x8d\x41\x0a\xc2\x30\x10\x45\xf7\x39\xc5\x3f\x40\x1b\xf0\x08\x5a\x0b\x2d\x68\x1b\x3a\xdd\x08\xd9\x14\x9a\x40\x60\x68\xc4\x99\x7a\x7e\x21\x0a\x82\xb8\xfd\xef\x3d\xbe\x9d\x3b\x3c\x97\x9d\x35\xac\xbe\x66\xc1\xc1\x58\xea\x30\x1c\xaf\xad\xb1\xce\x99\x0f\x02\x0b\x7c\x0d\x4e\xa2\x82\x85\xf9\x9d\x48\x71\xe9\x36\x8c\x8e\x7a\x2a\xbe\x8f\x27\x1f\x9b\x6f\xe5\xe3\xf4\x6f\x4f\xa2\x85\x50\x87\x73\x4b\xcd\xd4\xbb\xb9\x1f\x87\x62\x5e\x7e\x3e\x2a\xe4\x2d\xe0\x1e\x1e\xe0\xb4\x85\x0a\x9a\x21\xba\xe6\x5d\xad\x79\x05\x00\x00\xff\xff\x8d\xa8\x1b\x0a\xbc\x00\x00\x00")
+var _vaultedLock1 = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x90\xcd\x6a\x84\x30\x14\x85\xf7\x79\x8a\xb3\x6c\xa1\x06\x66\x53\xe8\xb2\x33\x15\xc6\x45\x1d\x31\x42\x29\xb8\xb1\x7a\xd5\xa0\x4d\xac\x49\xea\xcc\xdb\x17\xef\x54\x5a\x98\x6d\xce\xcf\x77\x73\x64\x71\xc4\x77\x15\x46\x4f\x4d\x19\x8d\xb6\x1e\xb0\x13\x52\x1d\x91\x3e\xbf\xc6\x42\x66\x99\xf8\x15\xc1\x5a\x19\x61\xd1\x13\x39\xf8\x9e\x30\xd0\xc5\xa1\xa7\xb1\xc1\xc7\x85\x1f\xaa\x8e\x8c\xe7\xb4\x7a\x4f\x4f\x99\x4a\x14\x37\x94\xed\xbe\x6c\x0f\xff\x7b\xca\x36\x67\xdb\x4b\xac\x0e\x79\x92\x15\xc9\x29\x65\xe7\x1b\x77\x57\xe3\x08\xdb\x72\x23\x87\x6e\x41\x73\x30\x46\x9b\xee\x0a\x7c\x80\xb3\x6c\x9e\x2a\xe7\x16\x3b\x37\x6b\x98\xaa\xba\xbf\x9e\x0e\xed\x30\xd3\x57\x20\xb7\xd2\x57\x9f\xa1\xb3\x87\xd7\x9f\x04\xcd\x6a\x70\xd4\x48\x14\xdb\x07\x30\x10\x4d\x6e\x63\x48\xa1\x88\x20\xe4\x3e\xdf\x66\x8a\x98\x8a\xbb\xdd\xbd\xe4\xa3\xe3\xb3\xf6\x0e\x8b\xf6\x3d\x6a\xdb\x10\x1e\x9f\xa0\xdb\xbf\x3d\x56\x82\xb1\x1e\x73\x30\x46\x9b\x4e\x8a\x9f\x01\x00\xf7\x40\x9d\xba\x72\x01\x00\x00")
+
+func vaultedLock1Bytes() ([]byte, error) {
+	return bindataRead(
+		_vaultedLock1,
+		"vaulted-lock.1",
+	)
+}
+
+func vaultedLock1() (*asset, error) {
+	bytes, err := vaultedLock1Bytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "vaulted-lock.1", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _vaultedLs1 = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x54\x4d\x6f\xdb\x46\x10\xbd\xf3\x57\xcc\xa1\x08\x24\x40\xa2\x91\x6b\x6f\x6e\x1c\xd4\x2a\x12\x4b\x10\x85\xa2\x41\x59\x04\x63\xee\x50\xdc\x66\xb9\x4b\xec\x0c\xa5\xea\xdf\x17\xb3\x5c\xda\x96\x92\x43\x4e\xd6\xd2\xf3\xf1\xde\x9b\x37\x53\x1e\x1e\xe1\x84\xa3\x13\x32\xf5\xda\x31\xbc\x2f\xca\xea\x11\x9e\xee\x3f\x7f\x2c\xca\xdd\xae\xc8\xff\x02\xc7\x50\xaf\xc1\x59\x16\x06\x74\x6e\x4a\xe1\x14\x5b\x7d\x79\xda\xee\xaa\x4d\x95\xe2\xeb\xf6\xb7\xba\xfd\xf0\x9a\x55\xb7\x7b\xf8\xbb\x6e\x37\xdb\xdd\x61\xb3\x7d\xaa\xea\x76\xf7\x4f\x7a\x0f\x91\x5a\xfb\x9f\x3e\x7f\x94\x66\x59\x7e\x26\xb1\x7a\x84\x87\x8f\xd5\x87\xfd\x26\x15\x4f\x85\x3e\xdd\x20\x5c\x41\xf0\x04\x03\x45\x70\xd6\xd3\x0a\x24\x00\x8b\x09\xa3\x94\x70\xe8\x08\xb0\x11\x7b\xa2\x29\x16\x16\xd2\xcd\x3f\x5d\x40\x43\xa6\xb0\x5e\x02\xe8\xd7\x66\x8c\x91\xbc\x00\xf9\x93\x8d\xc1\xf7\xe4\x65\x09\x96\xa1\xc7\xf8\x8d\x0c\x20\xc3\x44\x7c\x31\x15\x5c\xd6\xed\xbe\x7e\x57\xc2\x9f\x5a\x97\x01\x23\x25\x4e\x64\xe0\xf9\x52\x48\x47\x36\x42\x3b\x3a\x07\x1e\x7b\x82\x05\x95\xc7\x32\xe7\x0f\x31\x98\xbb\x01\x2f\xda\x80\xeb\x76\xbf\x5c\x01\x4f\x08\xc2\x28\xc3\x28\xda\x93\x47\x2b\xf8\xec\x08\xda\x10\x81\x9b\x68\x07\xe1\x02\xbd\x01\xee\xc8\x39\x68\x42\x3f\x38\x12\x1b\x7c\x99\x14\xa9\x2e\x2c\xd4\x67\x39\x60\x91\xff\x5a\xcf\x82\xce\x91\x01\xeb\x73\xef\x5f\xfe\x7a\xf8\xfd\xeb\xc3\xfd\xe1\xfe\xeb\xc3\x66\x5f\xd5\xed\x7e\x05\xe7\xce\x36\x5d\x82\x1f\x09\x4d\xbd\x0e\xde\x5d\x96\xfa\x2e\xbe\x23\xce\xa9\xcd\x4c\xfc\x4b\x18\x23\x84\xb3\x9f\xdb\x4a\x87\x02\x1d\x9e\x28\x71\x61\xa5\x9d\xb8\x23\x03\x02\xbf\x41\x58\x4c\x6a\x60\x2b\x14\xe1\xda\x15\x64\xac\x40\xbd\xae\xd7\x6d\x88\xdf\x54\x1b\x05\xf2\xfd\x04\xb8\x43\x13\xce\x7c\x55\x35\xc3\x4a\x7a\x6c\x5a\x78\x6b\x23\x55\x74\x88\xe1\x64\x0d\x19\x35\x8b\xbb\xcc\x98\xcf\x5d\xe0\x09\x26\x03\x0b\x46\x81\xb3\x95\xee\x3a\x59\xa5\xc8\x93\x7d\x3b\xc6\x17\x23\xa7\xda\xe6\x4e\xcd\xac\x61\x0c\x2f\x16\xe3\x57\xe1\x35\x44\xf9\x94\xc9\xd1\xd9\xf0\x45\x79\x98\xd7\x42\x39\x4b\x24\xd2\x2a\x77\x39\xa7\x5e\xeb\x86\x14\x9f\x6e\x8b\x26\x41\x35\x38\x4d\x98\xd0\xac\x26\xd4\x1a\x32\x60\x14\x86\xd0\x42\x67\x29\x62\x6c\x3a\xdb\x60\xde\x93\x22\xd3\xec\x74\x66\xc8\x60\x6c\xa4\x46\x42\xb4\xc4\xe5\x0d\x12\x17\xfc\xf1\x1a\x89\xbb\x46\xd2\x93\xa0\x41\x41\xed\x44\xd8\x74\x79\xa5\x90\xe1\x4c\xce\xfd\x0a\x56\x18\x84\xb0\x5f\x81\xe0\x91\xd5\x64\xe4\xc1\x0a\x9c\x91\xc1\x21\x4b\xd1\x07\x63\x5b\xab\xee\xf2\x06\x46\xd6\xb9\xa8\xbd\x35\xcf\xd0\x64\x78\x75\x37\x3c\xbc\x3e\x18\x9a\x0e\xfd\x91\x4c\x62\x1b\x46\x81\x30\x90\xb7\xfe\x58\xbc\x68\xf3\x63\xb7\x8c\xfe\x44\x31\x75\x53\x8f\xc0\x82\x29\x87\xeb\x39\x9c\x99\x2c\xde\x2f\x97\xb7\x32\x08\x26\x15\xea\x76\x93\x7e\xed\x8a\xad\x77\x97\x3c\xe4\x3c\x0b\xc1\xe3\x8c\xe8\x35\x4e\xb7\xe3\x33\x5e\xe0\x99\x80\x07\x6a\x52\x6b\xe8\x47\x27\x76\x70\x04\x62\x7b\xe2\x15\x58\x5f\x4c\xab\xd7\x20\x67\x3c\x0c\xfd\xc8\x79\x87\xf4\x00\x87\x36\x79\x49\x25\xbc\x85\xf6\x2f\x07\xaf\x23\xd9\xa6\x93\xa1\x86\xf8\xa3\xda\x3e\x25\x6c\x73\x5a\x2e\xa9\xb2\x4e\xe7\xe8\x65\x68\xd9\x36\xd3\x79\x9c\x6a\x72\x18\x63\xa3\xee\x2b\xae\x27\x6a\x67\x15\x47\xa6\x98\xee\x45\x8e\x4f\x9b\x97\x3e\x84\x79\x89\xa7\xad\xb4\xfe\x58\xb7\xfb\xfa\x5d\x59\xfc\x3f\x00\x5b\x24\x96\x6f\x77\x06\x00\x00")
 
 func vaultedLs1Bytes() ([]byte, error) {
 	return bindataRead(
@@ -240,7 +393,27 @@ func vaultedLs1() (*asset, error) {
 	return a, nil
 }
 
-var _vaultedPasswd1 = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x90\x5d\x6a\xeb\x30\x10\x85\xdf\xbd\x8a\x59\x40\x62\xb8\x4b\xc8\x4d\x0c\x31\xb4\x8e\xb0\xdc\x86\x82\xa0\x28\xd6\xa8\x16\xd8\x92\xd1\x8f\x4d\x77\x5f\x24\xbb\x69\x43\x69\x5f\xda\x57\xcd\x99\xef\x7c\x9a\xbc\x39\xc2\xc4\x43\xef\x51\xb0\xed\xc8\x9d\x9b\x05\xfc\xcb\x72\x7a\x84\x6a\x77\x5f\x64\x39\x21\xd9\x3a\x86\x75\xca\xb6\xd0\x76\x5c\xbf\xa0\x03\xdf\xe1\xf2\x6a\xac\x00\x23\x81\x2f\xa8\xb4\x4e\x9f\xaa\x13\xa1\x25\x4d\x08\x26\xff\x33\xb9\xbf\x05\x31\x59\x03\x93\xa5\xe6\x03\x32\x49\xbe\x8b\x19\xfb\x25\x48\x8f\x70\x28\xe8\xbe\x2e\x49\x53\x9e\xaa\xb4\xb8\x37\xda\xa3\xf6\xa0\x74\x72\xfa\x48\x2f\x42\xa0\x1c\x04\xed\x4d\x68\x3b\x14\x1b\x30\xba\x7f\xbd\x75\x57\x6e\xfd\x93\xc8\x13\xaf\x94\x2b\x27\xfa\x3c\xee\x1e\xee\x9a\xe2\xf0\x4c\x76\x94\x9e\x4f\xf5\x21\xfa\xa0\x9e\x94\x35\x7a\x88\xa5\x13\xb7\x8a\x5f\x7a\x8c\x14\x87\x7e\x03\xca\xc3\xac\xfa\x1e\x2e\x08\xc1\xa1\x00\x9e\x2e\x95\xb5\xc1\xda\x98\xbf\xb6\x4a\x63\x3f\xa9\x6e\xc0\xf8\x0e\xed\xac\x1c\xa6\xf2\xe0\xd0\x5e\x39\xa3\x35\xc3\x18\x8f\x12\x77\x22\xec\x1d\xf2\x83\x6f\x55\x9c\x7f\xe3\x9c\x45\xa2\xc6\xf9\xaf\x7d\xdf\x02\x00\x00\xff\xff\xf1\x83\xc8\xb9\x72\x02\x00\x00")
+var _vaultedMetadata1 = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x54\x4d\x6f\xe3\x46\x0c\xbd\xeb\x57\xf0\x50\xa0\x1b\xc0\x56\xb0\xd7\xde\xb6\x4e\xd0\xf8\x90\x58\xb0\x8d\x7e\xa0\x2a\x16\xb4\x86\xf2\x0c\x22\xcd\xa8\x22\x65\xad\xfe\x7d\xc1\x91\x14\x5b\xde\xbd\xf4\x26\xcd\x70\x1e\x1f\x1f\x1f\x99\x1e\x5f\xe0\x82\x5d\x25\x64\xf2\x75\x4d\x82\x06\x05\xe1\x73\x92\x1e\x5e\xe0\xed\xcb\xeb\x73\x92\x66\x59\x32\x05\xc0\xc7\x7d\xbe\x06\xb6\xa1\x67\x08\x2d\x14\x16\xfd\x99\x18\xc4\xd2\x35\x20\x94\x80\x23\x6e\x44\x3a\xfc\xf5\xb6\xcb\x0e\xdb\x43\x44\xcb\xcb\x5f\xf3\x72\x73\x8f\x99\x97\x7b\xf8\x3b\x2f\xb7\xbb\xec\xb8\xdd\xbd\x1d\xf2\x32\xfb\x07\xf2\x72\xeb\xb1\xa6\xbc\xcc\x22\xca\xd3\xf3\x61\xb3\xdf\xc6\xfb\x08\xf4\xbb\x42\x30\xd8\x50\x19\xe8\x3c\xf9\xa2\x1d\x9a\x05\x4d\x43\x5c\xb4\xee\xe4\xfc\x59\xd9\xd5\x2b\xe0\x30\x92\x62\x28\xd0\xc3\x89\xa0\x72\x2c\x64\x92\x4f\x4c\x74\x95\xa1\xe2\x4f\x9f\x1f\x1e\xa0\x77\x62\x43\x27\x70\x22\x05\x08\x0d\x79\x32\xbf\xc0\x8c\xda\x88\x0b\x7e\x05\x82\x67\x5e\x29\x3a\x08\x61\x0d\x62\x51\x92\xd0\xfb\x51\x8e\x88\xb8\x82\xde\x92\xbf\xfe\x43\x8f\x0c\x45\x4b\xa8\x54\xd1\x1b\xa8\x90\x05\xea\x60\x5c\xe9\xc8\xac\xe2\x51\x7c\xe1\x62\x68\x12\xaf\x3b\x26\x93\xc6\xa2\xff\x98\x58\x85\x48\x60\xca\xfd\x51\x71\x28\x6f\x12\x39\x06\xe3\xb8\xa9\x70\x20\x93\xc2\xd1\xd2\xfc\x68\xea\x59\xa2\xa1\xdf\x55\x13\x09\xc4\x62\x16\x60\xb3\x1a\x2d\xfd\xdb\x11\x8b\x4a\xe2\x84\xa1\x41\xe6\x3e\xb4\x13\xb9\xe3\x2d\x17\xc7\x80\x9d\x58\xf2\xe2\x8a\xb1\xd8\x2a\xf8\x73\x94\x35\xe2\x16\xc1\x0b\x79\x59\xa6\x21\x2c\x2c\x88\xab\x09\x9c\x24\x8e\x81\x09\x2b\x55\x85\xc3\x15\x98\x7b\x6c\x1a\x32\xe0\xfc\x6c\x32\x28\x5d\x45\xb1\x5e\x12\x2a\x34\xd7\x9d\xe6\x8e\x93\xb1\x81\x29\x6c\x26\xbf\xd6\x68\xe8\xa3\xc7\x7a\x39\xd9\x64\x7a\x51\xa0\xff\x59\x7b\xbf\xac\x21\x12\x11\x4b\x43\x82\x2d\x41\x8d\xed\xbb\xd6\xc5\xd0\xf9\x0b\xb5\xb1\x81\xa3\xd0\x9e\xbe\xc9\x58\xc6\x2d\x07\x20\xe3\x94\x9c\xda\x6d\x1e\xaa\x7c\xad\x87\x6a\xb8\x15\x0c\xa1\x03\xc5\x45\x56\xd8\xde\x92\x58\x6a\x41\x02\xbc\x13\x35\x93\x85\x7b\xeb\x0a\xbb\x20\x15\xbd\x56\xa7\xc9\x4e\xa3\x7b\xc7\x14\x3d\x31\x44\x24\xe3\xb8\xc0\xd6\xfc\x48\x90\x49\x5a\xc0\x33\x3a\x3f\x79\xcb\xd2\x55\x52\xb5\xe9\x87\xf7\xe0\xd3\x69\x80\xe5\xe0\x92\xbf\xe4\xe5\x7e\x75\x77\xca\x96\xaa\x4a\x67\x39\xb4\xf7\xf1\xdf\xa8\xc8\xcb\xfd\x83\x76\xd5\x07\x01\x96\xd0\x8e\x4d\xbc\xb2\xd2\x36\x46\x89\x3b\xd6\x6e\xcc\x54\x3c\x5d\xa8\x9d\x47\x84\xc1\x49\x0a\x5b\x2d\x21\x79\xa7\x46\x14\x61\xcc\xf4\xd3\x9f\x4f\xbf\x7d\xdd\x7c\xd9\xbc\x3c\x7f\x7d\xd9\xbd\x3e\x3f\x4e\x99\x1f\x53\x2d\x23\x5f\xeb\x0c\x3d\x2a\x35\xe7\x59\x08\xa7\x51\x9b\xd9\x0c\xbe\xf8\xae\x33\x3c\xf8\x42\x3b\x93\xc6\xf5\x33\x6d\xa6\x24\x3d\xce\x3b\x2c\x5f\xe7\xeb\x9b\xf9\x51\xec\xbc\xdc\x2e\x4e\xb2\xe4\x29\xfe\x9e\xa6\x0d\x19\xb1\xd3\x3b\x0c\x9d\xb6\xe9\xf1\xf8\x99\x25\x07\x12\x5e\xae\x15\x58\xae\x95\x7b\x0c\x34\x26\x5f\x0b\x9e\x67\x1c\xfd\xca\x92\x23\x9e\x6f\xdf\xc0\x2b\x0e\xea\x69\x6e\xa8\x50\x2d\x0d\xd4\x5d\x25\xae\xa9\x28\x9a\x95\xef\x41\x5b\xaa\xc3\x85\x7e\x80\xbb\x8f\x17\x0c\xa8\x2b\x03\xca\x36\xd4\xff\x2b\xcb\x7f\x03\x00\x3a\xd5\x57\x49\x76\x06\x00\x00")
+
+func vaultedMetadata1Bytes() ([]byte, error) {
+	return bindataRead(
+		_vaultedMetadata1,
+		"vaulted-metadata.1",
+	)
+}
+
+func vaultedMetadata1() (*asset, error) {
+	bytes, err := vaultedMetadata1Bytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "vaulted-metadata.1", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _vaultedPasswd1 = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x57\x5b\x6f\xdb\xca\x11\x7e\xe7\xaf\x98\x87\xe2\x44\x06\x28\xe2\xd8\x45\xfa\x74\x50\x40\xb1\xdc\x63\xa1\x89\x2d\x88\x3a\x49\x83\xb2\x08\x56\xdc\xa1\xb8\x30\xb9\xcb\xee\xac\xa4\xf0\xdf\x17\xb3\xdc\x95\xa8\x4b\x7a\x41\xdb\x27\x43\x26\xe7\x9b\x99\x6f\xbe\xb9\x30\x5b\x3f\xc3\x5e\xec\x1a\x87\xb2\x98\x76\x82\xe8\x20\xe1\x3e\xc9\xf2\x67\x78\x99\x7d\x7a\x4a\xb2\xe5\x32\x09\x8f\x21\x3c\x2d\xa6\x50\xd6\x42\x6f\x91\xc0\xd5\x38\xfc\xd7\x58\x09\xa6\x02\x31\x40\x79\xf3\xfc\xeb\xcb\xeb\x32\x5f\xe4\x1e\xa2\xa8\x3e\x14\xd5\xe3\x39\x50\x51\xad\xa0\xa8\x16\x5a\xb4\x58\x54\x4b\xf8\x6b\x51\x2d\x5e\x97\xeb\xc5\xeb\x4b\x5e\x54\xcb\xbf\xfd\xc8\xcc\xd8\x7f\x69\x98\x3f\xc3\xfc\x29\x7f\x5c\x2d\x3c\x9a\x07\x7a\x34\xda\xa1\x76\xa0\xb4\x8f\x79\x64\xed\x63\x02\x45\xb0\xd3\xce\xec\xca\x1a\x65\x0a\x46\x37\xfd\x79\x6e\x8a\x42\xce\x32\xf3\x78\x8b\x2a\xe0\x70\x5a\x9f\x67\xbf\x7d\x5c\x3f\xcd\xbf\x2d\x67\x79\xfe\xe5\x75\x35\xe7\xf8\x50\xef\x95\x35\xba\x65\xa7\x7b\x61\x95\xd8\x34\xc8\x5e\x08\x5d\x0a\xca\xc1\x41\x35\x0d\x6c\x10\x76\x84\x12\x84\x67\x32\x29\x77\xd6\xf2\xfb\x47\xaf\x95\xb1\xa3\x44\x53\x30\xae\x46\x7b\x50\x84\xfc\x3a\x9b\xda\x23\x4e\x67\x4d\xdb\x31\xb7\x6c\xc3\x60\x11\xe4\x9f\xc4\xfb\xf2\xf4\xe5\xbf\x89\x39\xe1\x20\x34\x1e\xfe\x1f\xf1\xae\x2f\xa1\xdb\x1d\x39\x20\xe1\x14\x55\x17\xa5\xe9\x4c\xa3\xca\x1e\x26\x84\x08\x31\x1b\x58\xbe\x7e\x5c\x3c\x7e\x05\xa5\xa3\x78\x27\xf7\x77\x77\x03\x74\xde\x93\xc3\x76\x10\x2a\xc1\x24\xfc\x55\x9a\x9c\x68\x1a\x94\x2c\x91\x41\xad\xbf\xfb\xcb\xfc\xd7\x6f\xf3\xd9\x7a\xf6\x6d\xbe\x58\xe5\x45\xb5\xba\x03\x61\x11\x2c\x0a\x59\x4c\x59\x22\x19\x3c\xb2\x26\x94\xde\x26\x67\x11\xf9\x46\xa0\x91\x1b\x2e\xbc\xc5\xca\xd7\x7a\xa7\x1b\x24\x0a\x2e\x8a\x69\x31\xad\x8c\x7d\x63\xc5\x30\xd1\x1d\x96\xaa\x52\x5e\x64\xf9\x33\xfc\xf9\xe9\x2b\xe4\x1f\x5f\xd7\x43\x0b\xcd\x02\x56\x29\x34\x0b\xc7\x74\xa8\x51\xc2\xa6\x07\xc2\x3d\x5a\xd1\x1c\xfd\x13\x4c\x30\xdb\x66\x20\xa0\x43\x4b\x46\x8f\x1e\x81\xd0\x12\x84\x4e\x90\x4a\x6b\x0e\x28\xc1\x62\x69\xf6\x68\xfb\xe3\x1b\x77\x8c\xb8\x23\xa5\xb7\xf0\x86\x3d\x50\x63\x1c\x65\xc0\xe5\x38\x66\x82\xba\xb4\xbd\xd7\xda\x41\xb9\x1a\x44\x62\x85\x96\xa6\x85\x56\x90\x43\xcb\x66\xa9\xf7\x83\xa2\xac\x3d\x00\xd4\xa6\x91\x04\x02\x4a\xd3\xf5\x3c\x26\x98\xad\xd3\xdb\x70\xb0\xa2\xeb\x86\x5c\x8c\xc6\x24\xbc\x10\x23\x22\x98\x0c\xf1\xb0\x15\xc3\xbd\x23\x30\x07\xed\x2d\x25\x5a\xb5\x17\x4e\x19\x0d\x2d\xba\xda\x70\x72\x3c\x5e\xac\x68\xd1\xa1\xa5\x50\xf2\x99\x94\x11\xa0\x52\x96\x85\xc4\x51\x39\x13\xe7\x15\xbc\x21\x76\x04\xca\x11\x5c\xf5\xa0\xd2\xc3\xdb\x3f\x7b\x68\x21\xe5\x0d\xdd\xc7\x77\xee\x33\x98\x55\xcc\x81\xab\x85\x4b\xfd\xff\xc8\x6b\x46\x48\xc9\x6d\xae\x99\xf0\xd6\xec\x99\xba\x5a\x35\x98\xb0\xdf\x18\x99\xe7\xf7\x1d\x9d\xd1\x48\xc6\x53\xe1\xfb\x28\xc0\xb1\x09\x1c\x8c\x7d\x53\x7a\x9b\xc1\x13\x73\x3c\x4c\x26\x50\x94\x70\x39\xad\x77\xc5\x74\x73\x8c\x16\xf7\x8a\x98\x1f\xa5\xcf\xbc\xd4\x8a\x9c\xb1\x7d\x06\x33\xdd\x47\x56\x8f\xf9\xb0\xb6\x28\x99\x70\xc2\x28\x95\xa3\xbb\x93\xe9\x40\xe8\x07\x2c\xc5\x8e\x70\x28\xf1\x89\x06\x76\xca\x58\x5c\x63\x26\xd3\x1c\x74\xfa\xa3\x1d\x71\x12\x59\xc2\x26\xc4\xd6\xda\xb8\x38\x66\x41\x2a\x8b\xa5\xe3\x26\x5b\x68\x72\x28\x64\x0a\x42\xca\xe8\x20\x4c\x8d\xf3\x32\x08\x2d\x93\x81\xdf\xa3\x56\xa2\xda\x4c\x23\xc1\x68\xcc\x20\xf7\x2d\xd6\x33\xe9\xa7\x16\x7c\x93\x15\x77\xe0\x84\xf5\x6c\x76\x6e\xf4\x44\x48\x59\x4c\x39\x3e\xdf\xfe\x84\xa2\x19\xca\xef\x8b\x05\xfc\x3e\x47\xa4\xf4\xb6\x19\x25\x29\xb6\x42\xe9\xa0\xbc\x63\xc1\x19\x84\x73\x8c\xbf\x2b\x6b\xda\x5b\x15\xe1\x65\x70\xc0\xa6\x49\x81\x8c\x57\x64\x84\x4d\xb8\xf1\xb5\x81\xc6\xe8\x2d\x5a\x5f\x23\x10\xba\x3f\x55\xd8\x54\x67\x65\xca\x9f\x21\x6c\xc5\x24\x5b\x2f\x93\xab\x6c\x7f\x11\x76\x6b\xf4\x83\x92\x69\xb7\x79\x93\xd5\x43\x31\xa5\x5a\xbc\xbf\x7f\xf8\x63\xf2\x49\x6d\xad\x70\x61\xbd\x0f\x99\xfa\x5e\x91\xaa\xaa\xd0\x6f\xa8\xdb\xbd\xe7\x35\x3d\x14\x30\x88\xfa\x34\xd5\xe1\x03\x5b\x54\x0c\x96\x9e\x70\xdf\x11\xe0\x77\x45\x2e\x8e\x9b\x6b\x4c\x45\xf0\x86\x9d\xcb\x92\x6c\xb1\x4c\x5e\xf0\x10\xe7\x36\xab\x6f\x48\x29\xa6\x51\x54\xab\x14\x04\xb4\xd8\x1a\xdb\x17\xd3\x5a\x58\x79\x89\x59\xed\x74\xe9\xc1\xb9\x3f\xb9\x5f\xda\x5d\x59\x43\x6b\xfc\x54\x27\x45\x4e\x68\x9f\xea\xaf\xcb\xdf\xa0\xb4\xa2\xe4\x1e\x03\x57\x8b\xb8\x11\xce\x89\x2a\xaa\x55\xf1\x53\x06\x9f\x87\x80\x4a\x8b\x22\xce\xc4\xc4\x34\x12\x2d\xec\xd1\x72\xeb\x11\x8b\xf0\x73\xb8\x61\xc2\xf4\x6e\x07\x8a\xc3\x08\xbd\x75\x1d\x41\x28\x15\x8c\xf2\x2b\x7e\x1a\x78\x88\xab\x80\xf5\x18\x56\xa8\xc5\x52\x75\x0a\x35\xaf\x32\x02\x3a\x28\xc7\xa7\x0c\x6c\x44\xf9\xc6\x19\x6d\x90\x53\x09\xef\x87\xb1\x1d\x6b\xc3\x73\x97\x7e\xdc\x16\x9c\xe3\x97\xd0\x18\xca\xa5\x67\xf5\x3d\x69\xde\x54\x40\x4c\xa6\x48\x2e\x37\x5e\x06\x39\x22\x24\xd9\x87\x55\xbc\x33\xa7\xa3\x60\x27\xf7\x77\xd9\x85\x3e\x4b\xd5\xd5\x68\xb9\x21\x7f\x21\x2c\x2d\xba\x8d\xf9\x9e\x7e\x2f\x6b\x51\xd6\xe2\xe1\xe7\xce\x34\xfd\xfd\xef\x7f\x7e\x9f\x0a\xa4\x62\xfa\xf0\xfe\x0f\xc5\x74\x5b\xb6\xff\x8e\x68\xc3\xde\xfa\x9f\x09\xf6\x1a\xef\x4c\xac\x7f\x32\x16\x24\x3a\xa1\x1a\x02\x2f\x39\x04\xb1\x17\xaa\xf1\x97\xe0\x95\x2d\xa5\x40\x97\x34\xf1\xbc\x1b\xf8\x59\x2c\x93\x78\x6a\x78\xa0\x6b\xd7\xb7\x06\xeb\x68\x63\x0c\xcb\xcd\xff\x1e\xe6\xfa\x78\xb2\xd0\x55\x09\x2c\x96\xa2\x51\x1b\x96\x68\x51\xad\x92\xc7\xf8\x63\x18\x09\xa5\x21\x77\x36\x6d\xde\xd1\x65\xa7\x39\x13\x96\x7c\x59\x2b\x8d\x61\xdb\xdd\x56\x8e\x3f\xdc\xf8\xd4\x99\x3f\xad\x16\x9f\x67\x3c\xb1\xe0\xf1\x35\x5f\xf3\x21\x16\x98\xf0\xc7\x1b\xac\xa3\x6b\x45\x89\xe6\x9b\x07\x1a\x73\x40\x8b\x32\x83\x4f\xa2\xf7\xcb\x63\xc3\x6f\xb4\x1b\xa5\xa3\xca\x23\x0b\x61\xad\xd0\x65\xa2\xe3\xf9\x9e\xcc\xa4\xbf\x52\x8e\x36\xbc\x64\xc4\xd9\x8a\x19\x2e\xa1\xab\x1b\x61\xcc\x05\x8f\x15\x8b\x7f\xdf\x21\x71\x2f\xfb\x73\x23\x85\xca\x34\x1c\xab\x3f\x71\x2e\xd7\xd6\x80\xb9\x98\x47\x46\xf9\x59\x58\x16\x89\x54\xd4\x35\xa2\xf7\x07\xe1\x62\x99\x7c\xa9\x51\x5f\x24\x78\x4a\x65\xe8\xd6\xf4\x1c\x63\x47\xa1\x66\x5b\xb5\xc7\xcb\x9b\x29\x09\xda\x99\x9c\xee\xf4\xab\x91\xca\x8d\xcc\x77\xeb\x0d\x89\xf0\x1e\x3b\x92\xc7\xdf\x29\x6c\xb0\x4c\x56\x7e\xc1\x0d\x5e\x8f\x54\xfa\x58\x4f\x71\x2c\xe6\xc3\xe1\x31\x26\x6e\x74\x80\x10\x4c\x94\x2e\x9b\x9d\xbc\x75\x5a\x1f\x57\xfb\x30\xd3\xc2\x3e\xbd\x83\x56\xf4\xf1\xe3\x24\x03\x1f\x44\x94\x5a\x23\xe2\xc9\x67\xaa\x5b\x03\xea\x22\xb3\x46\x91\x1b\xf2\x22\x16\xff\x47\x45\x6e\xc8\x66\x31\xf7\x27\xdc\x85\xd4\x4f\x0d\xe8\xaf\xa1\x63\xca\xe3\xdc\x2e\x5d\x84\x9b\x3f\xc9\x45\x64\xca\x2b\x1d\x26\xe3\x09\x1d\x0b\x19\x93\xbf\xe3\x81\xd6\x9b\x9d\xf5\xe7\x6f\xd8\x83\x13\xa5\x93\xcb\xcf\x95\xe7\xd7\x4f\x4f\xfe\x5e\xe1\x8f\x1a\x14\x27\xd6\x46\x9f\x24\x29\x50\x2d\xa4\x39\x44\x8e\xc6\xcf\x92\x78\x9b\x68\x30\xfa\x3f\x6a\xae\x7f\x0c\x00\xc4\x61\x81\xe1\x4d\x10\x00\x00")
 
 func vaultedPasswd1Bytes() ([]byte, error) {
 	return bindataRead(
@@ -260,6 +433,46 @@ func vaultedPasswd1() (*asset, error) {
 	return a, nil
 }
 
+var _vaultedRecipients1 = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x56\x41\x6f\xdb\x38\x13\xbd\xeb\x57\xcc\xe1\x43\x3f\x07\x48\xd4\xa6\x8b\x1e\xf6\xe8\x26\xd9\x46\xd8\xc6\x36\x2c\x6f\xdb\x60\xb5\x28\xc6\xe2\xd0\x22\x22\x91\x02\x49\xd9\xd5\x65\x7f\xfb\x62\x28\xc9\x96\x9c\x34\xbb\xb7\x44\xe4\x0c\x67\xde\x7b\xf3\xc6\xf1\xe6\x1e\xf6\xd8\x94\x9e\x44\x76\x65\x29\x57\xb5\x22\xed\x1d\x5c\x47\x71\x7a\x0f\x8b\xf9\xc3\x5d\x14\xaf\x56\x51\x7f\x05\x46\x37\xb2\x2b\xa8\x50\xe3\x8e\x1c\xf8\x82\xc6\x27\xd8\x65\x04\xe5\xc0\x11\x96\x24\x40\x1a\x1b\xf2\xa5\x8f\x8b\xe5\x2a\x4d\xd2\x90\x33\x93\x1f\x33\x79\xf3\x42\x66\x14\x22\x93\x6b\xc8\x64\xa2\xb1\xa2\x4c\xae\xf8\xcf\xba\xd9\x96\x2a\xcf\xae\x9e\xa8\xe5\x2f\x7f\x66\x32\x59\xae\x36\xc9\x72\x91\x66\x72\xf5\x57\x14\x6f\xed\xcf\x13\xda\xea\x79\xbe\xe3\x71\x26\x57\xaf\x47\x97\x6e\x1a\xfd\xfa\x6d\x25\x48\x7b\xe5\xdb\x4c\xae\x43\xcb\xb7\x77\xe9\xcd\x3a\x09\x95\x86\xae\x13\xed\x3c\xa1\x00\x23\x01\xa1\x46\xe7\x0e\xc6\x8a\xcb\x23\x66\x39\x6a\xd8\xd2\x08\x37\x30\x9a\xc0\x58\xa8\x8c\x1d\x83\x1c\xc3\x1d\xe6\x45\x74\xfc\xc0\x60\xa3\x86\x6f\xef\x3f\x7c\xb8\xfe\x15\x3a\xb0\xe0\x89\xda\x18\x36\x05\x9d\xf8\x20\x9d\xdb\xb6\x66\x78\x0e\xca\x17\x80\x60\x51\x0b\x53\x81\x40\x8f\xd1\x13\xb5\x97\x80\x5a\x00\x42\x6e\xea\x96\x4b\x64\x66\xf9\x8c\x53\x71\xfc\xc1\x62\x5d\xf7\x85\x11\xe6\xc5\xa9\xa2\x18\xe6\xba\xe5\x5a\x0b\x53\x0a\xa5\x77\x11\x87\x0e\x60\xc0\xac\xb6\x6a\x8f\x9e\x38\xcf\x05\x27\xc6\x53\x64\xe8\xd9\xd4\xa4\x83\x8e\x42\xa9\x97\xe0\xcc\x19\x24\x91\x2b\xd0\x0e\x75\x7b\xc2\xaa\x42\x4f\x2e\xfc\x6b\x1a\x0f\x7c\xaa\xf4\x6e\x84\x69\x1c\xe0\xfe\xc2\x29\xc6\x3a\x3c\xbd\xeb\x00\x2d\x85\x87\x49\x40\xe3\x38\xba\x35\x8d\x3d\x15\xad\x4e\x54\xd5\xd6\x54\xb5\xe7\xb6\x98\x92\xd1\x23\xf0\x38\x0d\x71\x60\x99\x5d\x69\x4d\x05\x9d\x1e\xff\xf7\xed\xf6\xd3\xf7\x9b\xe5\xe2\xb7\xe4\xd3\xf7\xfb\xe5\xc3\xdd\xdb\x5e\x34\x6f\x87\x20\x56\x4a\x26\x93\x99\x6f\x6b\x95\x63\x59\xb6\x7d\xe0\xdf\x6f\xe3\xdc\x68\xa9\x76\x2f\x45\x5c\x64\x72\x75\xc9\xba\x60\xcc\xa4\x2a\x09\x5c\x4d\xb9\x92\x8a\x04\x6c\x5b\x46\xb2\x97\xe8\x97\xf9\x1f\x9f\x37\x77\xb7\xdf\x93\xdb\xbb\xc5\x26\xd9\x3c\xb2\x96\x49\xef\x95\x35\xba\x62\xdd\xec\xd1\x2a\xdc\x96\xd4\xc1\xf5\xb5\x20\xfd\xc2\xfc\xe2\x0e\x95\xbe\x04\xe5\xc1\x79\x6c\x27\x70\x9e\xcd\xbe\xf2\x70\x40\xd7\xa3\x1a\x31\x3b\x31\xa4\x84\x25\xa3\x1b\xc0\x91\x8d\x23\x01\x4a\x9e\x9b\x86\x1a\xd1\xdf\x35\x94\xa3\xfe\xbf\x67\xe6\xb1\xf1\x05\x43\x95\xa3\x27\x11\xa4\x31\x8a\x43\x21\x48\x80\x37\x27\x20\xb6\x2d\x38\x53\x11\x6b\x71\x50\x07\xe6\x39\x39\xd7\xdf\xea\xcc\x2c\x90\xaf\x69\x4f\x76\xd4\x4d\x0c\xaf\xeb\xa5\x3c\x70\xf7\x8d\x23\x1e\x11\x55\x17\x64\x23\x5f\xa0\x87\x71\x85\xc1\x0e\x2b\x98\x75\xf0\x3b\xca\x2d\xf9\xad\xf9\xc1\xb8\x87\x87\x3b\xd9\xb9\x83\xf2\x79\x11\x2a\xef\x89\xfa\x91\x17\x98\x17\xf8\xfe\x5d\x6d\xca\xf6\xfa\x97\x77\x1f\x32\xb9\xbe\x88\x83\x87\xdc\x2c\x1f\x1e\xe6\x8b\xdb\x34\x8a\x37\x83\x6d\xfe\x07\x8f\x8c\xe6\x42\xb8\x17\xac\x73\x86\x0e\x6a\xab\x34\xbb\xc0\x76\x10\x5b\x2f\xb1\x09\x23\x23\xb5\x0d\xd0\x8d\x8e\x7b\x77\x08\x81\x71\x14\x27\xab\x28\x19\x7d\x61\xf1\xe4\x8d\xb5\xa4\x7d\xd9\x0e\x70\xf6\x9e\x33\x0c\x4f\x10\x94\x72\x63\x2c\x60\x4b\x3c\x64\x3f\x81\x5f\x8b\x6e\x42\xcd\x41\x4f\x46\xae\xd3\x00\xba\x89\xab\xa0\x83\x03\x95\x65\x3c\x02\xed\x5f\xf7\xc0\x9a\x2a\xb3\xa7\x69\x9e\x30\xca\xa7\x4e\xcf\x63\xb8\x51\x52\xbe\xa0\x6e\x14\x4f\xce\x0b\x86\xc5\x41\xc0\xfc\x0c\x56\x7a\x0c\x8c\x61\x0e\x9a\x0e\x13\x6f\xdd\x91\x26\x7b\x94\x78\x77\x9d\xcb\x11\x53\x97\x6f\x34\x8f\x2b\x13\x32\xf5\xcc\x63\xa1\x1a\x8c\xee\x6c\xbf\x44\xe7\x47\x9d\xb0\xed\xf6\xe2\xcf\x51\x6b\xc3\xc3\x35\xbc\x31\x86\xe9\xd9\xc2\xfb\xac\x9c\x7f\xb6\xe5\x27\xfc\xc3\xe3\x4b\xbc\x54\x68\x9f\x06\xda\xbb\xd4\xb3\xd6\x34\x17\x99\x5c\x67\x6f\xc6\x0f\x0e\x31\xec\x84\x2b\x56\xa6\x7b\x86\xa5\x9c\x7a\xf3\x25\xe4\x96\xd0\xb3\xab\x60\x00\x72\x38\x60\x67\x69\x4d\x03\xc2\x80\x36\x3e\x2a\x70\x4f\x61\x83\xb6\xe4\x63\x48\x79\x85\x9c\xa7\x0e\xa2\x34\x4c\xa0\xeb\x71\x6f\xc3\xca\x41\x11\xd4\x16\x64\x35\x62\xa0\x6b\x5b\xd9\x7e\x98\xbb\x01\xed\x7f\x89\x8c\x5a\xca\xae\xb2\x2b\x66\xfe\x0c\xc9\xb4\x37\x69\x96\x18\x1f\x33\x8d\x5d\xe9\xb2\x9d\x02\xcc\x2e\x3e\x6b\xdc\x14\xbe\x6e\xea\x7b\x57\x48\xbf\x26\x9b\x9b\xfb\x64\xf1\x09\x3e\xce\x6f\x7e\x87\xcd\x12\xe6\xb0\x9a\xa7\xe9\xd7\xe5\xfa\x36\x98\xf9\xc6\x84\xc1\x3b\xfa\xf9\xd9\xf8\x0d\xae\x9e\x17\xa8\x77\x04\xca\xbb\x00\xb5\xa0\xb0\xaa\x95\xd1\x50\x91\x2f\x4c\xbf\x1b\xa3\xa9\x4f\x84\x19\x16\xc0\x6d\x3e\x09\xd9\x77\xd9\xdd\xef\x07\xab\x6f\x39\x7b\xc3\x0b\x80\x20\x8a\x3f\xf6\x06\x48\xe2\xaa\x8f\x9e\x5d\x5f\xc4\xd1\x3f\x03\x00\xd5\xdf\x19\xea\x82\x0a\x00\x00")
+
+func vaultedRecipients1Bytes() ([]byte, error) {
+	return bindataRead(
+		_vaultedRecipients1,
+		"vaulted-recipients.1",
+	)
+}
+
+func vaultedRecipients1() (*asset, error) {
+	bytes, err := vaultedRecipients1Bytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "vaulted-recipients.1", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _vaultedRecovery1 = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x55\xc1\x6e\xe3\x36\x10\xbd\xf3\x2b\xe6\xb6\x09\xe0\x08\xbb\x1b\xec\xa9\xa7\x34\x09\x1a\x1f\x92\xb8\x76\x50\xa0\xa8\x7a\x18\x93\x23\x8b\xb0\x44\xaa\x1c\xca\x8e\xff\xbe\x18\x52\xb2\x65\x27\x5b\xa0\x47\x89\x9c\x99\xf7\x66\xde\x1b\x16\x6f\x4f\xb0\xc3\xbe\x89\x64\xca\x9b\x40\xda\xef\x28\x1c\xe0\x9b\x2a\x56\x4f\xf0\x72\xf7\xfc\xa8\x8a\xc5\x42\x0d\x17\xe0\x78\x5e\xde\x80\xef\xc8\x31\x60\x0e\x86\xbd\x8d\xb5\xef\x23\xd8\xc8\xd0\x21\xf3\xde\x07\x33\x83\x9e\xad\xdb\x9c\xa2\xb8\xc6\x40\x9c\x52\xaf\xfe\x7c\x79\x5d\xac\xe6\xab\x94\xbe\xac\x7e\x2d\xab\xfb\x0f\x45\xb8\x6b\x6c\x2c\xab\x25\x94\xd5\xdc\x61\x4b\x65\xb5\x80\xbf\xca\x6a\xfe\xba\x78\x9b\xbf\xbe\xac\xca\x6a\xf1\xb7\x2a\xd6\xe1\x67\xe1\x02\xf0\x3c\x3a\x55\x7e\x78\x5c\xdd\x2f\xe7\x29\x45\x2a\x7e\x37\x30\xd0\xe8\x60\x4d\xb0\xb1\x3b\x72\x80\xa7\x34\x5b\x3a\xcc\x60\x5f\x5b\x5d\x83\xe5\x8c\x09\xac\x8b\x7e\x60\x33\x70\x5c\xd5\xd8\xda\xf0\x85\x15\x93\x0e\x14\xd3\xa1\x75\x9b\x02\xee\xdc\x41\xf0\xc7\x3a\x10\xd7\xbe\x31\x42\xc2\x57\x10\x6b\x1a\x13\x08\xce\xf4\x9d\x70\xa4\x5a\x0d\x41\x45\x7b\x0a\x6a\xb8\x12\x68\x47\xd8\x80\xf3\xb1\x96\x86\xe2\x5a\x5a\x2d\x21\x53\x94\x05\x3c\xa1\x33\xe9\x3c\xa7\x86\xe8\x81\x50\xd7\xe0\x2b\xc5\xb4\xa3\x80\x0d\x74\xe4\xbb\x86\x00\x9b\xc6\xef\x19\xd6\x81\x70\x5b\xde\x6c\x1a\x64\x06\xd4\x9a\x98\x25\xe8\x72\xa8\xe8\x0e\xe0\x1d\x0d\xb8\x5b\x25\x3c\xa4\xce\x74\xd8\x45\x6a\xe6\xdb\x48\xe3\x0b\x0b\x24\xe9\xd8\x3e\x60\xd7\x91\x81\xca\x87\x0f\x90\x33\xbc\x68\x5b\x3a\x35\x40\x62\x98\xb0\x21\x33\x53\xec\xa7\x8d\xda\x12\x75\xb0\xf7\x61\x2b\xb5\xf7\xf5\xd0\xb5\x11\xc0\xd8\xd5\x63\x12\x5d\xa3\xdb\x90\x29\xe0\x0f\xf9\xc3\x4a\xd8\x5c\x0c\x16\xb0\xd9\xe3\x81\xa1\x67\x02\x04\x6d\xbb\x9a\x04\x24\x46\xc0\x3e\xd6\xe4\xa2\xd5\x18\x89\xc1\x46\xb8\xca\x2a\xcb\xe3\x5d\xfb\xf7\xb2\x5a\x66\x5b\x30\x60\x20\xe0\xbd\x8d\xba\x26\x23\xed\xcb\x37\xdf\x75\x8d\xba\xc6\xef\x5f\x3b\xdf\x1c\xbe\xdd\x7e\xfd\x51\x56\xcb\xeb\x19\xa0\x33\x89\x9e\x70\xb0\x0c\x81\xaa\x9e\xc9\x80\x4d\xe0\xd5\x19\x38\x3b\x91\x05\x54\xb6\x21\xd0\xe8\xbe\x44\x51\xe9\x14\x9e\x29\x92\xae\xef\x5f\x9f\x9f\xef\x5e\x1e\x56\xaa\x78\x1b\x1d\xf5\x89\x81\xd4\x6f\xe4\x28\x24\x52\x08\x8e\xf6\xe7\xed\x18\x67\x94\x4b\x0a\xd4\x2e\x58\x17\xa5\x01\x3c\x4c\x21\x2b\x7a\x70\xb2\xaf\x00\xa1\x0b\xb4\xb3\xbe\xe7\xf3\x54\x67\xd3\xe0\xe8\x8f\x93\x2b\x26\x00\x3f\xb1\xe8\x92\xd0\x8c\xb5\xa0\x0a\xbe\x05\x8e\xc6\x3a\xb8\x12\x01\x76\x14\xa0\xb1\x8e\xae\xa1\x77\xd1\x36\x40\xce\xf7\x9b\x7a\xbc\x1d\xfd\x85\x97\x54\x8d\x3b\x82\x35\x91\x03\x72\x91\x02\x99\x99\x80\x72\xd0\x05\xdf\x76\x91\x93\x26\x73\x1b\x8e\x2a\x3a\x6b\x41\x01\x2b\xeb\x34\x09\xfd\xd1\x8a\xa7\x94\xd9\x94\x43\xce\x73\xf2\x69\xb0\xad\xdf\x89\xec\x85\xc2\x31\xe1\x2f\x10\x7a\xf7\xb3\x85\x75\x1c\x17\x6e\x50\x66\xef\x41\x07\xc2\x28\xd2\x14\x88\xde\x51\xa1\x8a\xf9\x42\x65\x3d\x0f\x8b\x47\xca\x71\xe3\x23\x83\x0f\x83\x6f\x12\xad\x40\xda\x76\x96\xdc\xa0\xcf\x40\x4c\x51\x52\x22\x48\x58\x43\x6a\x64\x9c\xd5\x33\xec\xd4\xc9\x6c\xca\x9b\xf2\x26\x73\x1e\x26\xa4\x7d\xef\xa2\x48\x68\xd5\x91\xb6\x95\x25\x96\x66\x82\xeb\xdb\x35\x05\x71\xdf\x69\x0c\x89\xc9\x27\x6d\x91\xb5\x79\x95\xf4\x2e\x66\xbc\x50\xe9\x75\x01\x0f\x54\x65\x6e\xd1\xc3\x8f\xa9\x50\x04\xcc\x64\x85\xfe\x2f\x3c\x81\xfe\xe9\x6d\x20\xf3\x51\x1f\x13\x2c\x70\x89\x45\x4d\xb1\xdc\x5e\x62\xa9\x7c\x68\x71\xb4\xd6\xf8\x71\x89\x24\xff\x97\xf8\xe4\xa2\xe9\x2a\xb3\xee\xbf\x6a\x0f\x3f\x22\xbd\xcb\xb7\xba\x92\x40\x93\xe1\x5c\x43\x83\x6b\x6a\x38\x6f\xce\xc4\x30\xad\x94\x4d\xf0\x7d\x97\x7d\xaa\x6b\x0c\xa8\x23\x85\xd4\xc6\x16\xb7\xa2\x5f\x20\x64\x4b\x41\x45\x0f\x31\xa0\x63\x1d\xec\x9a\xc6\x42\x6b\x64\xba\xfd\x2e\x6c\x06\xbb\x8b\xd7\x72\xee\xd1\x71\x33\xe0\xde\x46\x5c\xcb\xcb\xe4\x03\x90\xd3\x3e\x3f\x35\xac\x10\x7e\x5f\x82\xf6\x46\xd4\x29\x2f\xfb\xd3\xdd\xf2\x31\xbf\xeb\x8f\x27\x8c\x96\x21\x57\xc9\xa1\x64\x12\x6a\xeb\x74\xd3\x9b\xb4\x8b\x74\x4d\x7a\xcb\x7d\x3b\x03\xf6\xd0\x5a\x8e\x07\x79\x34\x86\x76\x61\x20\x65\x28\x92\x96\x6d\x07\xab\x0e\x35\xf1\x0c\x0c\x72\x2d\x87\xce\x80\x46\xa6\xb4\x87\xed\xc6\x79\x99\x75\x7a\x1e\x4e\xd1\x40\x2e\x52\x20\x53\xa8\x7f\x07\x00\x3d\x6d\xc4\xb4\xf0\x08\x00\x00")
+
+func vaultedRecovery1Bytes() ([]byte, error) {
+	return bindataRead(
+		_vaultedRecovery1,
+		"vaulted-recovery.1",
+	)
+}
+
+func vaultedRecovery1() (*asset, error) {
+	bytes, err := vaultedRecovery1Bytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "vaulted-recovery.1", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
 var _vaultedRm1 = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x8f\xcd\x6a\xc4\x20\x14\x85\xf7\x79\x8a\xb3\x9a\x55\x47\xe8\x23\xb4\xd3\x81\x64\xd1\x8c\xc4\x6c\x0a\x6e\x4c\xbc\x36\x42\xa2\x53\x35\x43\xe7\xed\x4b\x1c\xa1\x3f\x94\xd9\x09\x9e\xef\x7c\xf7\xb0\xbe\xc6\x45\xad\x73\x22\x2d\xf7\x61\xc1\x63\xc5\x44\x8d\xf6\xe9\xf5\x58\x31\xce\xab\xf2\x85\xb0\x40\xee\x11\x68\xf1\x17\x8a\xa0\x4f\x1b\x93\x75\xef\x37\x32\x66\x44\xbc\xb5\x27\x2e\x1a\x91\x31\x69\x9e\xa5\x39\x7c\xc3\xd2\x74\x90\xa6\x71\x6a\x21\x69\xf8\xf6\x94\x3b\xc6\x98\x34\xfc\x9f\xb8\xa6\x99\x12\xdd\x43\x86\xf0\xd7\x90\x0f\xbb\x87\x88\x1a\x2f\x47\x71\xe8\x1a\xde\x37\xa7\x36\x5b\xbb\xb2\x26\x4d\x54\x86\x20\x9e\x69\xb4\xc6\x92\xc6\x70\xfd\x51\x25\x77\x0c\xfd\x44\xdb\xee\x84\xd1\x6b\x82\x8d\xa0\x8f\x55\xcd\x48\x3e\xf3\x6e\x5d\x06\x0a\xf0\xa6\x2a\x4d\x69\x52\x5b\x74\x9d\x35\x9c\x4f\x18\xa8\xdc\xa8\x59\x76\x37\x06\xea\x26\xc5\xa8\xdc\xef\xc4\x43\x6e\xa4\x10\x7c\xd8\x3c\xda\xc6\xf3\xac\xae\xa4\xe1\x1d\x62\xd2\x7e\x4d\xac\xfa\x0a\x00\x00\xff\xff\xe6\x20\x08\x4c\xb7\x01\x00\x00")
 
 func vaultedRm1Bytes() ([]byte, error) {
@@ -280,6 +493,26 @@ func vaultedRm1() (*asset, error) {
 	return a, nil
 }
 
+var _vaultedRollback1 = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x74\x92\x41\x6e\xe3\x3c\x0c\x85\xf7\x3e\xc5\x5b\xb6\x40\x23\xa0\x47\xf8\xdb\xbf\x40\xbd\x98\xd4\x88\x33\x8b\x01\xbc\x61\x6c\x2a\x16\x46\x91\x0c\x51\xb6\x91\xdb\x0f\xa4\xd8\x49\x93\xc1\xac\x6c\x89\x8f\x8f\x1f\x49\xa9\xfd\x27\x26\x1a\x6d\xe4\xae\xd9\x04\x6f\xed\x81\xda\xdf\x78\x2d\x54\xfd\x89\xed\x7f\x3f\x3e\x0a\x55\x55\xc5\x22\xc0\x35\xde\x6c\x10\x58\xa2\x0f\x2c\x20\x0c\x81\x27\xe3\x47\x41\xfa\x8a\xf1\x0e\x5e\x83\x2e\xb6\xd9\xa8\xfe\xb5\xfd\xaa\xea\xb2\xce\x66\x8d\x7e\x6b\xf4\xfb\xa3\x65\xa3\x77\x68\x74\xe9\xe8\xc4\x8d\xae\xd2\xef\x6a\xd6\xe8\x2a\x9b\xfc\xff\x51\xbf\xef\xca\x6a\x5f\x7e\x6d\xb3\xcf\x6e\x05\x88\x3d\xa3\xf5\x2e\xb2\x8b\xa9\xf0\x7d\x2a\x74\xf0\xa7\x2c\xe9\x4d\xd2\x9f\x93\x24\x1d\x33\x80\xc2\x4f\xe1\x07\xa2\x45\x97\x80\xa2\x87\x35\x12\x73\x3a\x4d\x64\x2c\x1d\x2c\x5f\xbb\x14\x95\x39\xf6\xf7\xe5\x63\x7f\x53\xc0\x08\x84\x26\xee\x40\x69\x4e\x8e\xe7\x5b\xe8\x3b\x06\x9e\x66\x13\xfb\x74\x5e\x58\xbe\x4f\xc5\x0f\x1c\x28\x1a\xef\x9e\x5f\x20\x3e\xef\xc0\xb8\x23\x52\x18\x2d\x39\x98\x28\x6c\x35\x0e\x8c\xd1\x75\xde\xf1\x8d\x6a\x59\x51\x77\xc7\x43\x76\xa6\xb3\x80\x5d\x1b\xce\x43\xea\x77\x2d\x8d\x76\x0c\x21\xcd\x70\x20\x91\xd9\x87\x6e\x41\xbc\x6c\xff\xef\xda\x8e\x27\x0e\x38\x04\xe3\x8e\x72\xb9\x21\x07\x6f\xbb\x6b\xbe\x42\x99\x0d\x6e\x86\x33\x49\xd1\xf6\xe4\x8e\xdc\x41\x8c\x6b\xf9\x71\x59\x33\x2d\x03\x7b\xc1\xd9\x8f\x98\x8d\xb5\x20\x2b\x3e\x75\x37\x04\x7f\xca\xc0\xda\x87\x3b\xdb\xe2\x5f\x26\xb9\x35\x85\x9a\x19\x85\x7a\xdb\xad\xef\x7c\xb3\xbe\x84\xa7\xd7\x67\x55\xfc\x19\x00\x7f\x1f\x04\x5a\x01\x03\x00\x00")
+
+func vaultedRollback1Bytes() ([]byte, error) {
+	return bindataRead(
+		_vaultedRollback1,
+		"vaulted-rollback.1",
+	)
+}
+
+func vaultedRollback1() (*asset, error) {
+	bytes, err := vaultedRollback1Bytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "vaulted-rollback.1", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
 var _vaultedShell1 = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x58\x5d\x6f\xdb\x3a\x12\x7d\xd7\xaf\x18\x60\x81\xbd\x09\xe0\xa8\x48\xdb\x27\x2f\xf2\xe0\x8d\xd5\xda\x48\x6a\x0b\x96\xd2\x20\xb8\xba\x08\x68\x69\x14\x11\x95\x48\x5d\x92\xb2\x9b\x7f\xbf\x18\x52\x5f\x4e\x94\xec\xed\x02\x7b\xdf\x62\x7e\x9c\x19\xce\x9c\x39\x33\x8a\x1f\xaf\xe0\xc0\x9a\xd2\x60\x96\x5c\xe8\x02\xcb\x12\x2e\x3d\x3f\x5a\xc1\x66\xf1\x2d\xf0\xfc\x30\xf4\xda\x5d\x70\x9b\xc9\x05\x68\xc3\x94\xd1\xc0\x04\x70\x61\x50\xb1\xd4\xf0\x03\xb6\xdb\x47\x6e\x0a\x30\x05\x82\xc6\x54\xa1\xd1\x90\x4b\x65\x7f\x5b\x14\x28\x25\xcb\x30\xa3\x7b\xd2\x9d\xa2\x4b\xd6\x5c\xf4\xb0\xd9\x86\xd1\x3a\xb2\x26\x93\xfc\xdf\x49\x7e\x7d\x62\x38\xc9\x77\x90\xe4\x6b\xc1\x2a\x4c\xf2\x10\x7e\x4f\xf2\xf5\x36\x8c\xd7\xdb\x4d\x94\xe4\xe1\x1f\x9e\xbf\x57\x53\xb7\x20\xb9\x48\x2e\x98\xd6\x0d\xdd\xb2\x00\x4c\x89\xc9\xfb\xd1\x0a\x96\x41\x74\xbd\x5b\xdb\x45\xeb\x45\xf4\xce\x3b\xcf\x1a\x8d\xda\x3e\xc1\x59\x8d\x56\xc1\xed\x2d\x99\x40\x71\xe0\x4a\x8a\x0a\x85\x81\x03\x53\x9c\xed\x4b\x9c\x01\xcf\x41\xa3\xf9\x97\x27\x4d\x81\xea\xc8\x35\x42\x86\x39\x39\xaa\xc1\xc8\x16\xe2\xc3\x9e\x8b\x0f\xba\x48\xf2\xdd\xb9\x6f\xfd\x69\xfd\xf3\xfc\xb8\x8b\xc8\x1b\xaf\xf1\xa2\x1a\x53\x9e\xf3\xd6\xa3\xbc\x29\x4b\x58\xec\x36\xd0\x86\x5e\xc9\x12\x81\x02\x07\x32\x1f\x16\x8c\x04\x07\xe5\x43\x84\x48\x06\x16\x51\x74\xf7\x6d\xbd\xf9\x0a\x0b\xd8\x6d\x6f\x03\x0a\xd3\x1e\x4b\x79\xb4\x39\xcc\xd0\x30\x5e\x6a\x90\x02\x0a\x79\x84\xef\x6d\x94\x1d\x84\xb6\x90\xda\xf7\xfc\x75\xe8\xed\x08\xdd\xae\xd7\x86\x4b\x01\x15\x7b\x86\x3d\x42\x8d\x2a\x97\xaa\xc2\xcc\x72\x44\x36\x06\xb4\xf5\xfa\x99\x8b\x27\x60\x2d\x3f\x8c\x04\x5d\xb3\xa3\x80\x5c\xc9\xca\xf7\xee\x0b\xa4\xe0\x1f\xe4\x0f\xcc\xc0\x14\x5c\xc3\x91\x3d\xcf\x20\x55\x98\xa1\x30\x9c\x95\x1a\x98\x42\xd0\xb2\x51\x29\x66\xf6\x52\x17\x58\x28\x65\xca\xc8\xbe\x86\x33\xf4\x9f\x7c\x6f\x94\x98\x19\xa4\x52\xe4\xfc\xa9\x51\xf6\x04\xe4\xbc\x44\x3d\x03\x2e\xb4\x61\x22\x45\xa8\x95\xa4\xa5\x19\xa0\x49\x7d\x4a\xc6\x49\x02\x84\x4c\x2e\x34\x6a\xcd\xa5\x48\xf2\x9d\xb7\xe4\x9a\x72\xec\x42\xff\x84\x02\x5b\x50\x8a\x35\x56\xb5\x54\x4c\x3d\x9f\x7a\x2c\x32\x97\x81\x21\x46\x3e\xc4\x05\x7a\x35\xaa\x8a\x09\x22\xce\xf8\xb8\x36\x52\xd9\x92\x19\x95\x11\x3d\xba\xd1\x76\x55\x1b\x64\xd9\x74\xe0\x53\x26\x4e\x03\xcf\x72\x83\xca\x05\xd8\x05\xdd\x71\xb9\xd1\xf4\x6b\xe0\xf2\x09\xcb\xbc\x54\x56\x15\xb9\xdc\x57\xb6\x65\xd6\x88\x48\xcf\xb2\x81\x23\xd7\xc5\x88\x51\x2f\x22\xa6\x30\x57\x68\x99\xed\x6a\x0a\x18\x08\x3c\x42\x1b\x44\x87\x4c\x0b\x6f\xc7\x8b\x41\x8b\x81\x19\xe0\xcf\x9a\xbb\x18\xbf\xb6\xf3\xe4\x92\x42\xa5\xd1\xfd\x08\xbd\xed\x01\x95\xe2\x19\x3a\x97\xed\x32\xf9\xba\x6f\x63\x48\xec\x5e\xdc\x47\x94\x03\xae\xa9\x4c\xf5\xf8\xa0\x3d\x72\x2c\x50\x78\x5d\x6e\x29\x56\x53\x8e\xba\x24\x58\xca\xb2\xee\x36\xd7\x0e\xe0\xec\xc0\x19\x4c\x38\x3a\x1b\x25\x95\x1b\x8d\x65\x3e\xeb\xaa\x16\x45\x5a\x4a\xca\xcc\x98\xb9\xbf\xe9\x16\x65\x71\x1f\x3d\xee\x82\xaf\xeb\xed\x86\x9e\x2b\xd5\x68\x79\x19\x7c\x59\xdc\xdd\xc6\xa3\xed\x4e\x87\xf4\xf9\xcc\x65\x1f\xb3\x31\xa8\x86\x23\x2f\x4b\xe0\x22\x2d\x9b\x36\x4a\x53\x46\x28\x0f\xef\x58\xf1\xa6\x94\xcf\xca\x1b\x17\x19\x4f\x99\x71\xc8\xad\x8a\xba\x08\x38\x9d\x5b\xdc\x47\x70\x13\x3c\x58\xcd\xfd\x9d\xe8\x86\xc2\xfc\x31\x87\x7f\xc0\xd9\xfd\x2a\xd8\xc0\xb7\xed\x72\xfd\xe5\x81\x74\x29\x5e\x05\x51\x00\xcb\xed\x75\x34\x83\xc5\x6d\xb4\x85\xbb\x70\xb9\x88\x83\xf9\xd0\xc0\x50\x1c\xfc\x4b\xbf\xca\xc8\x59\x6f\x58\xfd\x89\xa9\x5d\x3e\xb7\x36\x3a\xed\xb2\x12\xfe\xd7\x8b\xce\xc8\xae\xbc\x71\x20\x80\x37\xbe\xe5\x0a\x89\x9e\x13\xc5\x96\x4f\xf4\x4e\xdd\x94\x96\x33\x2f\x45\xab\x93\x20\x52\x36\x09\x25\xd3\x86\x98\xe8\x91\xbd\xac\x19\x69\x48\x6f\xbf\x2b\x97\xb3\xd1\xcd\x81\x56\x5d\xdb\xc3\x8c\x9b\xb6\x85\x84\xa1\x17\x4f\x56\x54\xd5\x68\xd3\xd3\x9f\x0b\x90\x2a\x43\x35\x94\x2f\xd1\x57\x96\xe8\xb7\xad\x78\xbd\x91\x06\xe7\xae\x1b\xa4\xac\xd1\xd8\x8b\xff\xb8\x87\xe9\x66\xaf\x0d\x37\x8d\x7d\xeb\x74\x50\xa9\xce\xbd\xc9\xd2\x71\x65\x30\x3e\x4b\xa2\x52\x2b\x79\xb0\x65\x2b\x7b\x8b\xd4\x4b\x84\x34\x50\x31\x93\x16\x9e\x29\xa4\x46\x7a\x00\xeb\x68\xdd\x05\xc9\x7f\x9d\x68\x4a\x0b\xe9\x7b\xc6\x54\x36\xd9\xa5\xdd\xac\x32\x72\x62\xee\xf9\xbb\x88\x8a\x1a\x92\xb3\x7d\x03\x1f\xbd\x81\xfd\x8b\xeb\xeb\x20\x8a\x1e\x6f\x82\x87\xc7\xf5\x92\xc8\x4f\xf3\xc7\x42\x00\xb7\x77\x73\x8e\xaa\x1f\x7c\x58\x9a\xa2\xd6\xf0\x03\x9f\x7d\xb8\x13\xfc\xcf\xc6\x3e\x08\x59\x5a\x90\xd6\x50\x8a\x87\x68\x51\xfe\xdf\x94\x96\xd7\x5e\x44\xc1\xf5\x2e\x88\x47\xce\x74\x9e\xc4\xfd\x00\xe6\x72\x4c\xf9\xe1\x4f\x02\x14\xfe\xd9\xa0\x36\xfa\xff\xe0\x49\x14\xad\xb7\x9b\xc7\x78\x7b\x13\x58\xb1\xf8\x00\x27\x6e\xde\xed\xd6\xf1\x43\xbf\x6b\x7d\x0c\x5d\x76\x9d\xb8\x76\x3d\x68\xd2\xe4\x7b\x50\xa4\xb0\x2d\x4f\x32\xcf\xd2\xb0\xae\xa5\x32\x50\xe2\x13\x4b\x9f\x21\x5a\xde\x90\xcb\xbb\xc0\x09\xcd\xe9\x80\xf3\xb7\x09\xce\xe2\xc5\xc4\xd5\x75\x66\xdd\x8e\x6d\x19\x20\xa7\xc1\xd0\x51\xd9\xa2\xfc\xa6\x5f\xcc\x28\xd4\x41\xbc\xe9\x52\x27\xf9\x1f\xa0\x48\x12\xde\xe8\xe5\x20\x6b\xf3\xaa\x38\x72\xae\xb4\xe9\x95\xcd\xb5\xdb\x94\xa5\x05\xfd\xd9\x6b\xce\xe9\x14\x7f\x66\x11\x47\x73\x9b\x37\x9a\xcc\x8f\x4c\x0f\xde\x9c\x5b\xb8\x7e\x56\x1e\xd4\xb0\x03\x36\xb2\x9b\x4f\x5c\xb1\xb8\xf8\x50\xb8\xbc\x94\x95\x65\xdb\x94\x59\x59\xca\xa3\x6e\xbf\x2b\xfa\x8b\x7b\x74\x8e\xba\x16\xce\xa0\x94\xe2\x09\xd5\xa0\x9e\xa6\x60\x62\x84\xea\x29\x59\x96\x40\xa8\xae\xdf\x59\x50\x38\xab\xd8\x4f\x5e\x35\x15\xd1\xff\x12\x0a\xd9\xa8\xf3\xde\xa8\x96\x50\x21\x13\x64\x98\x99\x49\xff\x2c\xfd\xfa\xf9\xca\x96\x92\xe1\x56\x41\x69\x9e\x19\xab\x0c\x8d\x16\xad\x46\xd9\x31\x75\xf4\x16\x97\x8f\x07\xd9\x58\x5e\x58\xb3\xed\x68\xdc\x2a\xb1\x1b\xde\x29\x92\x5d\xd2\xdc\x03\x0c\xd5\x8b\x21\xda\xa7\x0a\xbb\x06\xdb\x4f\xff\xd6\x0c\x37\x33\x28\xf9\x0f\x9a\x91\xe7\xd6\x8c\x95\x34\x91\xbf\xfa\xaa\xeb\x68\x02\x51\x53\xa3\xa2\x51\xd2\xf3\x73\xee\x4a\x27\x0c\xbd\x63\xc1\xd3\x02\x8e\xb2\x29\x33\xca\xa2\x2c\x0f\x96\xcf\xbd\x41\xa6\x44\xcb\x38\xa6\xc4\x9c\x1d\xf5\x9c\xb3\x6a\x3e\xbf\xbc\xbc\xfc\xf8\xf1\xe3\xa7\x4f\x9f\x3e\x7f\xfe\x3c\xa7\xa7\x7c\xe8\xe1\x93\x7c\x97\xfc\xd3\x3d\xdd\xcd\x4c\x03\xa7\xe8\xa0\x6b\x09\x5d\x72\x5e\x76\xc4\xe9\xb6\xca\x35\x5c\x7a\x94\xc2\x19\xcd\x17\x4c\x65\x25\x29\x6f\x7b\xa5\x87\x18\x4a\x65\xdc\xde\x5f\x16\x9c\xf3\x6c\x2d\x80\x65\x19\x37\x2d\xe3\xdc\xe9\xae\x5d\x0c\x40\x6c\x2f\x0f\x38\xeb\xb3\xd3\x0a\x92\xee\xef\xb2\xf2\x8d\xe9\xc8\x8e\xbe\x5c\x10\x7f\x9c\x73\x6c\x4f\x5f\x46\xdd\x6c\xfd\x46\x07\xfa\x4e\x93\x57\xb0\x7c\x0c\x36\xdf\x1f\x49\xc8\xa8\x03\x6c\xef\x36\xf1\xa8\x17\xc5\xae\xf3\xc8\x46\x18\x58\x2f\x4f\xe6\x75\x97\xe7\x6c\x42\xc9\x5f\xe3\xee\x36\x63\xc0\xe1\xc3\xf2\x7f\x83\xdb\x2c\xbe\x05\x63\xbc\x57\xdf\xa4\xbf\x80\x15\x2e\x76\xf1\x3a\x6e\x47\xcf\x0e\x90\x7a\x7c\xcd\x94\xe1\x27\x5c\xf9\x65\xe4\x78\x35\x06\xad\x99\x29\xde\xc0\x6a\x8b\xe3\x8b\x54\x80\x3f\x59\x55\xdb\x8c\xfd\x95\x22\xfb\x2f\x45\x42\x26\x3f\xbc\x51\x88\x5d\x09\xda\x4f\x06\x47\xe0\x5c\x92\x94\x51\x35\x0c\xcc\xda\xa3\x93\x59\x73\xea\xd1\x3b\xd4\xb9\x1a\xfb\x31\x71\x70\xb7\xb9\xfa\x35\xb7\x27\xf3\x7f\xf5\xce\x7e\x9f\xd3\x2b\x76\xd4\x53\xdb\xf1\xea\xca\x19\x19\x42\x12\xad\xe0\xeb\xdd\x1a\x42\xa6\xf5\x51\xaa\x0c\x42\x25\xab\xda\x68\xfb\xe8\xaf\x77\xeb\xe4\x62\xcf\x68\x02\xaa\xbb\xfd\xda\xed\x77\x2d\xd8\x8e\x47\xfb\x67\x0a\x94\x39\xfd\x0a\xee\xcc\x2f\xa2\x9b\x70\x11\x45\xc4\x88\x2e\xba\xf6\x3f\x26\xa7\x73\xf7\xd9\xe5\x39\x35\x58\x6a\x44\x95\x54\xd8\xfd\xbb\xc4\xf7\xfe\x13\x00\x00\xff\xff\x60\x35\x9c\x83\x60\x13\x00\x00")
 
 func vaultedShell1Bytes() ([]byte, error) {
@@ -300,7 +533,27 @@ func vaultedShell1() (*asset, error) {
 	return a, nil
 }
 
-var _vaultedUpgrade1 = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x90\x4d\x6e\xc3\x20\x10\x85\xf7\x9c\x62\x2e\x10\xa4\x1e\xa1\x4d\x23\xc5\x8b\x3a\x96\xf1\xa6\x12\x9b\x89\x67\x88\x23\xd9\x90\xf2\x93\xb6\xb7\xaf\xc0\xa1\x0b\x2f\xb2\x43\xbc\xf7\xbe\x4f\x20\x87\x23\xdc\x31\xcd\x91\x49\xef\xd2\xed\xe2\x91\x18\x5e\x84\x54\x47\x68\x5f\x3f\x0e\x42\x76\x9d\x78\xe4\x50\x63\xbd\xab\xc7\x00\x33\x5f\x70\xfc\x5d\x11\x01\xa2\x83\x38\x31\x8c\xc9\x7b\xb6\x71\xbd\x05\xe3\xfc\x82\xb1\x20\xd5\x67\x7b\xea\x54\xa3\x0a\x56\x9b\x37\x6d\xf6\x1b\xb8\x36\x7d\x69\xbe\x1f\xd4\xbe\x6f\xba\xa1\x39\xb5\xa5\xdc\x33\xd2\xd6\x86\x96\x60\x74\xf6\xce\x3e\xab\x27\x5e\x9e\xf9\x25\x0c\x13\x43\xc0\x85\xc5\x0d\x43\xf8\x76\x9e\xe0\x1a\x20\x05\xa6\xdc\x58\x77\x2b\x8c\xe9\x61\x90\x45\x9d\x77\xfc\x73\x8d\x30\x3a\xe2\xbc\xe1\xaf\x84\x73\x75\xd9\xb4\x9c\xd9\x83\x33\xff\x7f\x30\x61\xae\xa6\x99\xc0\xba\x08\x67\xae\x4f\x23\x29\xfe\x02\x00\x00\xff\xff\x93\xa5\x62\x52\x6e\x01\x00\x00")
+var _vaultedSync1 = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x55\x41\x6f\xdb\x38\x13\xbd\xeb\x57\xcc\xa9\x88\x01\x47\x45\xaf\x3d\x7d\xad\x9b\x0f\x31\xd0\xc4\x86\xe5\x64\xb1\x80\x2e\x34\x39\x32\x89\x50\xa4\xc1\xa1\x9d\xea\xdf\x2f\x66\x28\x25\x72\xda\x3d\xec\x29\x88\x39\x1c\xbe\xf7\xe6\xbd\x51\xbd\xbf\x87\x8b\x3a\xfb\x8c\xa6\xbd\xa5\x21\x68\xf8\x52\xd5\xcd\x3d\x3c\x7e\x7b\xb8\xab\xea\xed\xb6\x1a\x0f\x41\xce\xda\x5b\xf9\x4b\xe5\x0a\x81\xd2\x29\x12\x41\xaf\xb4\x75\x01\x09\xce\xe4\xc2\x11\x8e\x2e\x4b\x8f\xe6\xef\xc7\xcd\xb6\x59\x37\xd2\xa7\xed\xbe\xb7\xdd\xea\xaa\x9b\x0b\x2e\xb7\xdd\x0e\xda\x6e\x9d\xb0\x8f\x19\xdb\x6e\x5b\xd5\x87\xf4\xa7\xda\xd3\x99\x6c\xdb\xed\xfe\xfd\xd8\x7b\x39\x6e\xee\xe1\xc7\x5d\xb3\xda\xad\xb7\xfb\xf5\xe6\x51\x5e\x7e\xc6\x44\x2e\x06\x82\x6c\x71\x02\xee\x02\x28\xf0\x51\x2b\xcf\x68\x21\xe1\x29\x92\xcb\x31\x0d\x70\xe3\x82\x14\x1a\x97\x50\xcb\x2f\x13\xd7\x84\x15\xe5\x98\xd0\x80\x0b\x8b\x25\x50\xe4\xba\x01\xb4\x0a\x70\x40\x81\x81\x06\x5e\x5d\xb6\xa0\xa0\xf0\xf9\xd0\xbb\x86\xad\x57\x2e\x40\xa1\x77\x14\xf2\x95\x8e\x7d\xaf\x82\x91\xfe\x70\x26\x34\xd2\x79\x5e\x02\xfd\x99\x32\x3f\xe1\x02\x65\xe5\x3d\x97\xa8\x60\x40\x85\x61\x7a\x27\xa1\xd2\x56\x1d\x3c\x42\xbc\xe0\x24\x50\xe7\x3c\x7e\xfd\xfc\x99\x15\x8e\x09\x88\xec\x04\x95\x5f\x81\x1b\xac\x8f\x35\x28\x38\xf0\xbb\x33\xfe\x91\x95\x79\x6a\xbe\x83\x49\xee\x82\x10\x13\xa8\x8a\x30\x5d\x30\x2d\x6a\x91\x73\x13\xfc\xa8\x09\xf0\x0b\x05\x38\x5e\x30\x01\x53\x71\x39\xa3\xa9\xa1\x41\x62\xcd\x41\x2b\x6d\x91\x96\xac\x14\x58\xc7\xf2\x0d\x10\xbb\x71\x0a\xcb\x6a\xa2\x11\xb3\xc5\x34\x6b\x17\x3e\xf6\x7b\x96\x0b\x4c\x97\x05\xc4\xa0\xd3\x70\x62\x6f\x94\x61\x55\xef\xf8\x97\x80\xbf\x94\xce\x7e\x00\x25\x03\x1f\xa4\xdf\x38\xb7\x18\xc0\x38\x7a\x99\xf1\x98\x59\x22\x5b\x95\xa5\xf8\x35\x31\x89\x00\x39\xca\xbf\xe3\x60\x6f\x08\x11\x56\x4f\xcd\x7e\xf3\x00\xcd\x7e\xb3\xbb\x6b\xc0\x85\x29\x1d\x37\x5f\x16\x8b\x1a\x76\xa8\x4c\x7b\x1b\xdf\xf4\xa1\x51\x64\x1a\x28\x63\x3f\xfe\xb6\x98\x11\x2c\xad\x6b\x09\xcb\x6a\xf3\xf0\xf0\xed\xf1\x47\x53\xd5\xfb\x29\x2c\x7f\xcc\x47\x93\x55\xca\x04\x97\x62\x6a\x8e\xdb\x3b\x87\xe2\x0b\xee\x2a\xdc\xfb\xe2\xc6\xf9\xf5\xf6\xd3\x9b\x96\xca\x27\x54\x66\xa8\x5c\xb8\xaa\x10\x78\x1c\x27\xb6\x59\xb6\x28\x02\x8f\x51\x19\x59\x95\x0a\xb2\x68\x58\xa3\x0f\xed\xab\x7a\xbd\xad\xe6\xbf\x41\xaf\x06\x76\x9d\x82\xdf\x6c\xf9\xb4\xfb\xc9\x90\x41\x1d\x28\xfa\x73\x46\x38\xa9\x6c\xe1\x86\x85\xff\x60\xc9\x6c\xb1\x2a\x18\xc6\x5d\xb3\x90\x8b\xa5\x23\x91\x9d\x37\x64\xcb\x06\x20\x7d\x6a\x6f\xbd\x7b\x41\x71\xfe\x98\x13\x99\xc7\xa8\xee\xd1\xe5\xff\xe1\x2f\xd5\x9f\x3c\xd6\x3a\xf6\x5f\x0b\xb9\xba\x64\x8e\xa7\x79\x0e\x22\xef\xf5\x2c\xd4\x91\x0d\xa8\xad\x0a\x47\x14\x91\xc7\x08\xd6\xb3\xb9\x4d\xbb\x6a\x25\xfe\xa5\x99\x80\x6f\xf7\xe2\x6f\x53\xe3\x4b\x38\x8e\x2d\xc7\xab\xce\xdb\x33\x59\x46\xe2\x08\x12\x76\x92\x5e\xd7\xcd\x2a\xc0\x2a\x9a\x41\x52\x19\xac\xba\x20\x84\xc8\x5b\x03\xc3\x38\x4d\x18\x30\x5f\xa3\xf4\xfe\x3f\xa3\xec\x31\x4d\xbc\xa7\xaa\x2e\xc5\x7e\x0c\x21\xc3\x05\x17\xca\xbd\x7e\xf2\xf5\xe3\xff\x7f\xae\x57\xfb\xf2\x15\x78\xfe\xb0\x37\x4a\xd0\xa5\xab\x01\xef\x02\xc2\x61\x90\xbf\x4b\x20\x17\x34\x16\x3a\x3a\x9e\xbd\x81\x53\x8a\xe6\xac\x11\x54\xa5\x63\x4a\x67\xc9\xbf\x28\x58\xc3\x3a\x50\x46\x65\x96\xf0\x3a\x19\x96\x54\x3f\x22\x87\xd7\x37\x75\x0c\x1c\x62\xb6\xc5\xcc\xbc\x21\x02\x2f\x90\xea\x5d\xc8\xe5\x4c\x84\x72\xd7\x11\xbc\xe0\x29\x4b\xe9\x4c\xf0\xb7\x43\x52\x17\xfe\x04\x39\x23\xc0\xc9\x19\xac\x14\x71\x9e\x82\xea\xd9\xfc\x45\xea\xf6\x53\xad\x63\xe8\xbc\xd3\xb9\xbd\x6d\xbb\x5d\xdb\xad\xcb\xae\x1c\x13\xb9\xb7\x08\x53\x01\x0f\x7a\xcc\x19\xaf\x6b\x49\xe0\x81\x1f\xd6\x31\x68\xe7\xd1\x54\x87\x01\x2c\xe3\x11\x33\xf3\xb3\xf1\x84\xe2\x54\x21\xc7\x27\x8c\xf2\x32\xad\x06\x1d\x4f\xc3\xb4\x08\xe6\xdf\xcd\xd4\x8b\xcf\x65\x2c\x7f\xf1\x2b\x6a\xa6\x97\x74\x28\x1b\x33\x06\x2c\x04\xb9\xf3\xa4\x63\x89\x64\xd9\xdb\xcb\x99\x1d\x4c\x75\xa5\x5b\x5d\xfd\x33\x00\x83\x5d\xf3\x6c\x60\x08\x00\x00")
+
+func vaultedSync1Bytes() ([]byte, error) {
+	return bindataRead(
+		_vaultedSync1,
+		"vaulted-sync.1",
+	)
+}
+
+func vaultedSync1() (*asset, error) {
+	bytes, err := vaultedSync1Bytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "vaulted-sync.1", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _vaultedUpgrade1 = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x53\xd1\x6e\xdb\x30\x0c\x7c\xd7\x57\xf0\x6d\x09\x90\x08\xe8\x27\x64\x5d\x8a\x06\x68\x9b\x20\xce\x0a\x0c\xd3\x1e\x14\x8b\x8e\x85\xda\x92\x2b\xca\xf1\xf4\xf7\x83\x64\xab\x49\x3b\x74\xd8\x9b\x4d\xe9\x8e\x47\xde\x89\x1f\xee\xe1\x2c\xfb\xc6\xa3\x12\xcb\xbe\x3b\x39\xa9\x10\x6e\x18\x2f\xee\xe1\x69\xf5\xb8\x66\x7c\xb7\x63\xd3\x39\xe4\x63\xb1\xcc\x9f\x04\x0d\x9e\x64\x19\x46\x0a\x02\x6f\xc1\xd7\x08\x65\xef\x1c\x1a\x3f\x56\xa1\xb2\xae\x95\x3e\x51\x16\x3f\x9e\xb6\xbb\x62\x53\x24\x5a\x51\x7d\x15\xd5\xed\x07\x72\x51\xed\xe1\xa7\xa8\x36\xdb\xdd\x61\xb3\x7d\x2a\x44\xb5\xfb\x95\x90\xdf\xd6\xc5\xed\x7e\x93\x8a\x09\xbc\x47\xa9\x3e\x76\x97\x46\x41\x69\xcd\x19\x9d\xa7\xa8\xa3\xfd\x97\x1e\x0e\x87\x1a\x81\x64\x8b\xac\x93\x44\x83\x75\x0a\x34\x41\x4f\xa8\xa2\xe2\x88\xcf\x64\xa8\xa6\x0e\x3c\xb5\x8e\x38\xfc\xad\x3d\x94\x56\x61\xc4\xe0\x6b\x2f\x9b\xdc\xcb\xf4\xed\x11\x1d\xd8\x2a\xab\xf2\xb5\x8c\x57\xfb\x46\x81\xb1\x1e\x8e\x98\x47\x55\x3c\x4d\xf6\xbc\xfa\xfe\x70\x80\xbb\xcd\xc3\x1a\xee\xb6\xfb\xc7\xd5\x21\x35\x59\xcb\xb2\xce\x7a\x75\x83\xe0\xb0\xb4\x4e\x45\x36\x84\x33\x3a\xd2\xd6\xc4\x1e\xf1\x77\x9c\x07\xb4\x87\x41\x12\x0c\x4e\x7b\x8f\x06\xb4\xe1\xf0\x1c\xf1\x04\xda\x30\x69\xc0\x36\x0a\x5d\xbe\x2c\xdd\xd5\x74\x8b\xb4\xb9\x0b\xf0\xdd\xce\x46\xc0\x02\x86\x1a\xd3\x41\x60\x11\x6b\x3b\x34\xa8\x38\xac\x26\x8d\x57\x60\x09\x06\x87\xb7\x4e\x6c\x76\x0c\x6f\xa5\x2b\xe1\x49\x1a\xaa\x39\x94\xd2\x7c\x49\x5b\x99\x28\x3f\x8f\x06\x88\xa5\x58\xca\xa6\x89\x11\x71\x18\x3b\x22\x01\x9e\xd1\x4d\x01\xf8\x4c\xb9\xd2\xd4\x35\x32\x68\x73\x62\xf1\xd8\x21\x4d\x29\x00\xbc\x2c\x79\x36\xa6\xb1\xef\xa2\x8f\x4a\x7a\x14\xd5\x7e\x01\xb9\x98\xa2\xa9\x52\xc9\x3a\x18\xea\x00\x7a\xf2\x74\x14\xcf\xf2\x95\x39\x87\xdd\x94\x26\x82\xb4\x28\xd3\x04\x70\xf8\xda\x23\xf9\x29\x59\x53\x2e\x86\xda\x52\x32\xc1\x47\xb1\x6d\x4f\x89\xc8\xa1\x58\xa2\x29\x5d\xe8\xe2\x75\x6f\xaf\xf3\x32\xfa\x14\x43\xf4\x17\xcd\x0b\x86\x98\xc4\x52\x96\x35\x2a\x38\x86\xe8\x14\x23\xa4\x94\x13\x79\x42\xe3\x39\x14\x81\x3c\xb6\x19\x37\x23\xc4\xfc\xf2\x67\x37\xf3\x79\x52\xeb\x50\x2a\xb1\xb4\xa6\x09\x0b\xa0\x94\xe7\x90\xea\xf4\xa2\xbb\x2e\x07\x76\x7a\x9a\x8c\x1f\xb2\x51\x17\x63\xd8\xfe\x3f\x8c\xb9\x8a\x75\xf6\x48\x1b\xf2\x28\x55\x8c\xf4\x38\x6d\x74\xeb\xdd\xeb\xe6\xec\xcf\x00\x42\xf0\xdd\x09\xab\x04\x00\x00")
 
 func vaultedUpgrade1Bytes() ([]byte, error) {
 	return bindataRead(
@@ -320,7 +573,27 @@ func vaultedUpgrade1() (*asset, error) {
 	return a, nil
 }
 
-var _vaulted1 = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x57\x7f\x6f\xdb\xbc\x11\xfe\xbb\xfc\x14\xb7\x6c\xe8\x9b\x00\x8d\xdc\x0e\x5b\x87\xb7\x03\x06\xb8\xb1\xdf\xc6\x5b\x53\x1b\x91\xdb\x75\xa8\x8a\x82\x16\x4f\x36\x51\x8a\xd4\x78\x94\x1d\xff\xb3\xcf\x3e\x1c\x29\x29\x8e\xe3\xa0\xc5\x1b\x20\x80\x45\xf1\x9e\xe7\x7e\x3c\x3c\x9e\xb2\xe5\x35\x6c\x65\x6b\x02\x2a\x78\x25\xb2\xfc\x1a\x3e\x8c\x6f\xa6\x22\x5b\x2c\x44\xbf\x5c\x5c\x02\x35\x72\x67\x81\x90\x48\x3b\x4b\x50\x79\x57\x03\x61\xd9\x7a\x34\x7b\xa0\xe0\x3c\x2a\x7e\xf6\x18\x28\x62\xe4\xff\xf9\x30\x5f\xe4\xb3\x3c\xe2\x14\xd5\xdb\xa2\xba\xea\xd0\x8a\xea\x16\xd2\x42\x71\x69\xd3\xc3\xcc\xca\x1a\x8b\x6a\x01\x5f\xfa\x17\xba\xa8\x6e\xbf\x8a\x6c\xe5\x7f\x87\x6d\x71\xc9\xc6\xfc\xea\xea\x66\x52\x54\x8b\xa7\x5c\x98\x5d\xcd\x6f\x6e\xc6\x1f\x26\x9d\xf1\x4c\xfa\x35\x65\x59\x56\x54\x8b\xaf\x31\x84\xc9\x34\xbf\xba\x9d\x2d\x96\xb3\xf9\x87\x08\x31\xab\xc0\xba\x23\x3b\x4d\xd0\x78\xb7\xd5\x0a\xd5\x0b\x78\xc4\x81\x3a\x6c\xd0\xa7\xdc\xd1\xbd\x43\x70\xae\xab\xc1\xec\x02\x9c\x17\xdd\x0e\x69\x41\xdb\x80\x5e\x96\x41\x6f\x11\x68\x83\xc6\x64\x07\xee\x77\xb1\x41\x2d\xf7\xb0\x42\x68\x09\x15\x04\x07\x4a\x57\x15\x7a\xb4\x41\xcb\x80\x10\x36\x78\x40\x15\x0b\x75\xec\x58\xf1\xfc\x17\x02\xb7\xb3\x20\xfd\xba\xad\xd1\x06\xca\x62\xc4\x5d\x60\xb9\xc8\x96\x3d\xa5\x54\x31\x92\x51\x87\x51\x7a\x94\x01\x0f\x57\x2c\xee\x8a\xea\x56\xcc\xee\xfd\x36\x7b\x48\xdb\x28\xfa\x52\x3a\x1b\xd0\x06\x70\x15\x48\xb0\xb8\x4b\x62\xcb\x20\x47\x04\x91\xbd\xbd\xed\xc5\x77\x29\x95\x82\xf3\x57\x17\xd9\x01\x7b\xd9\x3c\x20\x77\xcd\x9e\xb9\xae\x5c\xa3\x4f\x81\x47\x20\x90\x56\x01\xc9\x2d\x12\xe8\x00\x92\x0e\x49\x61\xa7\xc3\xa6\x5b\x68\x24\xd1\xce\x79\x75\xc2\x91\xb2\x39\xf6\x43\xb5\x35\x7b\x22\xfe\xed\xf5\xc9\xb0\x12\x7a\x70\x40\x41\xb9\x36\xd2\xfe\x33\x9f\x7f\x38\x81\xcd\x48\xc7\xe8\xa8\x74\x78\x9c\x43\x5e\x7d\x4c\x65\x01\xef\x34\x05\x6d\xd7\x4f\xe6\x91\x0d\x1f\x51\xd8\x2d\x33\xcc\xdb\xd0\xb4\x81\x92\xb2\xa0\x74\x75\x2d\xad\x62\x12\x19\xc0\x38\x39\x1c\x61\xa8\x9c\x1f\xc2\xd2\x36\xb8\xe8\x47\xd2\xe3\x09\x42\xbb\x7d\xc4\x77\x87\x25\x13\x4e\xef\xb0\x6c\x39\x65\x47\x8c\x5d\x21\xd6\x7a\x8b\xb6\xa3\x71\x1e\xbc\x33\x78\x0a\xff\x0e\xcb\x63\x02\xf6\x96\x09\x3e\x12\xa6\x5c\x0f\x07\xaa\x2b\x83\xb6\xfc\x23\x09\x31\x62\x63\x63\x64\x89\x4f\xd4\xee\x04\x6b\xcc\xc7\x31\x2b\x1d\xea\xd1\x68\x8a\x75\x7b\xaf\x29\x10\x48\x63\x92\x2d\x9d\x02\xa3\x63\xa8\xa8\xbf\x07\x67\xab\x57\x64\x94\xf8\x46\xda\x75\xa7\xb4\x7e\x3d\x15\xe5\x27\x04\x90\xa0\x8f\x09\x7d\x7d\x48\xa6\xd0\xe0\xc3\x83\xec\xb1\x76\x5b\x5e\x11\xb7\xf1\x17\x1d\x11\x9d\x0a\xcb\xd7\xc7\x2c\xb1\xce\x0c\x92\x07\xe9\xc3\xe9\x96\x96\xaa\x1f\x15\x75\x20\x37\x7e\x4e\x4a\xe0\xcc\xa3\xfa\xb1\xee\x12\xd8\x91\x03\x6d\xb3\xf6\x52\xc5\x38\x3e\xa6\x9f\x04\x06\xd7\xb2\xdc\x77\x61\x40\x87\x5a\xb6\x9e\x7b\x66\xc7\x59\x39\x5f\xcb\x53\xc9\xec\xf0\x3a\x9a\xfc\x1a\x7e\x9b\xbd\x9f\xc2\xfb\xf9\xd5\x98\x2f\x86\x74\xbf\x7d\x4a\xc0\xdc\x79\x4a\x59\x6e\xe2\x55\xd8\x5d\x94\xd2\x63\x7f\x3d\xca\xb2\x74\x5e\x71\x3e\x3b\x0f\x3e\x4f\xde\xc1\x5b\x49\x08\x13\xed\xb1\x0c\xce\xef\x21\x6f\xb0\xd4\x95\x2e\x65\xd0\xce\x42\xf1\xc5\xc8\xaf\x9b\x10\x1a\x7a\x33\x1a\x51\x90\x56\x49\xaf\x28\xab\x3c\xa2\x42\xfa\x1e\x5c\x93\x39\xbf\x1e\xad\x24\xa1\xd2\xfe\x92\x1a\x2c\x1f\x3c\x5c\x1a\xee\xc2\x21\xdb\x84\xda\x14\x5f\xbc\xfc\x5a\x3c\x1f\xae\x93\xe8\x73\xbc\x21\xb4\xc1\x07\x7e\x6a\xfb\x46\x64\xb7\xb9\xc8\x66\x0b\x28\xce\x57\x2d\xfc\xb9\x4b\xed\x9f\x3e\x4f\xde\x7d\x9b\x8c\x97\xe3\x6f\xd7\xf3\x9b\xe9\xa8\xcb\xd0\xa8\xbb\x4d\xcf\xc3\xbe\xd1\xa5\x34\x66\xdf\x09\xea\x7f\xa3\xcc\xb8\x52\x9a\x11\x6d\xa4\xc7\xc3\xed\x17\xf1\x56\x7e\x1a\x7e\x32\xbb\xcd\x7f\x08\x3f\x6a\xc9\x8f\x0e\x08\x78\x1f\x57\xe0\xe0\x6d\xbf\x9e\xf8\x6e\xa7\xf7\xc5\x3a\x88\x7a\xe7\x75\x08\x18\xbb\xc5\x8f\xc2\x2c\x9e\x67\xb0\x74\xb0\x92\xe5\xf7\xb6\x81\xbd\x6b\x3d\x7c\xea\x46\x24\x25\x83\x7c\x11\x7b\x40\x42\xd6\x56\x84\x8d\x26\x50\x43\x69\x69\xe3\x5a\xa3\xf8\xde\x66\x7b\x54\xd0\x36\x2c\xb7\xa8\x93\x24\x9b\xce\x54\x39\xb0\x2e\x80\xc5\xd4\xcb\x56\x08\x1e\x83\xd4\x16\xd5\x50\xbc\xce\x8c\xcb\x77\x68\xf9\xd3\x45\xbc\x1a\x5f\x5d\xff\x54\x01\x23\xfa\xe3\xd2\x71\x2a\xf3\x6b\x98\x7e\x9e\x2d\xe1\x6a\x3e\x99\xf2\xc4\x90\x0b\x69\xcc\xca\xdd\xfd\x5d\x94\x2b\x28\x57\xa2\x04\xf3\xe8\x3f\x13\xd3\x3b\x1d\xa0\x74\x0a\x9f\xdd\xa0\xb4\xda\xae\xc5\xcb\x67\x79\x5b\x96\x48\x94\x89\xd7\x7f\x79\x36\xb3\x5b\x69\xb4\x82\xab\xf7\x33\x68\x49\xae\x11\xce\x09\x11\x6a\xa4\xf8\xc0\x8d\xa2\x76\x1e\x41\x71\x4a\x0c\x5d\x64\xe2\xf5\x5f\x9f\x2d\x37\xc8\x85\x94\xb1\xdd\xb4\xd6\x63\xe9\xb6\xe8\xe5\xca\x20\xdf\x0a\x2b\x83\xf5\x7d\xcb\xd9\x0e\xe5\xcf\xc4\xeb\x5f\x9f\x8d\xc1\xe3\x7f\x5b\x9d\xc6\x57\xbf\xd5\x25\xa6\x99\x0e\x09\x6d\x30\x7b\x68\xad\xdc\x4a\x6d\x22\xd6\x39\x66\xeb\x0c\x24\x7d\xe7\x06\x7b\x91\x89\xbf\xfd\x3a\xb8\x3b\xf4\x68\x6a\x9b\xc6\xe8\x58\xaa\x65\x4a\xd2\xbb\x8f\x33\x58\xf4\xaf\x17\xde\xd5\x0d\x8f\xc8\x8b\x85\x18\x9b\xb0\x71\xed\x7a\x33\x68\x28\xf8\x38\xd6\x38\xa8\xe5\x77\x04\x6a\x3d\xb2\xc6\xa0\x94\x16\x3c\x26\x21\x75\x95\x89\xf7\x5b\x2f\xf8\xca\x6b\xb4\x8a\x5e\x08\x72\x35\x06\x5d\xa7\xc9\x47\x13\x50\xd0\xc6\x70\x2c\x55\x97\x8c\xe0\x78\x66\x04\xc9\x3e\x15\x97\xb1\x53\xdc\x7b\xde\x44\xd7\x32\xf8\x2d\xf6\x62\x4d\xc2\xa3\x24\x67\x5f\x0c\xee\xb1\x1f\xab\x78\x79\x56\x7a\xdd\xfa\xa4\xce\x88\x67\xfb\xa4\x80\xae\x1b\x83\x3c\x51\xc6\x06\x96\xf5\xb6\xbf\x90\x18\x76\xd8\x80\x6b\x9f\xfa\x9b\x26\x8e\x79\xbd\x46\x06\xdb\x6d\xf8\x10\xc6\xe1\x95\x43\xfc\x34\xfe\xf8\x7e\x39\x9d\x7c\x1b\xe7\xff\x5a\x8c\xf3\x9c\x83\xdd\x4a\xaf\x63\x1c\x1c\x1b\x86\x74\x1a\x16\x4e\xdb\x78\x49\x3d\x69\x16\x5c\xba\x35\x79\x12\x89\xe6\x5c\xfc\x34\xf3\x0c\xee\xd2\x10\xc1\x4e\x1b\x23\x4a\xc9\x71\x0d\x75\x49\x61\x26\x84\x34\x41\x44\x08\x4a\xcd\x3a\xed\x48\xe9\x8b\x2f\x5b\x42\xcf\x4a\x15\x7d\x6e\x29\x83\x65\x34\xf2\x14\xa0\x91\x5e\xd6\x18\xd0\x3f\x98\x58\xd8\xee\xc0\xc5\xf4\x5d\x11\x01\xf1\x2e\x08\x4e\x9a\x55\x43\x3f\xa0\x0d\x0f\xef\x9d\x15\xb3\x25\xfc\xd3\x45\xe0\x4d\x36\x76\xb9\x34\x4f\x0c\x5e\xdd\x77\xbc\x34\xb5\xf6\x7a\xf2\x18\x5a\xcf\x57\x17\x50\x3a\x98\xf1\xbc\xc2\xf9\xcb\x8b\x0c\x66\x3c\x31\x55\x52\x1b\x16\x67\x5a\xb6\xce\x16\x97\x2f\x2f\x84\xa6\xce\x92\x3f\x85\x1e\xcc\x2d\xda\x36\x6d\x14\xa4\x5c\x39\x1f\xfa\x36\xd6\x67\x57\x27\x4d\xf4\xe1\xf5\xfa\xe0\xe1\x40\xd6\x06\x89\xcc\x3e\x9d\xde\x61\x1c\xe9\xe2\x14\x0f\xe3\xa4\xee\x7c\x76\x21\xd1\xa6\xb8\xec\x36\x72\xdb\x4a\x9c\x73\x0b\xb5\x2c\xe7\xf9\x0b\x0e\x2e\x9a\xc3\xb8\x69\x0c\xe6\xa5\xd7\x4d\x78\x2a\x81\x9d\xf0\xf9\x73\xeb\x4d\x84\x89\x8d\xd5\x56\xe2\x8f\x7f\x88\xd7\xcc\x4a\xdb\x11\xcf\xc0\x8e\x24\x45\x20\x21\x9c\x05\xdf\xc6\xef\xab\xad\x00\x00\xd0\x15\x18\xb4\xeb\xb0\x89\x33\xa7\x5f\x6f\xe1\x1f\xf0\x32\x56\x26\xbe\xe6\x3f\xc2\x30\xb4\x39\xce\x43\xc0\x1a\x5e\xf5\xdb\xe3\x2e\x34\x84\x4f\x6d\x3f\xeb\x5b\xcc\x9b\xb3\xb4\xd7\x2a\xd0\x95\x10\xfd\xd6\xca\x3b\x1b\x6a\x47\xe1\x9b\xe4\x06\xd5\x0d\x18\xc1\x01\x7f\x3e\x33\xcb\xb9\xb6\x95\x8b\xfd\xf5\xbc\x91\xdc\x2b\xdd\xbd\x0d\x1c\xd8\x5c\x5c\x44\xcc\xc0\xa3\xd7\x21\xd4\x49\x82\xc1\x5b\xa5\xa9\x31\x72\x0f\x4a\x4b\xe3\xd6\x83\xe3\xa9\x2b\xeb\x60\x10\xce\x3a\x3d\x9c\xa5\x45\x5d\xc6\xc4\xb7\x11\x3b\xae\x6c\xb4\x52\x68\x41\x5a\xda\xa1\x07\x85\x55\xf7\xb5\x17\x1f\xcf\xce\xc4\xc0\xc5\x27\x66\x90\x22\x87\xe6\x91\x5a\x13\x86\xb4\xb0\xeb\x82\x7f\xf8\xd6\x8a\xac\xd2\xf1\x3a\xfb\x7f\x00\x00\x00\xff\xff\x8b\x8a\xf9\xbf\x06\x11\x00\x00")
+var _vaultedVerify1 = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x55\xcf\x6e\xe3\x36\x13\x3f\x87\x4f\x31\x87\x0f\x58\x1b\x70\xb8\x5f\x0a\xb4\x87\xed\xc9\x8d\x8d\xc6\x45\x37\x36\x2c\x37\xbb\x45\xb5\x08\x46\xe2\xc8\xe2\x46\x26\x55\x0e\x65\xc7\x6f\x5f\x0c\x25\x3b\x4e\x36\x07\x1f\x68\x0e\x67\xe6\xf7\x67\x46\x7a\x73\x07\x7b\xec\x9a\x48\x26\xbf\xde\x53\xb0\xd5\x11\x6e\x94\xce\xee\xe0\x7e\xfa\x79\xae\xf4\x6a\xa5\x86\x6b\x18\x6e\xf3\x6b\x28\x6b\x2a\x9f\x18\x62\x4d\x50\x13\x36\xb1\x06\x5f\xf5\x59\x38\x3d\xcd\xfe\xbe\x5f\xae\xb2\x45\x96\x9e\xe7\xd5\x6f\x79\x75\xfb\x3a\x49\x5e\xad\xe1\x9f\xbc\x5a\x2c\x57\x9b\xc5\xf2\x3e\xcb\xab\xd5\xb7\x74\x76\xb8\xa3\xbc\x5a\x81\xd6\xfa\x5b\xca\x34\x9b\x67\xb7\xeb\x45\x8a\x4a\xc9\x6e\xfb\xca\x84\x65\x0d\x12\x6c\xfa\xb2\x30\xf2\x01\xb0\x69\x86\x26\x26\x70\xa8\xc9\x81\xf3\x29\x86\x01\x03\xc1\xd6\xee\xc9\x8d\xe1\x60\x63\xed\xbb\xa8\x7c\x4b\xce\xba\x2d\xd8\x38\x81\x40\xad\x0f\xd1\xba\xed\x27\xa5\xd7\x99\xd2\x8b\x15\xe4\xa3\xa2\x83\x9f\xd4\x97\x9a\x62\x4d\x21\x21\x4d\xa9\xa1\xb2\x0d\x41\x89\x0e\x0a\x82\x16\x03\x93\xd1\x97\x0f\x36\x12\x48\x81\xad\x77\xc2\x89\x8d\xdc\xbf\xa8\x7c\xd8\x61\x84\x91\x94\x07\x84\x03\x86\x54\xbd\xf2\x01\x7c\x63\x28\x0c\x01\x3c\x01\xa6\xa1\x94\x08\xd2\xb5\xdb\x80\x86\x46\x37\xe3\x09\xa0\x33\x80\x0e\x28\x04\x9f\xc2\x4f\x4f\xc0\xd1\x21\xb5\x88\x0e\x62\x6d\xf9\xb2\xfe\xc3\xc0\x3a\x77\xad\x40\xe4\xb1\x7e\x0f\x9d\x74\xf9\x44\x47\x30\x14\xec\x1e\xa3\xf4\x2e\xc5\xc8\x95\xe1\xd8\xa6\x63\xe9\x5d\x65\xb7\x5d\xe8\x2f\xa5\x06\x36\xd6\xbc\x0b\xa7\x97\x9b\xa9\x0c\x14\x0b\xff\x9c\x57\x6b\x51\xc3\x96\x35\x18\x4f\xec\x3e\x44\xc0\x2e\xd6\xe4\xa2\x2d\x31\xd2\x0b\xb3\x1f\x18\x76\x14\xd1\x60\x44\x51\xa4\xb4\xad\x25\x17\x39\xc1\x0e\x54\xfa\x3d\x85\x23\x3c\xd1\xf1\x35\x84\xc5\x8f\xad\xef\x28\xd6\x5e\xa8\x32\x22\x10\xee\x28\x52\xe0\x77\x5b\xed\x23\x19\x7c\xb8\x8c\x3c\x10\x3e\xbd\xf0\x49\x60\xa8\x92\xfe\xde\x70\x27\x42\x37\xbe\x7c\x61\xab\xa5\xb0\xb3\x2c\xc4\xf3\x2b\xe5\xdf\x16\x4e\xce\x94\xbc\xe9\xd6\x32\x60\x59\x12\xb3\x2d\x1a\x82\xe2\x08\x3e\x29\xd2\x31\x85\x37\x05\x1f\xce\xf6\xe3\x64\xe2\xc4\x1c\xe3\x8e\x92\xc7\xc1\xba\x81\xf9\xff\x7d\x9d\xfd\xfe\x38\x9b\x6e\xa6\x8f\xb3\xc5\x3a\xfb\x38\x58\xe9\xa3\x4c\x5c\xac\x31\xa6\x59\xe0\x1a\x8d\x3f\x90\x91\x82\x67\x01\xb4\xd2\xeb\x7e\xe0\xe7\x32\x5d\x27\x51\x38\x62\xec\x18\x2c\x0f\xf9\xfd\x53\x92\xb4\x3f\x0c\xa0\x24\xf9\xe8\x9c\x48\x62\x3b\xc6\xa2\xa1\x09\x14\x5d\x84\x1a\x59\x09\xa9\x8e\x98\x89\xc7\x70\x36\x49\xb2\xf2\x9b\xb7\x25\x8a\x45\x0a\x02\x19\x50\x32\xc2\x41\x76\x07\xc3\x9e\x50\x7a\x73\xda\x27\xf9\x75\x7e\x2d\x21\x79\xb5\x56\xd3\x86\xbd\xb8\xde\x56\x96\x64\x2b\x61\x04\x3a\x43\x38\x0d\xab\x04\x93\xd1\x20\xc2\xb5\xc8\x7c\xf0\xc1\x88\x50\x17\x91\x96\x55\xa0\x7f\x3b\x62\x59\x54\xa3\xbe\xce\xc3\xf4\xaf\x3f\x37\xf3\xd9\xe3\x6a\x9a\x65\x5f\x96\xeb\x99\xb4\x9b\xf0\x91\xe9\xa5\x64\x8a\x13\x20\xbd\xd5\xfd\x31\x74\x0e\xaa\xe0\x77\x50\x06\xef\xc6\xba\x97\x8d\x81\x09\x1b\x32\xc9\x75\x97\xde\x0e\xa7\xbe\xa0\x63\x31\xc7\xd1\x77\x01\xac\x91\xe9\x88\x47\xb0\x8e\x23\xa1\xd1\x6f\x60\x7f\x67\x9f\x60\x2f\xbb\xd8\x76\x51\x00\xd3\xb0\xc2\x00\x19\xfe\xc8\x96\xf7\x9f\x64\x51\xf8\xe2\x3b\x95\xf1\xc5\x2b\x32\x41\xb2\x22\x87\x01\x4d\xb2\x0a\x1a\x31\x2f\x0e\xe9\xf7\xa9\x5b\xf9\xb7\xb1\x1c\xa1\xf6\x8d\x91\xb6\x2e\x2a\xbc\x62\xac\x17\x67\xfe\x75\xb1\x81\xdb\xe5\x6c\x9e\x29\xbd\xc9\x14\x36\x4d\xe1\x9f\x7f\x55\x65\x01\x65\xa1\x4a\x68\xce\x3f\xad\xe6\xcf\x36\x42\xe9\x0d\x5d\x7d\x26\x94\x39\x54\xff\xbf\x9a\x9e\xd7\x76\xe2\xa3\xff\xa0\x1c\xb5\xba\xb9\xca\xfc\x6e\xb0\x05\x43\x8d\x7b\x3a\xcd\x10\xf7\xbe\x72\xde\x51\xff\x7f\x32\x12\x6b\xf5\xcb\xcf\x3f\xbe\xe9\xef\xd2\x07\xc2\x78\xf1\x16\x3d\x5b\x8e\x63\xad\xf4\x66\xae\xfe\x1b\x00\xb4\x0a\x97\xf0\xfe\x06\x00\x00")
+
+func vaultedVerify1Bytes() ([]byte, error) {
+	return bindataRead(
+		_vaultedVerify1,
+		"vaulted-verify.1",
+	)
+}
+
+func vaultedVerify1() (*asset, error) {
+	bytes, err := vaultedVerify1Bytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "vaulted-verify.1", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _vaulted1 = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x5a\x7b\x8f\xdb\x38\x92\xff\x7b\xfc\x29\xea\xb2\x87\x89\x0d\xb8\xdd\x49\x76\x67\xef\x36\x07\x1c\xd0\xd3\xed\x49\x7c\x93\x7e\xa0\xed\xcc\x4c\x30\x1e\x04\xb4\x58\xb2\x08\x4b\xa4\x96\xa4\xec\x78\xff\xb8\xcf\x7e\xa8\x22\x29\xc9\x6d\x75\x26\x38\x20\x01\xda\x12\xc9\x7a\xff\xea\x41\xcd\x56\xef\x61\x2f\x9a\xd2\xa3\x84\xd7\xa3\xd9\xf2\x3d\xdc\x5d\xdd\xce\x47\xb3\x87\x87\x51\x7a\xbc\xbe\x00\x57\x8b\x83\x06\x87\xce\x29\xa3\x1d\xe4\xd6\x54\xe0\x30\x6b\x2c\x96\x47\x70\xde\x58\x94\xf4\xdb\xa2\x77\x7c\xc6\xf2\xd3\xdd\xfd\xc3\x72\xb1\xe4\x73\xd6\xf9\x8f\xeb\xfc\x3a\x9e\xb6\xce\x1f\x21\x3c\x58\x5f\xe8\xf0\x63\xa1\x45\x85\xeb\xfc\x01\x7e\x4f\x2f\xd4\x3a\x7f\xfc\x63\x34\xdb\xd8\xff\xc7\xde\xf5\x05\x6d\x86\x75\xbe\xb8\xbe\xbd\x59\xe7\x0f\xc3\x2c\xf4\x96\x33\xfb\xf1\x34\xa9\xec\x3a\x7f\xf8\xa3\xff\x5a\x94\xa5\x39\xac\x2f\x0e\x28\x76\xeb\x8b\x5a\x38\x77\x30\x56\xb6\x24\xee\x6f\x6f\xaf\xee\x6e\x22\x03\x0b\x61\xb7\x6e\x36\x9b\xd1\x11\xac\x86\x9b\xf9\xf2\xfa\x71\xf1\xb0\x5a\xdc\xdf\x31\x1b\x8b\x1c\xb4\x79\xb2\x4f\x39\xa8\xad\xd9\x2b\x89\x72\x0a\x67\x7c\xa2\xf2\x05\xda\xa0\x7f\xd7\x09\x05\x63\x95\xb7\xdb\x26\x60\xec\x28\xae\x10\x1a\x94\xf6\x68\x45\xe6\xd5\x1e\xc1\x15\x58\x96\xb3\x9e\x0a\xa2\x7e\xa0\x12\x47\xd8\x20\x34\x0e\x25\x78\x03\x52\xe5\x39\x5a\xd4\x5e\x09\x8f\xe0\x0b\xec\x91\x62\x63\x3f\x65\x6c\xfd\xfd\x4b\x07\xe6\xa0\x41\xd8\x6d\x53\xa1\xf6\x6e\xc6\x12\x47\xc1\x96\xa3\xd9\x2a\x91\x14\x92\x36\xc0\x65\x14\x2e\xb3\x28\x3c\xf6\x9f\x68\x3c\xac\xf3\xc7\xd1\xa2\xe3\xbb\x3c\x42\x58\xe6\x98\x97\xcc\x68\x8f\xda\x83\xc9\x41\x80\xc6\x43\x70\xd8\x19\x2c\x11\x61\x34\xfb\xf1\x31\x39\xf0\x85\x90\x12\xc6\xaf\x27\xb3\x3e\xf5\x2d\x6a\x4f\xc7\xbf\x37\xa5\x74\xd0\xe8\xd2\x64\x3b\x94\x61\x0b\xec\xf0\xe8\x40\x69\xa8\xb0\x32\xf6\x38\x05\x67\x20\x99\xd8\x81\xb0\x08\xda\x78\xb0\xf8\xcf\x06\x1d\x45\x02\x8a\xac\x00\xaf\x2a\x1c\xa2\x4d\x84\xce\xa8\x37\x52\x31\xf5\x1b\xe5\xea\x52\x1c\x1d\x08\x2d\x61\x8f\x56\xe5\x2a\x0a\xc7\x4b\xa0\x34\xdb\x20\xde\xb3\xa2\xf1\xb2\x27\xc7\x67\xf5\x89\x66\x4d\x7d\x24\x5a\xd7\xa6\x56\x43\x9a\xe3\xa3\x98\x01\x27\xf6\xe8\x40\x79\x10\xae\xaf\x51\x38\x28\x5f\xc4\x07\x49\x0d\x03\xac\x64\xf5\x53\x31\xc9\x7d\x02\xe5\xaa\x16\xf6\x9c\xb6\x3f\x98\xb0\xdb\xc1\xd8\x58\xb0\xb8\x57\x01\x48\x3a\xbe\x26\x03\x84\xe8\xd8\x33\x52\x4d\x45\x42\x8f\x7e\xb5\x6a\xd0\x3d\x98\x0c\xb9\xb4\xf3\xd2\x34\x2c\xe1\xff\x2c\xef\xef\x86\x4e\x6f\xaa\x33\x41\x30\x9a\xeb\xd4\x17\xe9\xe9\x39\x29\x0d\xf8\x45\x39\xaf\xf4\xf6\x59\xa3\xe1\x80\xcd\x50\xef\x89\xff\xfb\xc6\xd7\x8d\x77\x21\x42\x21\x33\x55\x25\xb4\x24\x22\xc2\x43\x69\x44\x0b\xa7\x90\x1b\xdb\x8a\xa5\xb4\x37\xcc\x07\xef\x1a\x22\xa8\xf7\x67\xf4\xbe\x60\x46\x04\xe7\x5f\x30\x6b\x3c\x9e\x51\x8c\x36\xdf\xaa\x3d\xea\x48\x86\x4c\x64\xca\x21\x27\xc7\x2f\x98\x9d\x13\xa8\x8d\x65\xad\xcd\xf9\x2f\x97\x4c\xed\x0d\x2b\x49\x67\xf6\x58\x53\xf4\x6c\x1a\x2d\x9f\x39\x95\xf6\x3d\x3d\xb7\x50\x84\xcc\xec\xd1\x1f\x94\x8b\x06\xe8\x5c\x67\x87\xb5\xef\x2b\x67\xe0\xdc\x78\xc2\xd3\x83\x55\x95\x18\x5e\x54\x27\x0c\x33\xd2\x7d\x1b\xcb\xaa\x1a\x62\x99\x0c\x47\xe7\x7e\x74\x18\xdc\xae\xc5\xe8\xe8\x91\x4a\xd3\x1f\x01\xdb\x58\xcd\x58\x97\x22\xc3\x67\xdc\x78\x80\x2e\x51\x38\xa7\x9a\xed\x88\xea\xaf\xaa\x8e\x11\xc1\xb0\x56\x60\x29\x61\x73\xe4\x07\x0c\x4e\x83\xc7\x65\xbb\xb3\xe3\x5c\x1f\x54\x4a\xe5\x7c\x67\x02\x51\x96\x49\x59\x63\x53\x7b\x65\xb4\x28\xcb\x63\xc0\x0d\x5f\xa0\xb2\x50\xa1\x17\x52\x78\x31\x14\xcf\xa5\x7b\x4a\x2b\xad\x26\x0a\xcb\xc2\x1c\x1c\x29\x25\x2b\x84\xde\x46\x49\x24\xba\xcc\x2a\xa6\x34\x05\x2f\xb6\x01\x40\x3d\x8a\xea\xeb\x7a\x4a\x07\x3f\x25\xc8\xb0\x76\x92\x8f\x12\xd0\x11\x0b\xd7\x3d\xca\xe9\x79\xf0\xb1\x6f\x08\x76\xde\x70\x66\x1c\x8b\x99\xaa\x15\x25\x48\x22\x70\x2b\xb4\x48\x04\xba\x37\x49\x0e\x50\x0e\x1c\x8a\x12\x03\xd1\xb1\xd2\xce\xa3\x90\x41\xd2\xc4\xcf\x90\x62\x7b\x47\x9d\x93\x37\x7b\x0c\x51\x74\x5f\xa3\xee\x68\x35\x8e\x90\xcb\x15\x8c\xd7\x26\x07\x82\xb8\xb4\x9a\xf2\xe2\x09\x79\x7a\xf9\x27\x0c\x30\x99\x33\xe9\xab\xbe\xaa\x25\x96\x78\x9a\xfa\x2d\x56\x66\x4f\x4f\x46\x8f\xfc\x97\x7b\xa2\x66\x37\x44\xab\x3a\xa3\x62\xca\x72\x23\x42\x10\x3c\x22\xc5\x3c\x92\x9c\x35\x81\x85\x69\x5c\x9b\x6f\xbe\xee\x32\xe9\x94\xa7\xa7\x33\x5e\xd2\xd1\x4b\x2f\xac\x1f\x2e\xb1\xda\x08\x38\x81\x6d\xfa\xcd\xa7\x33\xa2\xa3\xfc\x73\xfc\xe6\xe7\x67\x0c\x1c\x35\x23\xf8\xf2\xa8\xb3\x16\xab\x44\x66\x8d\x73\x50\x89\xac\x50\x1a\x5d\x34\xe7\x56\x0d\x49\xe6\x8e\xfa\x0c\xb5\x9b\x7a\x6b\x85\x64\xd5\x7f\x0c\x7f\x3a\x28\x71\x2b\xb2\x63\xa2\x40\x99\x1a\xd9\xa8\xfc\x60\x1a\x64\x3c\x29\x8c\xd7\xf9\xe3\x04\xa2\x48\x59\x63\xa9\x80\x0c\xbb\x47\xb9\xb1\x95\x18\xe2\x25\xd2\x7d\xca\x0e\x97\x44\xec\xa5\xd7\x05\x66\xbb\x10\x21\x05\x8a\xd2\x17\x64\xb5\xc4\x92\xd0\x12\x7a\xb8\xc3\xd9\xd2\x17\x78\x84\x4c\x68\xaa\x67\x4d\x8d\x1a\x07\x3d\x34\x10\x88\x64\x97\xef\xe1\xa7\xc5\x87\x39\x7c\xb8\xbf\xbe\xa2\xe2\x3c\xf4\x29\xbf\x44\xcd\x6a\x09\x99\xc8\x0a\x94\x5d\xc3\x43\xa5\x60\x6c\x73\x44\x96\x19\x2b\x49\xd9\x51\xf0\xdf\x6e\xde\xc1\x8f\xc2\x21\xdc\x28\x8b\x19\xa5\x2c\x58\xd6\x98\xa9\x5c\x65\x82\x38\x85\xf5\xef\xa5\xf8\xa3\xf0\xbe\x76\x6f\x2f\x2f\x9d\x17\x5a\x0a\x2b\xdd\x2c\xb7\x88\x12\xdd\xce\x9b\x7a\x66\xec\xf6\x72\x23\x1c\x4a\x65\x2f\x5c\x8d\xd9\xc9\x8f\x8b\x52\x78\x74\x7e\x56\xf8\xaa\x5c\xff\x6e\xc5\x1f\xeb\xef\xdb\x92\x9e\x79\xa6\xf6\x23\x57\x25\x9e\xf0\xa9\xf4\xdb\xd1\xec\x71\x39\x9a\x2d\x1e\x60\x3d\xde\x34\xf0\x26\xaa\xfa\xdf\x7f\xbb\x79\xf7\xf9\xe6\x6a\x75\xf5\xf9\xfd\xfd\xed\xfc\x32\x2a\xe8\x32\x76\x40\x63\x7f\xac\x55\xc6\xda\x0d\xcb\xff\xf7\x72\x56\x9a\x4c\x94\x97\x0c\x15\xfd\xe5\x13\xee\xae\x9e\x3f\xfe\x66\xf1\xb8\xfc\xd3\xe3\x2f\x1b\x67\x2f\x7b\x04\x88\x0d\xb2\x72\xef\x6d\x7a\x1e\xe8\x3d\xce\x3b\x63\x01\x75\x8e\x2e\x35\x33\x85\x42\x2b\x6c\x56\xd0\xf9\x30\xc6\xd9\x76\x16\x4f\xa9\xad\x91\x97\xb5\x38\x56\x11\x86\x27\x53\xaa\xf9\x0f\x85\xca\x0a\xc8\xc8\x72\x5c\xd7\xbb\x52\xb8\x62\x7d\xe1\xb0\x16\x56\x50\xbd\x52\x0b\x1b\x20\x39\x1a\x9e\x30\xc5\x35\x1b\x99\xcc\x3c\x83\x07\x06\x04\x22\x4f\x7d\xc2\x06\x01\xab\xda\x1f\x29\x87\x39\xc2\x8a\x93\x88\xf9\x7e\xc6\x6d\xd3\xac\xc7\x7d\xb0\xd9\x98\xc4\x0d\xc9\x33\x16\x2c\xcc\x5e\xbb\x2d\x3e\x24\x0d\x4e\x39\xfb\xb5\x1d\x83\x3b\x5d\xc8\xcf\x2f\x4f\x15\x98\x1e\xaf\x2f\x0a\x14\x92\x5e\x4e\xd8\x49\x0e\x56\x79\x8f\x5c\x8d\xfc\x99\x57\xac\xbf\x9f\xc1\xca\x00\x01\x6c\x53\xc3\xd1\x34\x16\x7e\x89\x93\x01\xca\xb0\x53\x2e\x0a\x82\x28\x4a\x8f\x7c\xa1\x1c\xb4\x2a\x02\x57\x98\x86\xca\x10\xe4\xfd\x28\xa1\xa9\x29\x38\x79\x8e\x10\xa2\x2c\x6e\x95\x86\x7b\x2d\x8d\xa1\x21\xdd\x50\x7e\xf4\x42\x69\x94\x3d\x8d\xb9\x4e\xde\xaf\xb8\x19\xc9\xe7\x8e\xce\x63\x15\x71\x23\xaa\xcd\xd2\x99\x42\xae\x2f\x8c\x2e\x8f\x33\xb8\x3e\xa9\xb9\x2b\x23\x55\x7e\x6c\xb3\xa3\xc5\xbc\x71\x48\x9c\xb4\x2f\xfa\x47\x4e\x61\xd3\xf8\xc8\x49\x24\x0d\xb1\x77\x78\xd2\xc4\x43\xac\x09\xa7\x3d\xa3\xa4\x57\x5d\x31\x22\xb2\x8c\xca\xd9\x68\xb3\x8b\xf5\x45\x6e\x2c\xa5\x33\x62\x80\x9a\x35\x10\x90\x99\xfa\xf8\x2d\xe6\x82\x94\xb6\xc7\xc1\xc1\x7d\x81\x1a\x5c\x21\x24\x55\x57\xbe\x38\x55\xcd\xa4\x05\x92\x68\x13\x82\x92\xbe\x59\xbe\x19\x50\xae\xaf\xae\xdf\xcf\xbf\x19\x51\x98\x44\x7f\xe1\x49\x6c\x7f\x30\xd9\x2e\xd2\x1f\xf3\x84\xc2\x11\xd2\x0a\x0f\x8e\xf2\x91\x28\xe3\x39\x71\x3b\x91\xa9\xad\xc9\xd0\x51\xd5\x2d\x8d\x7e\xe9\x81\x8a\x11\x72\xf1\x18\xda\x86\x86\x28\x2f\x5d\x57\x59\x9a\xd6\xd0\xc6\x72\xe5\xd3\xc6\x54\x08\x8f\x1d\x59\xe3\xd4\xd7\x06\x04\x24\x60\xdc\xb9\x18\x23\xcc\xf9\x8a\x27\x27\x3f\x2a\x49\xa3\x14\x7f\x24\x6d\xa6\x11\x0b\xa5\xa6\x94\xc7\x7a\x45\x5e\xaf\x74\x53\xee\x1b\x55\x7d\x7f\xf7\xd3\xe2\xdd\x29\x2b\x1d\xc5\xe7\x75\x6e\x74\xae\xb6\x43\x3b\x4e\x94\xff\x89\x02\x3c\xbd\x1c\x8a\xdf\x29\xf5\xd4\xa7\x82\x50\x40\xb1\x34\xc7\x93\xcd\x99\xd0\x11\x17\x49\x78\x94\x8c\x87\xd4\x94\x2b\x1f\xa7\x45\x1f\x97\xab\xfb\x5b\x58\xae\xee\x1f\xe7\x21\x07\x5f\x05\x15\x50\x9c\x0b\xc8\x1a\xe7\x4d\xd5\x43\x93\x98\xe5\x59\xa5\xd1\xcd\xa7\xd4\xe2\x50\xca\x54\xf9\x91\x92\xf2\xd7\xe6\x7a\x30\xde\x60\x6e\x6c\x37\xe0\x6a\xa7\x70\x34\x42\x03\x87\x9e\x0b\xfc\xf0\x96\x8e\xf9\xe5\xea\xe3\x87\xd5\xfc\x86\x55\x4d\x9a\x45\xbd\x57\xd6\x68\xca\x23\xb0\x17\x56\x89\x0d\xf5\xb3\x03\x24\xbd\xd8\x21\xcd\xf5\x30\x43\x89\x3a\x43\x76\xc8\xe1\x43\x4f\x52\x82\x3b\x07\xe7\xc8\x7b\xcc\x87\x41\xef\xe4\x72\xd3\xd3\x9c\x31\xb4\xf8\x24\x73\x84\xd5\x5d\xee\x18\xda\x70\x9a\x41\xdc\x00\x4c\x0f\x6c\xe2\xd7\x6d\xa2\x88\x05\x51\xb2\x99\x8a\x18\x42\x7e\xc0\x66\x13\x9e\xf2\x45\x10\xf9\xb6\x29\xbd\xaa\xcb\x88\x30\x8e\x3c\xa8\xa2\x56\xcb\x58\x89\x14\x06\x0e\x29\x9d\x43\x2d\x7c\xf1\x76\x48\xcb\x31\xef\x07\xeb\x2b\x94\x50\xa5\x03\x69\x46\xe7\xfa\x90\xfb\xd4\x92\xb4\x95\x5a\xdb\x6e\x4b\x9f\xe3\x71\x57\x04\x6c\x52\x04\xbd\xa5\xdc\x39\x83\x9e\x99\x02\x7b\x31\x8e\x95\x8e\x55\x44\x72\x5f\x16\x22\xe4\x09\x0e\x0f\xf2\xaa\x5c\x59\xe7\xd3\x12\x07\x84\x66\x3d\x63\xb7\x87\xd3\x94\xa0\xc0\x80\x5a\x49\x37\x4f\xb2\x17\x87\xcf\xc3\xd5\x72\xf9\xeb\xfd\xe3\x0d\x3c\xdc\x7f\x58\x5c\x7f\x62\x9d\xde\xf5\x66\x77\x2e\x56\x41\x14\x99\xa7\x99\x27\xcc\x64\x9f\xa6\xaa\x30\x4e\xfc\x4a\x9e\x9a\x40\xd5\x90\x00\xc2\x2b\xc7\x39\x31\x51\x82\xda\x94\x2a\x3b\x9e\xa1\xd6\x8a\x6a\x73\xde\xb3\x41\xa0\xc9\x16\x0a\xe7\xe1\x3f\x09\x88\x69\xbc\x86\xd6\x41\x69\xf4\x16\xc6\xa7\x56\x4a\x82\x7d\xbe\x5d\xdc\x7d\xfe\x30\xbf\x7b\xb7\x7a\x4f\x9c\x39\x9a\x87\x89\x6e\x58\x0d\x95\xd2\xaa\x6a\xaa\xc9\x6c\x98\x66\x04\x1f\xca\x9d\x55\x45\x6a\x0b\xf3\xee\xc4\xf4\x14\xfa\x3d\xda\x4b\xc7\xd5\xe4\xd3\xa3\x94\x05\x74\x5e\x55\x5c\x11\xa2\xf6\xd6\xd4\x03\x12\xfd\xf5\x07\xd8\x50\x1a\xf9\x9a\x1c\xf3\xbb\xd5\xe3\xfd\xc3\xa7\xaf\x0b\x02\x94\x41\x12\x19\xe5\x7a\xb4\x37\x47\x28\xcc\x01\x50\x38\x15\xbd\xa9\x55\xbe\x72\xb0\x6d\x28\xfd\x49\x38\x50\xb6\x57\x5c\xb6\x56\xd4\x66\x99\x3c\x0a\xdf\x39\xc5\xf4\x4c\xe6\x29\x38\x1a\x71\xeb\x0c\x93\xc7\xc4\xe9\xf5\x86\xfa\x4e\x68\xbd\xe7\x6f\x7f\x7d\xf3\x9a\xbc\x60\x4a\xf3\x81\x8d\x11\x56\x82\x35\x87\xd3\x3d\xff\x3c\xa0\xe5\x34\x34\x99\xd2\x74\x8b\x06\x5d\xb2\x6f\x6e\xf2\xae\x23\x0a\xeb\x66\x6d\xc6\xb9\x92\x52\x51\xa3\x24\xca\x8e\xc9\xd4\xd0\x49\xd4\x14\xd8\x9b\x63\x8b\xce\xcf\x68\xf8\x66\x7e\xf7\xe9\xf3\x87\xc5\x72\x15\xab\x26\xc1\x05\x04\x94\x71\x9a\xe0\x0b\xac\x60\x6c\x34\x42\x8d\x16\x4a\xa5\x71\x0a\x6a\xab\x8d\xa5\x97\x9b\x52\xe8\x1d\x3f\x0c\xfc\x85\xbf\xb8\x84\xa7\xd7\xbd\x2a\xfe\x2f\x24\x58\x00\xaf\x87\x96\x55\x2e\x4d\x62\xfd\x9a\x42\x83\x54\x1c\x62\x22\x46\x2e\x95\x93\x32\x20\xd2\x53\xdb\x75\xf7\x0b\x62\x2b\x94\x86\x71\x53\x13\x38\xff\x95\x6f\x1a\xdc\x64\x06\x57\xdd\xf2\x30\xa6\xdd\x1c\x9f\xa8\xe1\x6e\xfe\x6b\xab\x8a\x68\x32\x8b\xbe\xb1\x1a\xe5\xf9\xe2\xab\xe5\xcf\xb4\x96\xd6\x45\xd6\xd1\x3d\xc7\xbc\x22\x8d\x00\x5a\x6b\x6c\x10\x7b\x65\x28\x88\x40\xc0\x01\xc5\xae\xe3\x4b\xe8\xe3\x41\xd0\x05\x0a\x63\xf1\xf1\x74\x4c\x30\x78\x7f\xf6\x95\x64\x3c\x83\x85\x87\x42\x10\x53\x54\x80\x58\x9e\xf3\x56\xd3\xd8\x99\xa7\x9a\xc2\xa1\x27\xd9\x84\x1e\x4e\xcb\x8c\x90\x3f\xcf\x3f\xc1\xcd\xfc\x71\xf1\x0b\xb7\xf9\x70\x7d\xbf\x5c\xb5\x75\x5a\x66\x1c\x0f\x5b\x25\x5a\xb5\x27\x3b\xc7\x92\xf0\x25\xcd\x96\x8f\xe1\x8e\xb3\x3f\xf7\x82\x31\x19\x4e\x37\xd5\x06\x2d\xed\x53\x74\x45\xe0\xd3\x35\x46\xec\x34\x37\x3b\x99\xbf\x59\x5f\xb8\x42\xfc\xf0\xfa\x0d\xe7\x5d\x63\x59\x4b\xd8\x5b\x25\xec\xd6\xe8\x37\x8a\x20\x78\x42\x0e\x90\x89\x52\x6d\x42\xba\x89\x33\x85\x38\xd6\xe1\x0b\x29\xb6\x11\x15\x50\xd1\x8f\x63\xe1\x1a\x4a\x0c\xb1\xa1\x7a\xea\x87\x57\xaf\x2a\x17\x92\x2f\x4b\x75\x7a\x26\x63\x42\xaa\x77\xe9\x15\x8f\x9f\xe5\x34\x81\x45\x90\x97\xd5\x10\x06\x16\x15\xfa\xc2\x48\xe6\x8c\x0b\x66\x09\xe3\xa1\x84\x00\x64\xde\x9d\xcc\x59\x0c\xa1\x5b\x42\x74\x9a\x2b\x0d\xd3\x12\x52\xa2\x64\x7b\x2a\x07\x9a\x13\x5e\x69\x0e\x48\xb8\x2b\x42\x96\x0b\xc4\x5e\x3a\x90\x98\xb3\x60\x24\xc1\x14\xb4\xb1\x50\x51\x2a\xe5\x85\xaf\x5f\xbd\x0a\xd1\x10\x87\xc2\xdd\xca\x36\x69\x1e\x0a\xe3\xf0\xa9\x24\xb4\x02\xf0\x4b\x86\x98\xe2\x94\xa6\x5a\xac\x51\xe1\x76\xe7\xe6\x80\xfc\x94\x2c\xbc\x53\x3f\x92\xdd\xc2\xfd\xe0\xa4\x1f\xcb\x6d\x31\xdb\xfa\x4f\xa8\xb0\x76\x48\x61\xa0\x74\x3b\xd5\x0f\xf6\xa0\xdc\x0d\x3d\x8f\xdf\xb7\x13\x00\x7a\x2b\x1a\x6a\xd3\x3c\x4d\x8c\x52\xc3\x7b\x1a\xb3\x3f\xdf\xfc\xf4\x79\x75\xf5\xf8\x6e\xbe\x1a\xcc\x1f\x5e\xd8\x2d\x7a\x56\xd1\x09\x1a\xbf\x76\x27\x00\xfe\xe6\x87\x57\x15\x3d\x99\x50\x0f\x1e\x8a\x5d\xe5\xbb\xa6\xf2\x15\x2d\x96\xca\x51\x59\xdb\x79\x10\x8f\xde\x13\x6e\xf5\x55\x4f\x8c\x13\xaa\x05\x7e\x97\x28\x4a\x3a\xf0\x6c\x54\x1e\x51\x6d\x87\x58\xd3\x9d\xa3\x8b\x66\x5b\x99\xce\x47\x3b\x4d\x0d\x5d\xac\x45\x89\x44\xee\x91\x7c\x62\x1f\x67\x6f\x02\x72\xe1\xf8\x51\x08\x96\xc9\x94\x91\xe9\x59\x4f\xb5\xd8\xd2\x4b\x65\xf7\xf2\x3d\xdc\xce\x6f\xef\x1f\x3f\xc1\xc3\xe3\xfd\x6a\x7e\xdd\x5e\xd4\xb7\x03\x8e\x56\x19\xe4\x13\xb2\xa9\x6a\xae\x52\x49\x0c\x2c\x73\x18\xf7\xf2\x12\x89\xe0\x4c\x4e\xaa\xb1\x54\xe9\x51\x59\xab\xfe\x85\x50\xaa\x2a\xe8\xf8\x5f\x68\xcd\x84\x02\x5a\x62\xba\x5e\x4a\xc3\x62\x72\x2b\xfd\xa4\x1a\x04\xa9\xdc\x2e\xf6\x41\xe9\xc4\x19\xdc\x6b\xf8\xa0\x74\xf3\x65\x9a\x06\x9b\x64\x04\x51\x3a\x03\x95\xb0\xd4\x9f\x31\x6a\x7a\x66\x95\x38\x9f\xc6\x31\x17\xcd\xc2\xb9\xc9\x0c\x45\x65\xd7\x2f\x9b\x9c\x95\xef\x44\xc5\x5f\x03\xd8\x78\x09\xe6\x3d\x2b\x95\x87\x9c\xca\x93\x07\x91\x03\x93\xe6\xc9\x82\x21\x1c\x02\xe0\xb0\xcc\x41\x48\x45\xf3\x99\x02\xa9\xef\x6e\x6f\x9e\xda\x7b\xc6\xc4\xaf\x6d\x74\xaa\x18\xda\xcc\x94\xde\xd1\xf5\xe2\x70\x09\x9a\x86\xf0\x93\x50\x51\x13\xcb\x05\xd5\x1f\x2d\xe1\x12\x73\xbe\xec\x55\x2e\x82\x66\x1c\x0a\x5b\xa1\x1c\xf2\x55\x37\x7b\x61\x20\xdc\xcb\xe8\x4d\x38\x61\x7d\x91\xd1\xb7\x01\xf4\x37\xca\x2e\xc9\xff\x4c\x17\x69\x01\x2e\x2c\xcd\xa3\xcb\x50\xec\x53\xcd\x9a\x30\x4c\x50\xb0\x6f\x4b\x9e\x3d\x87\x7c\x00\x63\xd7\x64\x05\x88\x81\xbb\xb8\x53\xa1\xd2\xb7\x09\xa7\x33\x87\xa0\x5c\x3a\x3c\x08\xc6\xdf\x2b\x74\x42\x0d\xfa\x8a\x3b\x88\x9a\x26\x5a\x7c\xf1\x6f\x72\x82\x41\x4a\xdc\xb2\xe7\x6b\xfb\x18\xb7\xe1\x92\x91\x4e\x39\xa8\x3a\x38\x8c\x33\x46\x47\x76\x8f\x50\xd0\xb8\x69\x83\xa8\xc9\x1d\xe4\x0c\xba\x3a\x67\x7c\x28\x90\xbd\x07\xe9\xfa\x23\xf6\x72\x74\xc3\x59\xd5\x7e\xfa\x6d\xf5\x86\xb1\x5d\x05\x33\x94\xb5\xdd\xa4\x53\xb4\xa0\x12\x8c\xea\x33\x97\x9c\xb8\xcb\xfd\xcc\xfa\x14\x1a\xed\x55\xd9\x53\x8b\x49\x96\xb1\xc8\x85\x75\xc6\xe0\xb4\x7c\x0f\xf3\xdf\x16\x2b\xb8\xbe\xbf\xa1\x29\xc3\x6a\x39\x12\x65\xb9\x31\x5f\xfe\x6b\x94\x6d\x20\xdb\x8c\x32\x28\xcf\xfe\xcf\x46\xf3\x2f\x8a\xd4\x25\xf1\xbb\x5b\x14\x5a\xe9\xed\xe8\xd5\x77\xcb\x26\xa3\x31\xd3\x6c\xf4\xf7\xbf\x7d\xb7\xd0\x7b\x51\x2a\x09\xd7\x1f\x16\xd0\x38\xb1\x45\x18\x3b\xa4\x74\xe6\xf8\x47\x9b\x48\x24\x7a\xa1\x4a\x37\x99\x8d\xfe\xfe\xc3\x77\xab\x02\x49\xf1\xf4\xd9\x85\x86\x46\xc7\x6b\x33\x92\x9c\xf4\xb8\x29\xb1\xea\x6e\x92\x7a\x19\x82\x92\xd6\xe9\x67\x19\xcf\x14\x6d\xe9\x6d\x28\x3d\x89\xe6\x3f\xbe\xbb\xe2\x0f\x58\x54\x68\xa8\xed\x5e\x65\x48\x6e\x55\x5b\x74\xa8\x3d\xf5\x44\x5a\xec\x85\x2a\x99\x89\x88\xb4\x6e\x47\x74\xa8\xe0\xea\x86\x39\xb1\x57\x62\x9f\x9d\xcc\x46\xff\xf1\x8f\x56\x03\x2d\x4f\xae\xa9\xeb\x92\xca\xf5\x71\x5c\xdc\x6e\xa6\xe4\x6f\xc8\x5b\xda\x71\x57\x02\x1e\x96\x72\xd2\xeb\x49\x58\x3b\x3c\x74\xa5\x93\x0e\x05\x55\xf1\x1b\x24\xe4\xa1\x01\x2b\x76\x5d\x1b\xdf\x6a\xd1\x05\xa2\x27\x2c\xe8\x0d\xf7\x28\x70\xd8\x8b\x49\x3d\xec\xc9\x75\x53\x96\xec\x0a\xab\x39\x83\xfe\xbb\x8f\x8b\xd6\xaf\xe1\x81\x1d\xd8\x31\xee\x5f\x95\xbe\x30\xcd\xb6\x68\x27\xdc\xde\x52\x44\xd1\x18\x58\xec\x10\x5c\x63\x91\x26\xe0\x01\x59\xe8\x82\x06\xb3\x34\xb5\xe5\xdb\xfd\x04\x5e\xb9\x55\xa8\xa5\x9b\x8e\x9c\xa9\x90\xb2\xb2\x8b\x4d\x99\xf3\xaa\x2c\x69\x30\x94\x47\xb3\xfb\x54\x4f\xbf\xfb\xb8\x58\x5f\xf0\xb5\x4f\xcf\x8c\xcc\xda\x0c\x7e\x62\x91\x95\x1b\x59\x14\x8e\xd2\x71\x62\x2f\x76\x49\x61\xb4\xd7\x90\x89\xd3\x79\x3a\x59\x11\x54\x55\x97\x48\xb5\x31\x83\x53\xac\x97\x50\xbe\x74\xa3\x76\x85\xf6\xb8\x8d\xd8\xa5\x1c\x78\xab\xb6\x5b\x0e\x70\x2e\xe8\xce\xc7\x61\xbd\x80\x4e\x81\xcb\xb2\xa1\x8f\xad\x91\x51\x7a\xa0\x57\xeb\x6d\x8b\x9f\x86\xf0\x27\x29\xbc\x9d\xdd\x3c\x40\x5e\x62\xd7\xb5\x12\x1c\x54\x59\x8e\x32\x41\x7a\x4a\x82\x47\x31\x29\x61\x34\xb1\x7e\xe0\x23\xba\x59\x90\x37\x51\x7d\xfc\x32\xa4\x36\x63\x47\x49\xb7\xb1\x5c\x0e\x13\x19\x1a\xf6\x54\x48\xb5\x44\xff\x7b\x0d\xda\xd7\x63\x91\x83\x86\xec\x01\x1e\xbf\xf8\x11\x7d\x66\xa7\xe3\x4a\x1a\x42\x15\xf4\x35\x5c\xdc\x45\xd4\xc2\xf9\xc3\x46\xa0\x45\x9a\x27\x3f\xe4\x5c\x05\xb6\x5c\x75\xb5\x58\xf8\x7c\x29\xf9\x53\x00\x57\xaa\xf9\x5c\x80\x20\x46\x26\x18\xbf\x9a\xcc\x60\x41\x97\xda\xb9\x50\x25\x39\x67\x78\xac\x8d\x5e\x5f\xbc\x9a\x8c\x94\x6b\x61\x79\xfa\xa4\xed\xd4\x35\xcd\x61\xb9\x81\xb0\x6d\xcd\x99\xb4\xcb\x89\xbc\x13\x2f\xf9\x07\xf5\x5c\xa2\x2a\xd1\xb9\xf4\xcd\x47\x5b\xa8\x45\x39\x47\xa7\x72\xa6\x44\x1f\x45\xa2\xeb\xb5\xb8\xb0\x4b\xb1\xf7\x9a\x2e\xb1\xef\x97\x53\x12\x8e\xb7\xc3\x55\x5d\x97\xb8\xe4\xef\x3e\x9e\x53\x60\x74\x7c\x4a\x51\x6f\x99\x75\x1e\x3c\xe9\x7c\xf4\x97\x7f\xe3\x3b\xc3\x8d\xd2\x97\xa8\xf7\x60\x9c\x08\x1f\x90\x8c\x46\x46\x83\x6d\xf8\x83\xc5\xfd\x08\x00\x40\xe5\x50\xa2\xde\x86\x0b\x66\x7a\x0a\xff\x0d\xaf\x48\x4b\x9a\x5f\xd3\x3f\x6a\x31\x13\xa0\x73\x21\x84\x15\xbc\x4e\xcb\x79\x15\x96\x0e\x9f\x5b\xfe\x22\x41\xcc\xdb\x17\xbc\x04\xb5\x04\x95\x8f\x46\x69\x69\x6e\x8d\xf6\x95\x71\xfe\xb3\x20\xdc\x8c\xb7\xc5\xde\xf0\x1c\x8a\xa8\x8c\x95\xce\x0d\x79\x2d\x8c\x69\x06\x4a\x67\xb6\x7b\xa0\xb7\x67\x32\xe1\x33\x3d\x96\x65\xff\xf1\x30\x81\x96\x5b\x19\x3e\x49\x04\xa9\x04\x7d\x7d\x98\x18\x0f\xf9\x47\xf9\x12\xe1\x45\xf4\x87\x17\xc1\xd8\x2a\xa3\x8e\x4a\x34\x74\x4a\x78\x52\x28\x29\xa9\xe5\xd3\x8e\x5a\xba\xd4\x1b\xc4\x9f\x2f\x5e\x8c\x5a\x5a\x14\x31\xad\x2b\x92\x68\x16\x5d\x53\xfa\x56\x2d\xc4\xfa\x88\xf4\x63\x1b\x3d\x9a\xe5\x6a\x34\x7b\x9c\x8f\xfe\x6f\x00\x3d\x3a\xe0\xa0\x9b\x2c\x00\x00")
 
 func vaulted1Bytes() ([]byte, error) {
 	return bindataRead(
@@ -392,19 +665,32 @@ func AssetNames() []string {
 
 // _bindata is a table, holding each asset generator, mapped to its name.
 var _bindata = map[string]func() (*asset, error){
-	"vaulted-add.1":     vaultedAdd1,
-	"vaulted-cp.1":      vaultedCp1,
-	"vaulted-dump.1":    vaultedDump1,
-	"vaulted-edit.1":    vaultedEdit1,
-	"vaulted-env.1":     vaultedEnv1,
-	"vaulted-exec.1":    vaultedExec1,
-	"vaulted-load.1":    vaultedLoad1,
-	"vaulted-ls.1":      vaultedLs1,
-	"vaulted-passwd.1":  vaultedPasswd1,
-	"vaulted-rm.1":      vaultedRm1,
-	"vaulted-shell.1":   vaultedShell1,
-	"vaulted-upgrade.1": vaultedUpgrade1,
-	"vaulted.1":         vaulted1,
+	"vaulted-add.1":        vaultedAdd1,
+	"vaulted-agent.1":      vaultedAgent1,
+	"vaulted-audit.1":      vaultedAudit1,
+	"vaulted-cp.1":         vaultedCp1,
+	"vaulted-diff.1":       vaultedDiff1,
+	"vaulted-dump.1":       vaultedDump1,
+	"vaulted-edit.1":       vaultedEdit1,
+	"vaulted-env.1":        vaultedEnv1,
+	"vaulted-exec.1":       vaultedExec1,
+	"vaulted-export.1":     vaultedExport1,
+	"vaulted-history.1":    vaultedHistory1,
+	"vaulted-import.1":     vaultedImport1,
+	"vaulted-load.1":       vaultedLoad1,
+	"vaulted-lock.1":       vaultedLock1,
+	"vaulted-ls.1":         vaultedLs1,
+	"vaulted-metadata.1":   vaultedMetadata1,
+	"vaulted-passwd.1":     vaultedPasswd1,
+	"vaulted-recipients.1": vaultedRecipients1,
+	"vaulted-recovery.1":   vaultedRecovery1,
+	"vaulted-rm.1":         vaultedRm1,
+	"vaulted-rollback.1":   vaultedRollback1,
+	"vaulted-shell.1":      vaultedShell1,
+	"vaulted-sync.1":       vaultedSync1,
+	"vaulted-upgrade.1":    vaultedUpgrade1,
+	"vaulted-verify.1":     vaultedVerify1,
+	"vaulted.1":            vaulted1,
 }
 
 // AssetDir returns the file names below a certain
@@ -448,19 +734,32 @@ type bintree struct {
 }
 
 var _bintree = &bintree{nil, map[string]*bintree{
-	"vaulted-add.1":     &bintree{vaultedAdd1, map[string]*bintree{}},
-	"vaulted-cp.1":      &bintree{vaultedCp1, map[string]*bintree{}},
-	"vaulted-dump.1":    &bintree{vaultedDump1, map[string]*bintree{}},
-	"vaulted-edit.1":    &bintree{vaultedEdit1, map[string]*bintree{}},
-	"vaulted-env.1":     &bintree{vaultedEnv1, map[string]*bintree{}},
-	"vaulted-exec.1":    &bintree{vaultedExec1, map[string]*bintree{}},
-	"vaulted-load.1":    &bintree{vaultedLoad1, map[string]*bintree{}},
-	"vaulted-ls.1":      &bintree{vaultedLs1, map[string]*bintree{}},
-	"vaulted-passwd.1":  &bintree{vaultedPasswd1, map[string]*bintree{}},
-	"vaulted-rm.1":      &bintree{vaultedRm1, map[string]*bintree{}},
-	"vaulted-shell.1":   &bintree{vaultedShell1, map[string]*bintree{}},
-	"vaulted-upgrade.1": &bintree{vaultedUpgrade1, map[string]*bintree{}},
-	"vaulted.1":         &bintree{vaulted1, map[string]*bintree{}},
+	"vaulted-add.1":        &bintree{vaultedAdd1, map[string]*bintree{}},
+	"vaulted-agent.1":      &bintree{vaultedAgent1, map[string]*bintree{}},
+	"vaulted-audit.1":      &bintree{vaultedAudit1, map[string]*bintree{}},
+	"vaulted-cp.1":         &bintree{vaultedCp1, map[string]*bintree{}},
+	"vaulted-diff.1":       &bintree{vaultedDiff1, map[string]*bintree{}},
+	"vaulted-dump.1":       &bintree{vaultedDump1, map[string]*bintree{}},
+	"vaulted-edit.1":       &bintree{vaultedEdit1, map[string]*bintree{}},
+	"vaulted-env.1":        &bintree{vaultedEnv1, map[string]*bintree{}},
+	"vaulted-exec.1":       &bintree{vaultedExec1, map[string]*bintree{}},
+	"vaulted-export.1":     &bintree{vaultedExport1, map[string]*bintree{}},
+	"vaulted-history.1":    &bintree{vaultedHistory1, map[string]*bintree{}},
+	"vaulted-import.1":     &bintree{vaultedImport1, map[string]*bintree{}},
+	"vaulted-load.1":       &bintree{vaultedLoad1, map[string]*bintree{}},
+	"vaulted-lock.1":       &bintree{vaultedLock1, map[string]*bintree{}},
+	"vaulted-ls.1":         &bintree{vaultedLs1, map[string]*bintree{}},
+	"vaulted-metadata.1":   &bintree{vaultedMetadata1, map[string]*bintree{}},
+	"vaulted-passwd.1":     &bintree{vaultedPasswd1, map[string]*bintree{}},
+	"vaulted-recipients.1": &bintree{vaultedRecipients1, map[string]*bintree{}},
+	"vaulted-recovery.1":   &bintree{vaultedRecovery1, map[string]*bintree{}},
+	"vaulted-rm.1":         &bintree{vaultedRm1, map[string]*bintree{}},
+	"vaulted-rollback.1":   &bintree{vaultedRollback1, map[string]*bintree{}},
+	"vaulted-shell.1":      &bintree{vaultedShell1, map[string]*bintree{}},
+	"vaulted-sync.1":       &bintree{vaultedSync1, map[string]*bintree{}},
+	"vaulted-upgrade.1":    &bintree{vaultedUpgrade1, map[string]*bintree{}},
+	"vaulted-verify.1":     &bintree{vaultedVerify1, map[string]*bintree{}},
+	"vaulted.1":            &bintree{vaulted1, map[string]*bintree{}},
 }}
 
 // RestoreAsset restores an asset under the given directory
@@ -0,0 +1,110 @@
+package menu
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/fatih/color"
+	"github.com/miquella/vaulted/lib"
+)
+
+// ConflictMenu is shown when a vault was modified (e.g. from another
+// terminal) while it was being edited. Handler returns nil if the user chooses
+// to overwrite the saved vault with the edited one.
+type ConflictMenu struct {
+	Menu
+	VaultName string
+
+	// LoadSaved opens the currently saved version of the vault
+	LoadSaved func() (*vaulted.Vault, error)
+}
+
+func (m *ConflictMenu) Handler() error {
+	fmt.Println("")
+	warningColor.Printf("Vault '%s' was modified since it was opened.\n", m.VaultName)
+
+	for {
+		input, err := interaction.ReadPrompt("Show differences, overwrite, or abort? (s/o/a): ")
+		if err != nil {
+			return err
+		}
+
+		switch strings.ToLower(input) {
+		case "s", "show":
+			saved, err := m.LoadSaved()
+			if err != nil {
+				color.Red("%s", err)
+				continue
+			}
+			m.printDifferences(saved)
+
+		case "o", "overwrite":
+			return nil
+
+		case "a", "abort":
+			return ErrUserAbort
+
+		default:
+			fmt.Println("")
+			color.Red("Response not recognized. Please enter 's', 'o', or 'a'.")
+			fmt.Println("")
+		}
+	}
+}
+
+func (m *ConflictMenu) printDifferences(saved *vaulted.Vault) {
+	var differences []string
+	differences = append(differences, mapDifferences("Variable", saved.Vars, m.Vault.Vars)...)
+	differences = append(differences, mapDifferences("SSH key", saved.SSHKeys, m.Vault.SSHKeys)...)
+	if !reflect.DeepEqual(saved.AWSKey, m.Vault.AWSKey) {
+		differences = append(differences, "AWS key differs")
+	}
+	if !reflect.DeepEqual(saved.Includes, m.Vault.Includes) || saved.IncludeAWSKey != m.Vault.IncludeAWSKey {
+		differences = append(differences, "Includes differ")
+	}
+	if saved.Duration != m.Vault.Duration {
+		differences = append(differences, fmt.Sprintf("Duration: %s saved, %s edited", saved.Duration, m.Vault.Duration))
+	}
+
+	fmt.Println("")
+	cyan.Println("Differences between the saved vault and your edits:")
+	if len(differences) == 0 {
+		faintColor.Println("  (none)")
+	}
+	for _, difference := range differences {
+		fmt.Printf("  %s\n", difference)
+	}
+	fmt.Println("")
+}
+
+// mapDifferences describes which keys differ between two maps without
+// revealing any of their values.
+func mapDifferences(kind string, saved, edited map[string]string) []string {
+	var keys []string
+	for key := range saved {
+		keys = append(keys, key)
+	}
+	for key := range edited {
+		if _, exists := saved[key]; !exists {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	var differences []string
+	for _, key := range keys {
+		savedValue, inSaved := saved[key]
+		editedValue, inEdited := edited[key]
+		switch {
+		case !inEdited:
+			differences = append(differences, fmt.Sprintf("%s '%s' only exists in the saved vault", kind, key))
+		case !inSaved:
+			differences = append(differences, fmt.Sprintf("%s '%s' only exists in your edits", kind, key))
+		case savedValue != editedValue:
+			differences = append(differences, fmt.Sprintf("%s '%s' differs", kind, key))
+		}
+	}
+	return differences
+}
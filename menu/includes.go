@@ -0,0 +1,105 @@
+package menu
+
+import (
+	"fmt"
+
+	"github.com/fatih/color"
+)
+
+type IncludesMenu struct {
+	*Menu
+}
+
+func (m *IncludesMenu) Handler() error {
+	for {
+		var input string
+		var err error
+		m.Printer()
+		if len(m.Vault.Includes) == 0 {
+			input, err = interaction.ReadMenu("Edit includes: [a,b]: ")
+		} else {
+			input, err = interaction.ReadMenu("Edit includes: [a,D,k,b]: ")
+		}
+		if err != nil {
+			return err
+		}
+		switch input {
+		case "a", "add":
+			name, err := interaction.ReadValue("Vault name: ")
+			if err != nil {
+				return err
+			}
+			if m.includes(name) {
+				color.Red("Vault '%s' is already included", name)
+				break
+			}
+			m.Vault.Includes = append(m.Vault.Includes, name)
+		case "D", "delete", "remove":
+			name, err := interaction.ReadValue("Vault name: ")
+			if err != nil {
+				return err
+			}
+			if !m.includes(name) {
+				color.Red("Vault '%s' is not included", name)
+				break
+			}
+			var includes []string
+			for _, include := range m.Vault.Includes {
+				if include != name {
+					includes = append(includes, include)
+				}
+			}
+			m.Vault.Includes = includes
+		case "k", "key", "aws":
+			m.Vault.IncludeAWSKey = !m.Vault.IncludeAWSKey
+		case "b", "back":
+			return nil
+		case "q", "quit", "exit":
+			confirm, err := interaction.ReadValue("Are you sure you wish to save and exit the vault? (y/n): ")
+			if err == nil {
+				if confirm == "y" {
+					return ErrSaveAndExit
+				}
+			}
+		case "?", "help":
+			m.Help()
+		default:
+			color.Red("Command not recognized")
+		}
+	}
+}
+
+func (m *IncludesMenu) includes(name string) bool {
+	for _, include := range m.Vault.Includes {
+		if include == name {
+			return true
+		}
+	}
+	return false
+}
+
+func (m *IncludesMenu) Help() {
+	color.Set(color.FgYellow)
+	defer color.Unset()
+	fmt.Println("")
+	fmt.Println("a,add    - Add")
+	fmt.Println("D,delete - Delete")
+	fmt.Println("k,key    - Include/Exclude AWS Key")
+	fmt.Println("?,help   - Help")
+	fmt.Println("b,back   - Back")
+	fmt.Println("q,quit   - Quit")
+}
+
+func (m *IncludesMenu) Printer() {
+	color.Cyan("\nIncludes:")
+	if len(m.Vault.Includes) > 0 {
+		for _, include := range m.Vault.Includes {
+			green.Printf("  %s\n", include)
+		}
+		if m.Vault.IncludeAWSKey {
+			fmt.Println("  (AWS key included)")
+		}
+	} else {
+		fmt.Println("  [Empty]")
+	}
+}
@@ -19,6 +19,7 @@ func (m *MainMenu) Handler() error {
 	awsMenu := &AWSMenu{Menu: &m.Menu}
 	variableMenu := &VariableMenu{Menu: &m.Menu}
 	sshKeysMenu := &SSHKeyMenu{Menu: &m.Menu}
+	includesMenu := &IncludesMenu{Menu: &m.Menu}
 
 	for {
 		cyan.Printf("\nVault: ")
@@ -26,10 +27,11 @@ func (m *MainMenu) Handler() error {
 		variableMenu.Printer()
 		awsMenu.Printer()
 		sshKeysMenu.Printer()
+		includesMenu.Printer()
 		durationMenu.Printer()
 
 		var input string
-		input, err = interaction.ReadMenu("Edit vault: [a,s,v,d,S]: ")
+		input, err = interaction.ReadMenu("Edit vault: [a,s,v,i,d,S]: ")
 		if err != nil {
 			break
 		}
@@ -40,6 +42,8 @@ func (m *MainMenu) Handler() error {
 			err = sshKeysMenu.Handler()
 		case "v", "vars", "variables":
 			err = variableMenu.Handler()
+		case "i", "includes":
+			err = includesMenu.Handler()
 		case "d", "duration":
 			err = durationMenu.Handler()
 		case "S", "show", "hide":
@@ -70,6 +74,7 @@ func (m *MainMenu) Help() {
 	fmt.Println("a,aws      - AWS Key")
 	fmt.Println("s,ssh      - SSH Keys")
 	fmt.Println("v,vars     - Variables")
+	fmt.Println("i,includes - Included Vaults")
 	fmt.Println("d,duration - Session Duration")
 	fmt.Println("S,show     - Show/Hide Secrets")
 	fmt.Println("?,help     - Help")
@@ -0,0 +1,49 @@
+package menu
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/fatih/color"
+	"github.com/miquella/vaulted/lib"
+)
+
+// PendingMetadataMenu is shown when the description, tags or team of a vault
+// were changed without opening it (see vaulted.VaultMetadata.Unverified).
+// Anyone able to write the vault file could have made the changes, so they are
+// only kept (Keep is set) when the user confirms them.
+type PendingMetadataMenu struct {
+	VaultName string
+	Metadata  *vaulted.VaultMetadata
+
+	Keep bool
+}
+
+func (m *PendingMetadataMenu) Handler() error {
+	fmt.Println("")
+	warningColor.Printf("The metadata of vault '%s' was changed without opening it:\n", m.VaultName)
+	fmt.Printf("  Description: %s\n", m.Metadata.Description)
+	fmt.Printf("  Team:        %s\n", m.Metadata.Team)
+	fmt.Printf("  Tags:        %s\n", strings.Join(m.Metadata.Tags, ", "))
+
+	for {
+		input, err := interaction.ReadPrompt("Would you like to keep these changes? (y/N): ")
+		if err != nil {
+			return err
+		}
+
+		switch strings.ToLower(input) {
+		case "y", "yes":
+			m.Keep = true
+			return nil
+
+		case "", "n", "no":
+			return nil
+
+		default:
+			fmt.Println("")
+			color.Red("Response not recognized. Please enter 'y' or 'n'.")
+			fmt.Println("")
+		}
+	}
+}
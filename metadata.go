@@ -0,0 +1,72 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/miquella/vaulted/lib"
+)
+
+type Metadata struct {
+	VaultName string
+
+	Description *string
+	Team        *string
+	AddTags     []string
+	RemoveTags  []string
+}
+
+func (m *Metadata) Run(store vaulted.Store) error {
+	metadata, err := store.VaultMetadata(m.VaultName)
+	if err != nil {
+		return err
+	}
+
+	if m.Description == nil && m.Team == nil && len(m.AddTags) == 0 && len(m.RemoveTags) == 0 {
+		printMetadata(metadata)
+		return nil
+	}
+
+	if m.Description != nil {
+		metadata.Description = *m.Description
+	}
+	if m.Team != nil {
+		metadata.Team = *m.Team
+	}
+
+	var tags []string
+	for _, tag := range metadata.Tags {
+		if !containsString(m.RemoveTags, tag) {
+			tags = append(tags, tag)
+		}
+	}
+	for _, tag := range m.AddTags {
+		if !containsString(tags, tag) {
+			tags = append(tags, tag)
+		}
+	}
+	metadata.Tags = tags
+
+	return store.SetVaultMetadata(m.VaultName, metadata)
+}
+
+func printMetadata(metadata *vaulted.VaultMetadata) {
+	fmt.Printf("Description: %s\n", listValue(metadata.Description))
+	fmt.Printf("Team:        %s\n", listValue(metadata.Team))
+	fmt.Printf("Tags:        %s\n", listValue(strings.Join(metadata.Tags, ", ")))
+	fmt.Printf("Created:     %s\n", listTime(metadata.Created))
+	fmt.Printf("Modified:    %s\n", listTime(metadata.Modified))
+	fmt.Printf("Last used:   %s\n", listTime(metadata.LastUsed))
+	if metadata.Unverified {
+		fmt.Println("The description, tags and team were changed without opening the vault, they are unverified until they are kept when editing the vault.")
+	}
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
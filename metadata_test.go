@@ -0,0 +1,74 @@
+package main
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/miquella/vaulted/lib"
+)
+
+func TestMetadata(t *testing.T) {
+	store := NewTestStore()
+	store.Vaults["one"] = &vaulted.Vault{}
+	store.Metadata["one"] = &vaulted.VaultMetadata{
+		Description: "Old",
+		Tags:        []string{"dev", "aws"},
+		Team:        "ops",
+	}
+
+	description := "Production"
+	m := Metadata{
+		VaultName:   "one",
+		Description: &description,
+		AddTags:     []string{"prod", "aws"},
+		RemoveTags:  []string{"dev"},
+	}
+	err := m.Run(store)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := &vaulted.VaultMetadata{
+		Description: "Production",
+		Tags:        []string{"aws", "prod"},
+		Team:        "ops",
+	}
+	if !reflect.DeepEqual(expected, store.Metadata["one"]) {
+		t.Fatalf("Expected %#v, got %#v", expected, store.Metadata["one"])
+	}
+
+	if _, exists := store.Passwords["one"]; exists {
+		t.Fatal("Expected the vault not to be opened")
+	}
+}
+
+func TestMetadataShow(t *testing.T) {
+	store := NewTestStore()
+	store.Vaults["one"] = &vaulted.Vault{}
+	store.Metadata["one"] = &vaulted.VaultMetadata{
+		Description: "Production",
+		Tags:        []string{"aws", "prod"},
+		Unverified:  true,
+	}
+
+	output := CaptureStdout(func() {
+		m := Metadata{VaultName: "one"}
+		err := m.Run(store)
+		if err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	expected := []byte("" +
+		"Description: Production\n" +
+		"Team:        -\n" +
+		"Tags:        aws, prod\n" +
+		"Created:     -\n" +
+		"Modified:    -\n" +
+		"Last used:   -\n" +
+		"The description, tags and team were changed without opening the vault, they are unverified until they are kept when editing the vault.\n")
+	if bytes.Compare(output, expected) != 0 {
+		t.Fatalf("Expected:\n%s\nGot:\n%s", expected, output)
+	}
+}
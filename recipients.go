@@ -0,0 +1,160 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+
+	"github.com/miquella/vaulted/lib"
+)
+
+type AddRecipient struct {
+	VaultName string
+	Recipient vaulted.Recipient
+}
+
+func (a *AddRecipient) Run(store vaulted.Store) error {
+	vault, _, err := store.OpenVault(a.VaultName)
+	if err != nil {
+		return err
+	}
+
+	recipients, err := store.VaultRecipients(a.VaultName)
+	if err != nil {
+		return err
+	}
+
+	if len(recipients) == 0 {
+		// the vault is switching from a password, so keep access to it by
+		// sealing it for our own identity as well
+		identity, err := stewardIdentity(store, a.VaultName)
+		if err != nil {
+			return err
+		}
+
+		recipients = append(recipients, identity.Recipient(""))
+	}
+
+	for _, recipient := range recipients {
+		if bytes.Equal(recipient.PublicKey, a.Recipient.PublicKey) {
+			return fmt.Errorf("%s is already a recipient of vault '%s'", vaulted.FormatPublicKey(a.Recipient.PublicKey), a.VaultName)
+		}
+	}
+	recipients = append(recipients, a.Recipient)
+
+	return store.SealVaultWithOptions(vault, a.VaultName, "", vaulted.SealOptions{
+		Recipients: recipients,
+		Operation:  "recipients",
+	})
+}
+
+type RemoveRecipient struct {
+	VaultName string
+
+	// Recipient is either the public key or the name of the recipient
+	Recipient string
+}
+
+func (r *RemoveRecipient) Run(store vaulted.Store) error {
+	vault, _, err := store.OpenVault(r.VaultName)
+	if err != nil {
+		return err
+	}
+
+	recipients, err := store.VaultRecipients(r.VaultName)
+	if err != nil {
+		return err
+	}
+
+	remaining := []vaulted.Recipient{}
+	for _, recipient := range recipients {
+		if recipient.Name != r.Recipient && vaulted.FormatPublicKey(recipient.PublicKey) != r.Recipient {
+			remaining = append(remaining, recipient)
+		}
+	}
+
+	if len(remaining) == len(recipients) {
+		return fmt.Errorf("'%s' is not a recipient of vault '%s'", r.Recipient, r.VaultName)
+	}
+
+	if len(remaining) == 0 {
+		return vaulted.ErrNoRecipients
+	}
+
+	// sealing generates a new data key, so removed recipients are unable to
+	// open the vault from now on
+	return store.SealVaultWithOptions(vault, r.VaultName, "", vaulted.SealOptions{
+		Recipients: remaining,
+		Operation:  "recipients",
+	})
+}
+
+type ListRecipients struct {
+	VaultName string
+}
+
+func (l *ListRecipients) Run(store vaulted.Store) error {
+	recipients, err := store.VaultRecipients(l.VaultName)
+	if err != nil {
+		return err
+	}
+
+	if len(recipients) == 0 {
+		fmt.Printf("Vault '%s' is sealed with a password.\n", l.VaultName)
+		return nil
+	}
+
+	var publicKey []byte
+	if identity, err := stewardIdentity(store, l.VaultName); err == nil {
+		publicKey = identity.PublicKey
+	}
+
+	for _, recipient := range recipients {
+		line := vaulted.FormatPublicKey(recipient.PublicKey)
+		if recipient.Name != "" {
+			line = fmt.Sprintf("%s %s", line, recipient.Name)
+		}
+		if bytes.Equal(recipient.PublicKey, publicKey) {
+			line = fmt.Sprintf("%s (you)", line)
+		}
+		fmt.Println(line)
+	}
+
+	return nil
+}
+
+type ShowIdentity struct {
+	IdentityFile string
+}
+
+func (s *ShowIdentity) Run(store vaulted.Store) error {
+	identity, err := vaulted.ReadIdentityFile(s.IdentityFile)
+	if os.IsNotExist(err) {
+		identity, err = vaulted.GenerateIdentity()
+		if err != nil {
+			return err
+		}
+
+		err = vaulted.WriteIdentityFile(s.IdentityFile, identity)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(os.Stderr, "Created identity in %s\n", s.IdentityFile)
+	}
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(vaulted.FormatPublicKey(identity.PublicKey))
+
+	return nil
+}
+
+func stewardIdentity(store vaulted.Store, name string) (*vaulted.Identity, error) {
+	steward, ok := store.Steward().(vaulted.StewardIdentity)
+	if !ok {
+		return nil, vaulted.ErrNoIdentity
+	}
+
+	return steward.GetIdentity(name)
+}
@@ -0,0 +1,194 @@
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/miquella/vaulted/lib"
+)
+
+func TestAddRecipientToPasswordVault(t *testing.T) {
+	store := NewTestStore()
+	store.Vaults["one"] = &vaulted.Vault{}
+	store.Identity = testIdentity(t)
+	teammate := testIdentity(t).Recipient("teammate")
+
+	a := AddRecipient{
+		VaultName: "one",
+		Recipient: teammate,
+	}
+	err := a.Run(store)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []vaulted.Recipient{store.Identity.Recipient(""), teammate}
+	if !reflect.DeepEqual(expected, store.Recipients["one"]) {
+		t.Fatalf("Expected %#v, got %#v", expected, store.Recipients["one"])
+	}
+
+	err = a.Run(store)
+	if err == nil {
+		t.Fatal("Adding an existing recipient should fail")
+	}
+}
+
+func TestAddRecipientWithoutIdentity(t *testing.T) {
+	store := NewTestStore()
+	store.Vaults["one"] = &vaulted.Vault{}
+
+	a := AddRecipient{
+		VaultName: "one",
+		Recipient: testIdentity(t).Recipient("teammate"),
+	}
+	err := a.Run(store)
+	if err != vaulted.ErrNoIdentity {
+		t.Fatalf("Expected %v, got %v", vaulted.ErrNoIdentity, err)
+	}
+}
+
+func TestRemoveRecipient(t *testing.T) {
+	store := NewTestStore()
+	store.Vaults["one"] = &vaulted.Vault{}
+	me := testIdentity(t).Recipient("me")
+	teammate := testIdentity(t).Recipient("teammate")
+	store.Recipients["one"] = []vaulted.Recipient{me, teammate}
+
+	r := RemoveRecipient{
+		VaultName: "one",
+		Recipient: vaulted.FormatPublicKey(teammate.PublicKey),
+	}
+	err := r.Run(store)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual([]vaulted.Recipient{me}, store.Recipients["one"]) {
+		t.Fatalf("Expected only 'me' to remain, got %#v", store.Recipients["one"])
+	}
+
+	r.Recipient = "me"
+	err = r.Run(store)
+	if err != vaulted.ErrNoRecipients {
+		t.Fatalf("Expected %v, got %v", vaulted.ErrNoRecipients, err)
+	}
+
+	r.Recipient = "nobody"
+	err = r.Run(store)
+	if err == nil {
+		t.Fatal("Removing an unknown recipient should fail")
+	}
+}
+
+func TestListRecipients(t *testing.T) {
+	store := NewTestStore()
+	store.Vaults["one"] = &vaulted.Vault{}
+	store.Vaults["two"] = &vaulted.Vault{}
+	store.Identity = testIdentity(t)
+	teammate := testIdentity(t).Recipient("teammate")
+	store.Recipients["one"] = []vaulted.Recipient{store.Identity.Recipient(""), teammate}
+
+	output := CaptureStdout(func() {
+		l := ListRecipients{
+			VaultName: "one",
+		}
+		err := l.Run(store)
+		if err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	expected := []byte(
+		vaulted.FormatPublicKey(store.Identity.PublicKey) + " (you)\n" +
+			vaulted.FormatPublicKey(teammate.PublicKey) + " teammate\n",
+	)
+	if bytes.Compare(output, expected) != 0 {
+		t.Fatalf("Expected:\n%s\nGot:\n%s", expected, output)
+	}
+
+	output = CaptureStdout(func() {
+		l := ListRecipients{
+			VaultName: "two",
+		}
+		err := l.Run(store)
+		if err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	expected = []byte("Vault 'two' is sealed with a password.\n")
+	if bytes.Compare(output, expected) != 0 {
+		t.Fatalf("Expected:\n%s\nGot:\n%s", expected, output)
+	}
+}
+
+func TestShowIdentity(t *testing.T) {
+	dir, err := ioutil.TempDir("", "vaulted")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	s := ShowIdentity{
+		IdentityFile: filepath.Join(dir, "identity"),
+	}
+
+	var outputs [][]byte
+	for i := 0; i < 2; i++ {
+		outputs = append(outputs, CaptureStdout(func() {
+			err := s.Run(NewTestStore())
+			if err != nil {
+				t.Fatal(err)
+			}
+		}))
+	}
+
+	identity, err := vaulted.ReadIdentityFile(s.IdentityFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []byte(vaulted.FormatPublicKey(identity.PublicKey) + "\n")
+	for _, output := range outputs {
+		if bytes.Compare(output, expected) != 0 {
+			t.Fatalf("Expected:\n%s\nGot:\n%s", expected, output)
+		}
+	}
+}
+
+func TestPasswdRecipientVault(t *testing.T) {
+	store := NewTestStore()
+	store.Vaults["one"] = &vaulted.Vault{}
+	store.Recipients["one"] = []vaulted.Recipient{testIdentity(t).Recipient("")}
+
+	c := Copy{
+		OldVaultName: "one",
+		NewVaultName: "one",
+	}
+	err := c.Run(store)
+	if err != ErrSealedForRecipients {
+		t.Fatalf("Expected %v, got %v", ErrSealedForRecipients, err)
+	}
+
+	c.KeyMethod = "argon2id"
+	err = c.Run(store)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(store.Recipients["one"]) != 0 {
+		t.Fatal("Vault should be sealed with a password")
+	}
+}
+
+func testIdentity(t *testing.T) *vaulted.Identity {
+	identity, err := vaulted.GenerateIdentity()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return identity
+}
@@ -0,0 +1,168 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/miquella/vaulted/lib"
+)
+
+const (
+	RecoveryFormatText   = "text"
+	RecoveryFormatBase32 = "base32"
+)
+
+var (
+	ErrNoRecoveryShares = errors.New("No recovery shares were provided")
+)
+
+type SplitRecovery struct {
+	VaultName string
+	Shares    int
+	Threshold int
+	Format    string
+}
+
+func (s *SplitRecovery) Run(store vaulted.Store) error {
+	recovery, shares, err := vaulted.NewRecoveryKey(s.Shares, s.Threshold)
+	if err != nil {
+		return err
+	}
+
+	vault, password, err := store.OpenVault(s.VaultName)
+	if err != nil {
+		return err
+	}
+
+	err = store.SealVaultWithOptions(vault, s.VaultName, password, vaulted.SealOptions{
+		Recovery:  recovery,
+		Operation: "recovery",
+	})
+	if err != nil {
+		return err
+	}
+
+	if s.Format == RecoveryFormatBase32 {
+		for _, share := range shares {
+			fmt.Println(share.String())
+		}
+		return nil
+	}
+
+	fmt.Printf("Recovery shares for vault '%s' (any %d of the %d shares open the vault):\n", s.VaultName, s.Threshold, s.Shares)
+	for i, share := range shares {
+		fmt.Printf("\nShare %d:\n%s\n", i+1, groupText(share.String(), 5))
+	}
+
+	return nil
+}
+
+type OpenRecovery struct {
+	VaultName string
+}
+
+func (o *OpenRecovery) Run(store vaulted.Store) error {
+	if !store.VaultExists(o.VaultName) {
+		return os.ErrNotExist
+	}
+
+	shares, err := readRecoveryShares(o.VaultName)
+	if err != nil {
+		return err
+	}
+
+	vault, err := store.OpenVaultWithRecovery(o.VaultName, shares)
+	if err != nil {
+		return err
+	}
+
+	// the shares have been revealed, so a new password is required
+	password, err := store.Steward().GetPassword(vaulted.SealOperation, o.VaultName)
+	if err != nil {
+		return err
+	}
+
+	keySlots, err := store.KeySlots(o.VaultName)
+	if err != nil {
+		return err
+	}
+	recipients, err := store.VaultRecipients(o.VaultName)
+	if err != nil {
+		return err
+	}
+
+	// the recovery key is removed too, since its shares have been revealed
+	options := vaulted.SealOptions{
+		RemoveRecovery: true,
+		Operation:      "recovery",
+	}
+	if len(keySlots) > 0 || len(recipients) > 0 {
+		// vaults using key slots or recipients are reset to a single password
+		options.KeyMethod = vaulted.DefaultKeyMethod
+	}
+	err = store.SealVaultWithOptions(vault, o.VaultName, password, options)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Vault '%s' was recovered and sealed with a new password\n", o.VaultName)
+	fmt.Printf("Its recovery key was removed, use `vaulted recovery split %s` to create a new one\n", o.VaultName)
+
+	return nil
+}
+
+// readRecoveryShares reads recovery shares from stdin (one per line) until
+// enough shares to open the vault have been read.
+func readRecoveryShares(name string) ([]*vaulted.RecoveryShare, error) {
+	fmt.Fprintf(os.Stderr, "Enter the recovery shares for vault '%s' (one per line):\n", name)
+
+	var shares []*vaulted.RecoveryShare
+	seen := make(map[byte]bool)
+	scanner := bufio.NewScanner(os.Stdin)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+
+		share, err := vaulted.ParseRecoveryShare(line)
+		if err != nil {
+			return nil, err
+		}
+		if seen[share.X] {
+			fmt.Fprintln(os.Stderr, "That share was already entered")
+			continue
+		}
+		seen[share.X] = true
+
+		shares = append(shares, share)
+		if len(shares) >= shares[0].Threshold {
+			break
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(shares) == 0 {
+		return nil, ErrNoRecoveryShares
+	}
+
+	return shares, nil
+}
+
+// groupText splits text into groups of size characters (separated by spaces),
+// to make it easier to transcribe.
+func groupText(text string, size int) string {
+	var groups []string
+	for len(text) > size {
+		groups = append(groups, text[:size])
+		text = text[size:]
+	}
+	groups = append(groups, text)
+
+	return strings.Join(groups, " ")
+}
@@ -0,0 +1,205 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/miquella/vaulted/lib"
+)
+
+func TestSplitRecovery(t *testing.T) {
+	store := NewTestStore()
+	store.Vaults["one"] = &vaulted.Vault{}
+
+	s := SplitRecovery{
+		VaultName: "one",
+		Shares:    5,
+		Threshold: 3,
+		Format:    RecoveryFormatBase32,
+	}
+	output := CaptureStdout(func() {
+		err := s.Run(store)
+		if err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	recovery := store.Recoveries["one"]
+	if recovery == nil || recovery.Shares != 5 || recovery.Threshold != 3 {
+		t.Fatalf("Expected a 3 of 5 recovery key, got %#v", recovery)
+	}
+	if store.Operations["one"] != "recovery" {
+		t.Fatalf("Expected operation 'recovery', got %q", store.Operations["one"])
+	}
+
+	lines := strings.Split(strings.TrimSpace(string(output)), "\n")
+	if len(lines) != 5 {
+		t.Fatalf("Expected 5 shares, got:\n%s", output)
+	}
+	for _, line := range lines {
+		share, err := vaulted.ParseRecoveryShare(line)
+		if err != nil {
+			t.Fatalf("Failed to parse share %q: %v", line, err)
+		}
+		if string(share.ID) != string(recovery.ID()) {
+			t.Fatalf("Expected share of recovery key %x, got %x", recovery.ID(), share.ID)
+		}
+	}
+}
+
+func TestSplitRecoveryText(t *testing.T) {
+	store := NewTestStore()
+	store.Vaults["one"] = &vaulted.Vault{}
+
+	s := SplitRecovery{
+		VaultName: "one",
+		Shares:    2,
+		Threshold: 2,
+		Format:    RecoveryFormatText,
+	}
+	output := CaptureStdout(func() {
+		err := s.Run(store)
+		if err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	if !strings.Contains(string(output), "Share 1:") || !strings.Contains(string(output), "Share 2:") {
+		t.Fatalf("Expected both shares to be labeled, got:\n%s", output)
+	}
+
+	// grouped shares still parse
+	lines := strings.Split(string(output), "\n")
+	for i, line := range lines {
+		if strings.HasPrefix(line, "Share ") {
+			_, err := vaulted.ParseRecoveryShare(lines[i+1])
+			if err != nil {
+				t.Fatalf("Failed to parse share %q: %v", lines[i+1], err)
+			}
+		}
+	}
+}
+
+func TestSplitRecoveryInvalidShares(t *testing.T) {
+	store := NewTestStore()
+	store.Vaults["one"] = &vaulted.Vault{}
+
+	s := SplitRecovery{
+		VaultName: "one",
+		Shares:    2,
+		Threshold: 3,
+	}
+	err := s.Run(store)
+	if err != vaulted.ErrInvalidRecoveryShares {
+		t.Fatalf("Expected %v, got %v", vaulted.ErrInvalidRecoveryShares, err)
+	}
+	if store.Recoveries["one"] != nil {
+		t.Fatal("Expected the vault not to have a recovery key")
+	}
+}
+
+func TestOpenRecovery(t *testing.T) {
+	store := NewTestStore()
+	store.Vaults["one"] = &vaulted.Vault{
+		Vars: map[string]string{"TEST": "value"},
+	}
+	store.Passwords["one"] = "forgotten"
+
+	recovery, shares, err := vaulted.NewRecoveryKey(3, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	store.Recoveries["one"] = recovery
+
+	input := "\n" + shares[2].String() + "\n" + shares[2].String() + "\n" + shares[0].String() + "\n"
+	o := OpenRecovery{VaultName: "one"}
+	WriteStdin([]byte(input), func() {
+		CaptureStdout(func() {
+			err = o.Run(store)
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if store.Passwords["one"] != "prompted seal password" {
+		t.Fatalf("Expected the vault to be sealed with a new password, got %q", store.Passwords["one"])
+	}
+	if store.Vaults["one"].Vars["TEST"] != "value" {
+		t.Fatalf("Expected the vault to be preserved, got %#v", store.Vaults["one"])
+	}
+	if store.Recoveries["one"] != nil {
+		t.Fatal("Expected the revealed recovery key to be removed")
+	}
+}
+
+func TestOpenRecoveryResetsKeySlots(t *testing.T) {
+	store := NewTestStore()
+	store.Vaults["one"] = &vaulted.Vault{}
+	store.Slots["one"] = []*vaulted.KeySlot{
+		{ID: 0, Key: &vaulted.VaultKey{Method: vaulted.DefaultKeyMethod}},
+		{ID: 1, Key: &vaulted.VaultKey{Method: vaulted.DefaultKeyMethod}},
+	}
+
+	recovery, shares, err := vaulted.NewRecoveryKey(2, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	store.Recoveries["one"] = recovery
+
+	input := shares[0].String() + "\n" + shares[1].String() + "\n"
+	o := OpenRecovery{VaultName: "one"}
+	WriteStdin([]byte(input), func() {
+		CaptureStdout(func() {
+			err = o.Run(store)
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(store.Slots["one"]) != 0 {
+		t.Fatalf("Expected the key slots to be removed, got %d", len(store.Slots["one"]))
+	}
+	if store.Operations["one"] != "recovery" {
+		t.Fatalf("Expected operation 'recovery', got %q", store.Operations["one"])
+	}
+}
+
+func TestOpenRecoveryNotEnoughShares(t *testing.T) {
+	store := NewTestStore()
+	store.Vaults["one"] = &vaulted.Vault{}
+	store.Passwords["one"] = "forgotten"
+
+	recovery, shares, err := vaulted.NewRecoveryKey(5, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	store.Recoveries["one"] = recovery
+
+	input := shares[0].String() + "\n" + shares[1].String() + "\n"
+	o := OpenRecovery{VaultName: "one"}
+	WriteStdin([]byte(input), func() {
+		err = o.Run(store)
+	})
+	if err != vaulted.ErrNotEnoughRecoveryShares {
+		t.Fatalf("Expected %v, got %v", vaulted.ErrNotEnoughRecoveryShares, err)
+	}
+	if store.Passwords["one"] != "forgotten" {
+		t.Fatal("Expected the vault not to be resealed")
+	}
+}
+
+func TestOpenRecoveryInvalidShare(t *testing.T) {
+	store := NewTestStore()
+	store.Vaults["one"] = &vaulted.Vault{}
+
+	o := OpenRecovery{VaultName: "one"}
+	var err error
+	WriteStdin([]byte("not a share\n"), func() {
+		err = o.Run(store)
+	})
+	if err != vaulted.ErrInvalidRecoveryShare {
+		t.Fatalf("Expected %v, got %v", vaulted.ErrInvalidRecoveryShare, err)
+	}
+}
@@ -0,0 +1,46 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/miquella/vaulted/lib"
+)
+
+type Rollback struct {
+	VaultName string
+	Revision  int
+}
+
+func (r *Rollback) Run(store vaulted.Store) error {
+	_, password, err := store.OpenVault(r.VaultName)
+	if err != nil {
+		return err
+	}
+
+	vault, err := store.OpenVaultRevision(r.VaultName, r.Revision, password)
+	if err == vaulted.ErrIncorrectPassword {
+		// the revision was sealed before the password was changed
+		revisionName := fmt.Sprintf("%s (revision %d)", r.VaultName, r.Revision)
+		var revisionPassword string
+		revisionPassword, err = store.Steward().GetPassword(vaulted.OpenOperation, revisionName)
+		if err != nil {
+			return err
+		}
+
+		vault, err = store.OpenVaultRevision(r.VaultName, r.Revision, revisionPassword)
+	}
+	if err != nil {
+		return err
+	}
+
+	// always reseal with the current password (never the revision's password)
+	err = store.SealVaultWithOptions(vault, r.VaultName, password, vaulted.SealOptions{
+		Operation: "rollback",
+	})
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Vault '%s' rolled back to revision %d\n", r.VaultName, r.Revision)
+
+	return nil
+}
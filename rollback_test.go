@@ -0,0 +1,95 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/miquella/vaulted/lib"
+)
+
+func TestRollback(t *testing.T) {
+	store := NewTestStore()
+	store.Vaults["one"] = &vaulted.Vault{
+		Vars: map[string]string{
+			"TEST": "CURRENT",
+		},
+	}
+	store.Passwords["one"] = "current password"
+	store.RevisionVaults["one"] = map[int]*vaulted.Vault{
+		1: {
+			Vars: map[string]string{
+				"TEST": "PREVIOUS",
+			},
+		},
+	}
+	store.RevisionPasswords["one"] = map[int]string{
+		1: "current password",
+	}
+
+	r := Rollback{
+		VaultName: "one",
+		Revision:  1,
+	}
+	err := r.Run(store)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if store.Vaults["one"].Vars["TEST"] != "PREVIOUS" {
+		t.Fatal("The vault was not rolled back")
+	}
+
+	if store.Passwords["one"] != "current password" {
+		t.Fatal("The vault should be sealed with the current password")
+	}
+
+	if store.Operations["one"] != "rollback" {
+		t.Fatalf("Expected operation 'rollback', got '%s'", store.Operations["one"])
+	}
+}
+
+func TestRollbackWithOldPassword(t *testing.T) {
+	store := NewTestStore()
+	store.Vaults["one"] = &vaulted.Vault{}
+	store.Passwords["one"] = "current password"
+	store.RevisionVaults["one"] = map[int]*vaulted.Vault{
+		1: {
+			Vars: map[string]string{
+				"TEST": "PREVIOUS",
+			},
+		},
+	}
+	store.RevisionPasswords["one"] = map[int]string{
+		1: "prompted open password",
+	}
+
+	r := Rollback{
+		VaultName: "one",
+		Revision:  1,
+	}
+	err := r.Run(store)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if store.Vaults["one"].Vars["TEST"] != "PREVIOUS" {
+		t.Fatal("The vault was not rolled back")
+	}
+
+	if store.Passwords["one"] != "current password" {
+		t.Fatal("A rolled back revision must not restore an old password")
+	}
+}
+
+func TestRollbackMissingRevision(t *testing.T) {
+	store := NewTestStore()
+	store.Vaults["one"] = &vaulted.Vault{}
+
+	r := Rollback{
+		VaultName: "one",
+		Revision:  7,
+	}
+	err := r.Run(store)
+	if err != vaulted.ErrRevisionNotExist {
+		t.Fatalf("Expected %v, got %v", vaulted.ErrRevisionNotExist, err)
+	}
+}
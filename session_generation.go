@@ -56,14 +56,22 @@ func GetSessionWithOptions(store vaulted.Store, options *SessionOptions) (*vault
 
 	// Assume any role specified
 	if options.Role != "" {
-		return session.AssumeRole(options.Role)
+		if options.VaultName == "" {
+			return session.AssumeRole(options.Role)
+		}
+		return store.AssumeRole(session, options.VaultName, options.Role)
 	}
 
 	return session, nil
 }
 
 func getVaultSessionWithNoSession(store vaulted.Store, options *SessionOptions) (*vaulted.Session, error) {
-	vault, _, err := store.OpenVault(options.VaultName)
+	vault, _, err := store.UnlockVault(options.VaultName)
+	if err != nil {
+		return nil, err
+	}
+
+	vault, err = store.ResolveIncludes(vault, options.VaultName)
 	if err != nil {
 		return nil, err
 	}
@@ -91,7 +99,7 @@ func getDefaultSession(options *SessionOptions) (*vaulted.Session, error) {
 }
 
 func getVaultSession(store vaulted.Store, options *SessionOptions) (*vaulted.Session, error) {
-	vault, password, err := store.OpenVault(options.VaultName)
+	vault, password, err := store.UnlockVault(options.VaultName)
 	if err != nil {
 		return nil, err
 	}
@@ -110,14 +118,18 @@ func getVaultSession(store vaulted.Store, options *SessionOptions) (*vaulted.Ses
 	}
 
 	// Assume the session's role
-	return session.AssumeSessionRole()
+	return store.AssumeRole(session, options.VaultName, "")
 }
 
 func updateVaultFromEnvAndOptions(vault *vaulted.Vault, options *SessionOptions) {
 	// Calculate the region (lowest precedence to highest)
-	region := os.Getenv("AWS_DEFAULT_REGION")
-	if awsRegion := os.Getenv("AWS_REGION"); awsRegion != "" {
-		region = awsRegion
+	var region string
+	if !vault.IncludeAWSKey || vault.AWSKey.Valid() {
+		// the region of an included AWS key is only overridden by --region
+		region = os.Getenv("AWS_DEFAULT_REGION")
+		if awsRegion := os.Getenv("AWS_REGION"); awsRegion != "" {
+			region = awsRegion
+		}
 	}
 	if vault.AWSKey != nil {
 		if vault.AWSKey.Region != nil && *vault.AWSKey.Region != "" {
@@ -1,29 +1,79 @@
 package main
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"os/exec"
 	"regexp"
+	"strconv"
 	"strings"
 
 	"github.com/miquella/ask"
+	"github.com/miquella/vaulted/agent"
 	"github.com/miquella/vaulted/lib"
 	"github.com/miquella/vaulted/lib/legacy"
 )
 
 func NewSteward() vaulted.Steward {
+	var steward vaulted.Steward
 	if askpass, present := os.LookupEnv("VAULTED_ASKPASS"); present {
-		return &AskPassSteward{
+		steward = &AskPassSteward{
 			Command: askpass,
+			Policy:  PasswordPolicy,
 		}
 	} else {
-		return &TTYSteward{}
+		steward = &TTYSteward{
+			Policy: PasswordPolicy,
+		}
+	}
+
+	// derived keys are cached by the agent (when it's running)
+	socket := agentSocket()
+	if _, err := os.Stat(socket); err == nil {
+		return &AgentSteward{
+			Steward: steward,
+			Agent:   agent.NewClient(socket),
+		}
+	}
+
+	return steward
+}
+
+// AgentSteward caches the keys derived from vault passwords in the vaulted
+// agent. Passwords (and identities) are requested from Steward.
+type AgentSteward struct {
+	vaulted.Steward
+	Agent *agent.Client
+}
+
+func (a *AgentSteward) GetMaxOpenTries() int {
+	if getMax, ok := a.Steward.(vaulted.StewardMaxTries); ok {
+		return getMax.GetMaxOpenTries()
 	}
+
+	return 1
+}
+
+func (a *AgentSteward) GetIdentity(name string) (*vaulted.Identity, error) {
+	if stewardIdentity, ok := a.Steward.(vaulted.StewardIdentity); ok {
+		return stewardIdentity.GetIdentity(name)
+	}
+
+	return nil, vaulted.ErrNoIdentity
+}
+
+func (a *AgentSteward) GetKey(name string, fingerprint []byte) ([]byte, error) {
+	return a.Agent.GetKey(name, fingerprint)
+}
+
+func (a *AgentSteward) PutKey(name string, fingerprint, key []byte) error {
+	return a.Agent.PutKey(name, fingerprint, key)
 }
 
 type AskPassSteward struct {
 	Command string
+	Policy  vaulted.PasswordPolicy
 }
 
 func (t *AskPassSteward) GetMaxOpenTries() int {
@@ -40,6 +90,10 @@ func (t *AskPassSteward) GetPassword(operation vaulted.Operation, name string) (
 	switch operation {
 	case vaulted.SealOperation:
 		if password, present := os.LookupEnv("VAULTED_NEW_PASSWORD"); present {
+			err := checkNewPassword(t.Policy, name, password)
+			if err != nil {
+				return "", err
+			}
 			return password, nil
 		}
 
@@ -54,18 +108,20 @@ func (t *AskPassSteward) GetPassword(operation vaulted.Operation, name string) (
 	// askpass prompt
 	switch operation {
 	case vaulted.SealOperation:
-		for firstTry := false; ; firstTry = true {
-			var prompt string
-			if firstTry {
-				prompt = fmt.Sprintf("'%s' new password: ", name)
-			} else {
-				prompt = fmt.Sprintf("'%s' new password (passwords didn't match): ", name)
-			}
+		// a password refused by the password policy isn't asked for again,
+		// since a scripted askpass would keep returning it
+		prompt := fmt.Sprintf("'%s' new password: ", name)
+		for attempts := 0; attempts < 3; attempts++ {
 			password, err := t.askpass(prompt)
 			if err != nil {
 				return "", err
 			}
 
+			err = checkNewPassword(t.Policy, name, password)
+			if err != nil {
+				return "", err
+			}
+
 			confirm, err := t.askpass(fmt.Sprintf("'%s' confirm password: ", name))
 			if err != nil {
 				return "", err
@@ -74,8 +130,12 @@ func (t *AskPassSteward) GetPassword(operation vaulted.Operation, name string) (
 			if password == confirm {
 				return password, nil
 			}
+
+			prompt = fmt.Sprintf("'%s' new password (passwords didn't match): ", name)
 		}
 
+		return "", ErrNoPasswordEntered
+
 	case legacy.LegacyOperation:
 		return t.askpass("Legacy Password: ")
 
@@ -84,6 +144,10 @@ func (t *AskPassSteward) GetPassword(operation vaulted.Operation, name string) (
 	}
 }
 
+func (t *AskPassSteward) GetIdentity(name string) (*vaulted.Identity, error) {
+	return readIdentity()
+}
+
 func (t *AskPassSteward) GetMFAToken(name string) (string, error) {
 	return t.askpass(fmt.Sprintf("'%s' MFA token: ", name))
 }
@@ -98,7 +162,9 @@ func (t *AskPassSteward) askpass(prompt string) (string, error) {
 	return strings.Trim(string(output), "\r\n"), nil
 }
 
-type TTYSteward struct{}
+type TTYSteward struct {
+	Policy vaulted.PasswordPolicy
+}
 
 func (t *TTYSteward) GetMaxOpenTries() int {
 	if _, present := os.LookupEnv("VAULTED_PASSWORD"); present {
@@ -114,6 +180,10 @@ func (t *TTYSteward) GetPassword(operation vaulted.Operation, name string) (stri
 	switch operation {
 	case vaulted.SealOperation:
 		if password, present := os.LookupEnv("VAULTED_NEW_PASSWORD"); present {
+			err := checkNewPassword(t.Policy, name, password)
+			if err != nil {
+				return "", err
+			}
 			return password, nil
 		}
 
@@ -129,12 +199,18 @@ func (t *TTYSteward) GetPassword(operation vaulted.Operation, name string) (stri
 	switch operation {
 	case vaulted.SealOperation:
 		ask.Print(fmt.Sprintf("Vault '%s'\n", name))
-		for {
+		for attempts := 0; attempts < 3; attempts++ {
 			password, err := ask.HiddenAsk("   New password: ")
 			if err != nil {
 				return "", err
 			}
 
+			err = checkNewPassword(t.Policy, name, password)
+			if err != nil {
+				ask.Print(fmt.Sprintf("%v\n\n", err))
+				continue
+			}
+
 			confirm, err := ask.HiddenAsk("   Confirm password: ")
 			if err != nil {
 				return "", err
@@ -147,6 +223,8 @@ func (t *TTYSteward) GetPassword(operation vaulted.Operation, name string) (stri
 			ask.Print("Passwords do not match.\n\n")
 		}
 
+		return "", ErrNoPasswordEntered
+
 	case legacy.LegacyOperation:
 		return ask.HiddenAsk("Legacy Password: ")
 
@@ -156,7 +234,13 @@ func (t *TTYSteward) GetPassword(operation vaulted.Operation, name string) (stri
 	}
 }
 
+func (t *TTYSteward) GetIdentity(name string) (*vaulted.Identity, error) {
+	return readIdentity()
+}
+
 var (
+	ErrInvalidPasswordPolicy = ErrorWithExitCode{errors.New("Invalid password policy (VAULTED_PASSWORD_MIN_LENGTH and VAULTED_PASSWORD_MIN_ENTROPY must be non-negative numbers)"), EX_USAGE_ERROR}
+
 	mfaTokenValidation = regexp.MustCompile(`^\d{6}$`)
 )
 
@@ -177,3 +261,74 @@ func (t *TTYSteward) GetMFAToken(name string) (string, error) {
 
 	return "", ErrNoMFATokenEntered
 }
+
+// checkNewPassword applies the password policy (if there is one) to a new
+// password for a vault.
+func checkNewPassword(policy vaulted.PasswordPolicy, name, password string) error {
+	if policy == nil {
+		return nil
+	}
+
+	return policy.CheckPassword(name, password)
+}
+
+// passwordPolicyFromEnv returns the password policy for new passwords, with
+// the minimums (and additional deny list) given by the VAULTED_PASSWORD_*
+// environment variables.
+func passwordPolicyFromEnv() (vaulted.PasswordPolicy, error) {
+	policy := vaulted.NewStrengthPolicy()
+
+	if minLength, present := os.LookupEnv("VAULTED_PASSWORD_MIN_LENGTH"); present {
+		length, err := strconv.Atoi(minLength)
+		if err != nil || length < 0 {
+			return nil, ErrInvalidPasswordPolicy
+		}
+		policy.MinLength = length
+	}
+
+	if minEntropy, present := os.LookupEnv("VAULTED_PASSWORD_MIN_ENTROPY"); present {
+		bits, err := strconv.ParseFloat(minEntropy, 64)
+		if err != nil || bits < 0 {
+			return nil, ErrInvalidPasswordPolicy
+		}
+		policy.MinEntropy = bits
+	}
+
+	if filename, present := os.LookupEnv("VAULTED_PASSWORD_DENY_LIST"); present {
+		denyList, err := vaulted.ReadDenyList(filename)
+		if err != nil {
+			return nil, err
+		}
+		policy.DenyList = append(policy.DenyList, denyList...)
+	}
+
+	return policy, nil
+}
+
+// identityFile returns the location of the identity used to open vaults sealed
+// for recipients.
+func identityFile() string {
+	if filename, present := os.LookupEnv("VAULTED_IDENTITY"); present {
+		return filename
+	}
+
+	return vaulted.DefaultIdentityFile()
+}
+
+func readIdentity() (*vaulted.Identity, error) {
+	identity, err := vaulted.ReadIdentityFile(identityFile())
+	if os.IsNotExist(err) {
+		return nil, vaulted.ErrNoIdentity
+	}
+
+	return identity, err
+}
+
+// agentSocket returns the location of the vaulted agent's socket.
+func agentSocket() string {
+	if socket, present := os.LookupEnv("VAULTED_AGENT_SOCK"); present {
+		return socket
+	}
+
+	return agent.DefaultSocket()
+}
@@ -0,0 +1,68 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/miquella/vaulted/lib"
+)
+
+func TestStewardPasswordPolicy(t *testing.T) {
+	savedPassword, passwordSet := os.LookupEnv("VAULTED_NEW_PASSWORD")
+	defer func() {
+		if passwordSet {
+			os.Setenv("VAULTED_NEW_PASSWORD", savedPassword)
+		} else {
+			os.Unsetenv("VAULTED_NEW_PASSWORD")
+		}
+	}()
+
+	stewards := []vaulted.Steward{
+		&TTYSteward{Policy: vaulted.NewStrengthPolicy()},
+		&AskPassSteward{Command: "false", Policy: vaulted.NewStrengthPolicy()},
+	}
+
+	for _, steward := range stewards {
+		os.Setenv("VAULTED_NEW_PASSWORD", "password1")
+		_, err := steward.GetPassword(vaulted.SealOperation, "one")
+		if err != vaulted.ErrPasswordDenied {
+			t.Errorf("Expected %v, got %v", vaulted.ErrPasswordDenied, err)
+		}
+
+		os.Setenv("VAULTED_NEW_PASSWORD", "")
+		_, err = steward.GetPassword(vaulted.SealOperation, "one")
+		if err != vaulted.ErrPasswordTooShort {
+			t.Errorf("Expected %v, got %v", vaulted.ErrPasswordTooShort, err)
+		}
+
+		os.Setenv("VAULTED_NEW_PASSWORD", "xK9#mQ2v")
+		password, err := steward.GetPassword(vaulted.SealOperation, "one")
+		if err != nil || password != "xK9#mQ2v" {
+			t.Errorf("Expected the password to be accepted, got %q (%v)", password, err)
+		}
+	}
+
+	// without a policy, any password is accepted
+	os.Setenv("VAULTED_NEW_PASSWORD", "")
+	_, err := (&TTYSteward{}).GetPassword(vaulted.SealOperation, "one")
+	if err != nil {
+		t.Errorf("Expected the password to be accepted, got %v", err)
+	}
+}
+
+func TestAskPassStewardRefusesPassword(t *testing.T) {
+	// askpass always returns the same password, which the policy refuses
+	askpass := filepath.Join(t.TempDir(), "askpass")
+	err := ioutil.WriteFile(askpass, []byte("#!/bin/sh\necho password1\n"), 0700)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	steward := &AskPassSteward{Command: askpass, Policy: vaulted.NewStrengthPolicy()}
+	_, err = steward.GetPassword(vaulted.SealOperation, "one")
+	if err != vaulted.ErrPasswordDenied {
+		t.Errorf("Expected %v, got %v", vaulted.ErrPasswordDenied, err)
+	}
+}
@@ -0,0 +1,53 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/miquella/vaulted/lib"
+)
+
+type SyncInit struct {
+	Remote string
+}
+
+func (s *SyncInit) Run(store vaulted.Store) error {
+	sync := newGitSync()
+	conflicts, err := sync.Init(s.Remote)
+	if err != nil {
+		return err
+	}
+
+	printSyncConflicts(conflicts)
+	fmt.Printf("Vaults in %s are synced with %s\n", sync.Dir, s.Remote)
+	return nil
+}
+
+type SyncPush struct{}
+
+func (s *SyncPush) Run(store vaulted.Store) error {
+	return newGitSync().Push()
+}
+
+type SyncPull struct{}
+
+func (s *SyncPull) Run(store vaulted.Store) error {
+	conflicts, err := newGitSync().Pull()
+	if err != nil {
+		return err
+	}
+
+	printSyncConflicts(conflicts)
+	return nil
+}
+
+// newGitSync returns the sync for the vaults of the store given by --store
+// (or the XDG store). Read-only stores are never synced.
+func newGitSync() *vaulted.GitSync {
+	return vaulted.NewGitSync(newBackend(StoreDirs).(*vaulted.FileBackend))
+}
+
+func printSyncConflicts(conflicts []vaulted.SyncConflict) {
+	for _, conflict := range conflicts {
+		fmt.Printf("Vault '%s' was changed both locally and remotely, the remote vault was saved as '%s'\n", conflict.Name, conflict.Copy)
+	}
+}
@@ -0,0 +1,67 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/miquella/vaulted/lib"
+)
+
+func TestSync(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not installed")
+	}
+
+	root, err := ioutil.TempDir("", "vaulted")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+
+	remote := filepath.Join(root, "remote.git")
+	err = exec.Command("git", "init", "--quiet", "--bare", remote).Run()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	savedStoreDirs := StoreDirs
+	defer func() {
+		StoreDirs = savedStoreDirs
+	}()
+	StoreDirs = []string{filepath.Join(root, "store")}
+
+	store := NewTestStore()
+	err = (&SyncPull{}).Run(store)
+	if err != vaulted.ErrSyncNotInitialized {
+		t.Fatalf("Expected %v, got %v", vaulted.ErrSyncNotInitialized, err)
+	}
+
+	output := CaptureStdout(func() {
+		err = (&SyncInit{Remote: remote}).Run(store)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(output), "synced with "+remote) {
+		t.Fatalf("Unexpected output: %s", output)
+	}
+
+	err = (&SyncPush{}).Run(store)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	output = CaptureStdout(func() {
+		err = (&SyncPull{}).Run(store)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(output) != 0 {
+		t.Fatalf("Expected no conflicts, got: %s", output)
+	}
+}
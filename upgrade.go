@@ -3,6 +3,7 @@ package main
 import (
 	"errors"
 	"fmt"
+	"sort"
 
 	"github.com/miquella/vaulted/lib"
 	"github.com/miquella/vaulted/lib/legacy"
@@ -12,9 +13,15 @@ var (
 	ErrUpgradeFailed = errors.New("Upgrade failed")
 )
 
-type Upgrade struct{}
+type Upgrade struct {
+	All bool
+}
 
 func (u *Upgrade) Run(store vaulted.Store) error {
+	if u.All {
+		return u.upgradeVaults(store)
+	}
+
 	ls := store.(legacy.LegacyStore)
 	environments, password, err := ls.OpenLegacyVault()
 	if err != nil {
@@ -38,7 +45,9 @@ func (u *Upgrade) Run(store vaulted.Store) error {
 		vault := &vaulted.Vault{
 			Vars: env.Vars,
 		}
-		err = store.SealVaultWithPassword(vault, name, password)
+		err = store.SealVaultWithOptions(vault, name, password, vaulted.SealOptions{
+			Operation: "upgrade",
+		})
 		if err != nil {
 			failed++
 			fmt.Printf("%s: %v\n", name, err)
@@ -53,3 +62,34 @@ func (u *Upgrade) Run(store vaulted.Store) error {
 
 	return nil
 }
+
+// upgradeVaults rewrites every vault in the current vault file format.
+func (u *Upgrade) upgradeVaults(store vaulted.Store) error {
+	vaults, err := store.ListVaults()
+	if err != nil {
+		return err
+	}
+	sort.Strings(vaults)
+
+	failed := 0
+	for _, name := range vaults {
+		version, err := store.UpgradeVault(name)
+		switch {
+		case err == vaulted.ErrReadOnlyVault:
+			fmt.Printf("%s: skipped (read-only system vault)\n", name)
+		case err != nil:
+			failed++
+			fmt.Printf("%s: %v\n", name, err)
+		case version == vaulted.CurrentFormatVersion:
+			fmt.Printf("%s: up to date\n", name)
+		default:
+			fmt.Printf("%s: upgraded (format version %d to %d)\n", name, version, vaulted.CurrentFormatVersion)
+		}
+	}
+
+	if failed > 0 {
+		return ErrorWithExitCode{ErrUpgradeFailed, failed}
+	}
+
+	return nil
+}
@@ -59,3 +59,43 @@ func TestUpgrade(t *testing.T) {
 		t.Fatalf("Password not kept. Expected %v, got %v", store.LegacyPassword, store.Passwords["two"])
 	}
 }
+
+func TestUpgradeAll(t *testing.T) {
+	store := NewTestStore()
+	store.Vaults["current"] = &vaulted.Vault{}
+	store.Vaults["old"] = &vaulted.Vault{}
+	store.FormatVersions["old"] = 0
+	store.Vaults["system"] = &vaulted.Vault{}
+	store.FormatVersions["system"] = 0
+	store.Sources["system"] = vaulted.SystemVault
+
+	output := CaptureStdout(func() {
+		u := Upgrade{All: true}
+		err := u.Run(store)
+		if err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	expected := "current: up to date\n" +
+		"old: upgraded (format version 0 to 2)\n" +
+		"system: skipped (read-only system vault)\n"
+	if string(output) != expected {
+		t.Fatalf("Expected %q, got %q", expected, output)
+	}
+	if _, exists := store.FormatVersions["old"]; exists {
+		t.Fatal("Expected old to be upgraded")
+	}
+
+	store.FormatVersions["newer"] = vaulted.CurrentFormatVersion + 1
+	store.Vaults["newer"] = &vaulted.Vault{}
+
+	CaptureStdout(func() {
+		u := Upgrade{All: true}
+		err := u.Run(store)
+		exitErr, ok := err.(ErrorWithExitCode)
+		if !ok || exitErr.ExitCode != 1 {
+			t.Fatalf("Expected a single failure, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,137 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/miquella/vaulted/lib"
+)
+
+const (
+	// EX_VERIFY_WARNING is returned by verify when vaults only have warnings
+	EX_VERIFY_WARNING = 1
+)
+
+var (
+	verifySeverity = map[string]int{
+		vaulted.VerifyOK:      0,
+		vaulted.VerifyWarning: 1,
+		vaulted.VerifyError:   2,
+	}
+)
+
+type Verify struct {
+	VaultNames []string
+	Open       bool
+	JSON       bool
+}
+
+type verifyReport struct {
+	Status string                 `json:"status"`
+	Vaults []*vaulted.VaultReport `json:"vaults"`
+}
+
+func (v *Verify) Run(store vaulted.Store) error {
+	names := v.VaultNames
+	if len(names) == 0 {
+		var err error
+		names, err = store.ListVaults()
+		if err != nil {
+			return err
+		}
+		sort.Strings(names)
+	}
+
+	report := verifyReport{
+		Status: vaulted.VerifyOK,
+		Vaults: []*vaulted.VaultReport{},
+	}
+	for _, name := range names {
+		vaultReport, err := v.verify(store, name)
+		if os.IsNotExist(err) {
+			vaultReport = &vaulted.VaultReport{
+				Name:   name,
+				Status: vaulted.VerifyError,
+				Errors: []string{"Vault does not exist"},
+			}
+		} else if err != nil {
+			return err
+		}
+
+		report.Vaults = append(report.Vaults, vaultReport)
+		if verifySeverity[vaultReport.Status] > verifySeverity[report.Status] {
+			report.Status = vaultReport.Status
+		}
+	}
+
+	if v.JSON {
+		output, err := json.MarshalIndent(report, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(output))
+	} else {
+		for _, vaultReport := range report.Vaults {
+			printVaultReport(vaultReport)
+		}
+	}
+
+	switch report.Status {
+	case vaulted.VerifyError:
+		return ErrorWithExitCode{ErrNoError, EX_DATA_ERROR}
+	case vaulted.VerifyWarning:
+		return ErrorWithExitCode{ErrNoError, EX_VERIFY_WARNING}
+	default:
+		return nil
+	}
+}
+
+func (v *Verify) verify(store vaulted.Store, name string) (*vaulted.VaultReport, error) {
+	if !v.Open {
+		return store.VerifyVault(name)
+	}
+
+	// vaults sealed for recipients are opened with an identity instead
+	password := ""
+	recipients, err := store.VaultRecipients(name)
+	if err == nil && len(recipients) == 0 {
+		password, err = store.Steward().GetPassword(vaulted.OpenOperation, name)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return store.VerifyVaultWithPassword(name, password)
+}
+
+func printVaultReport(report *vaulted.VaultReport) {
+	var details []string
+	if report.KeyMethod != "" {
+		details = append(details, report.KeyMethod)
+	}
+	if report.Method != "" {
+		details = append(details, report.Method)
+	}
+	if report.Revision != 0 {
+		details = append(details, fmt.Sprintf("revision %d", report.Revision))
+	}
+	if report.Opened != nil && *report.Opened {
+		details = append(details, "opened")
+	}
+
+	line := fmt.Sprintf("%s: %s", report.Name, report.Status)
+	if len(details) > 0 {
+		line = fmt.Sprintf("%s (%s)", line, strings.Join(details, ", "))
+	}
+	fmt.Println(line)
+
+	for _, problem := range report.Errors {
+		fmt.Printf("  error: %s\n", problem)
+	}
+	for _, problem := range report.Warnings {
+		fmt.Printf("  warning: %s\n", problem)
+	}
+}
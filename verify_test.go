@@ -0,0 +1,83 @@
+package main
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/miquella/vaulted/lib"
+)
+
+func TestVerify(t *testing.T) {
+	store := NewTestStore()
+	store.Vaults["one"] = &vaulted.Vault{}
+	store.Vaults["two"] = &vaulted.Vault{}
+	store.Reports["two"] = &vaulted.VaultReport{
+		Name:      "two",
+		Status:    vaulted.VerifyWarning,
+		KeyMethod: "pbkdf2-sha512",
+		Warnings:  []string{"weak"},
+	}
+
+	v := Verify{}
+	var err error
+	output := CaptureStdout(func() {
+		err = v.Run(store)
+	})
+	if exitErr, ok := err.(ErrorWithExitCode); !ok || exitErr.ExitCode != EX_VERIFY_WARNING {
+		t.Fatalf("Expected exit code %d, got %v", EX_VERIFY_WARNING, err)
+	}
+
+	expected := "one: ok\ntwo: warning (pbkdf2-sha512)\n  warning: weak\n"
+	if string(output) != expected {
+		t.Fatalf("Expected %q, got %q", expected, output)
+	}
+
+	v.VaultNames = []string{"one"}
+	CaptureStdout(func() {
+		err = v.Run(store)
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+}
+
+func TestVerifyJSON(t *testing.T) {
+	store := NewTestStore()
+	store.Vaults["one"] = &vaulted.Vault{}
+	store.Passwords["one"] = "prompted open password"
+	store.Vaults["two"] = &vaulted.Vault{}
+	store.Passwords["two"] = "another password"
+
+	v := Verify{
+		VaultNames: []string{"one", "two", "missing"},
+		Open:       true,
+		JSON:       true,
+	}
+	var err error
+	output := CaptureStdout(func() {
+		err = v.Run(store)
+	})
+	if exitErr, ok := err.(ErrorWithExitCode); !ok || exitErr.ExitCode != EX_DATA_ERROR {
+		t.Fatalf("Expected exit code %d, got %v", EX_DATA_ERROR, err)
+	}
+
+	report := verifyReport{}
+	err = json.Unmarshal(output, &report)
+	if err != nil {
+		t.Fatalf("Invalid JSON output: %v", err)
+	}
+
+	if report.Status != vaulted.VerifyError || len(report.Vaults) != 3 {
+		t.Fatalf("Unexpected report: %s", output)
+	}
+
+	statuses := []string{vaulted.VerifyOK, vaulted.VerifyError, vaulted.VerifyError}
+	for i, vaultReport := range report.Vaults {
+		if vaultReport.Status != statuses[i] {
+			t.Fatalf("Expected %s to be %s, got %s", vaultReport.Name, statuses[i], vaultReport.Status)
+		}
+	}
+	if report.Vaults[0].Opened == nil || !*report.Vaults[0].Opened {
+		t.Fatalf("Expected vault 'one' to be opened, got %s", output)
+	}
+}